@@ -0,0 +1,44 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedCatalogueIsCurrent(t *testing.T) {
+	if file, err := os.Open("../../" + defaultSpecFile); err != nil {
+		t.Fatal(err)
+	} else if spec, err := parseSpec(file); err != nil {
+		t.Fatal(err)
+	} else if output, err := Codegen(spec); err != nil {
+		t.Fatal(err)
+	} else if current, err := os.ReadFile("../../" + defaultOutputFile); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(output, current) {
+		t.Errorf("%s is stale, run 'make codegen'", defaultOutputFile)
+	}
+}
+
+func TestSpecErrors(t *testing.T) {
+	for name, text := range map[string]string{
+		"duplicate type code":   "ie\t1\tA\tgroup\t-\tA\nie\t1\tB\tbytes\toctets\tB\n",
+		"duplicate identifier":  "ie\t1\tA\tgroup\t-\tA\nie\t2\tA\tbytes\toctets\tB\n",
+		"undeclared member":     "ie\t1\tA\tgroup\t-\tA\ngroup\tA\n\tB\trequired\n",
+		"undeclared base IE":    "ie\t1\tA\tgroup\t-\tA\nmessage\t1\tM\tM\nmessageies\tM\n\tA\tupdate=B\n",
+		"unknown attribute":     "ie\t1\tA\tgroup\t-\tA\ngroup\tA\n\tA\toptional\n",
+		"orphan member":         "\tA\n",
+		"vendor type code":      "ie\t32768\tA\tbytes\toctets\tA\n",
+		"group without members": "ie\t1\tA\tgroup\t-\tA\n",
+		"unknown release":       "ie\t1\tA\tbytes\toctets\tA\tR18\n",
+		"releases reversed":     "ie\t1\tA\tbytes\toctets\tA\tR16-R15\n",
+	} {
+		if _, err := parseSpec(strings.NewReader(text)); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+}
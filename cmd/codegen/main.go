@@ -4,120 +4,41 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
 	"os"
-	"strconv"
-	"strings"
 )
 
+const (
+	defaultSpecFile   = "ts29244.spec"
+	defaultOutputFile = "pfcp/catalogue_generated.go"
+)
+
+// usage: codegen [spec file [output file]]
+// run from the repository root, e.g. 'make codegen'
 func main() {
-	fmt.Println("codegen")
-	filePath := "PFCP IEs"
+	specFile := defaultSpecFile
+	outputFile := defaultOutputFile
 	if len(os.Args) > 1 {
-		fmt.Printf("file parameter provided\n")
-		filePath = os.Args[1]
+		specFile = os.Args[1]
 	}
-
-	if file, err := os.Open(filePath); err != nil {
-		fmt.Printf("can't open: %s (%s)\n", filePath, err.Error())
-		os.Exit(1)
-	} else {
-		var lines []line
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			s := scanner.Text()
-			fmt.Println(s)
-			line := processInputLine(s)
-			lines = append(lines, line)
-			fmt.Println(line)
-		}
-		if err := scanner.Err(); err != nil {
-			fmt.Println("error reading file:", err)
-			os.Exit(1)
-		}
-		Codegen(lines[:])
+	if len(os.Args) > 2 {
+		outputFile = os.Args[2]
 	}
-}
 
-type line struct {
-	TypeCode int
-	TypeName string
-}
-
-func (line line) String() string {
-	return fmt.Sprintf("%d : '%s'\n", line.TypeCode, line.TypeName)
-}
-
-func processInputLine(s string) (l line) {
-	before, after, found := strings.Cut(s, "\t")
-	if !found {
-		fmt.Printf("can't parse: %s\n", s)
+	if file, err := os.Open(specFile); err != nil {
+		fmt.Printf("can't open: %s (%s)\n", specFile, err.Error())
 		os.Exit(1)
-	} else if i, err := strconv.Atoi(before); err != nil {
-		fmt.Printf("can't parse: %s\n", s)
+	} else if spec, err := parseSpec(file); err != nil {
+		fmt.Printf("can't parse: %s (%s)\n", specFile, err.Error())
+		os.Exit(1)
+	} else if output, err := Codegen(spec); err != nil {
+		fmt.Printf("codegen failed for %s (%s)\n", specFile, err.Error())
+		os.Exit(1)
+	} else if err := os.WriteFile(outputFile, output, 0o644); err != nil {
+		fmt.Printf("code output to %s could not be written (%s)\n", outputFile, err.Error())
 		os.Exit(1)
 	} else {
-		l.TypeCode = i
-		l.TypeName = mangle(after)
-	}
-	return
-}
-
-const (
-	_0_ rune = 48
-	_9_ rune = 57
-	_A_ rune = 65
-	_Z_ rune = 90
-	___ rune = 95
-	_a_ rune = 97
-	_z_ rune = 122
-)
-
-func toUpper(r rune) rune {
-	if r >= _a_ && r <= _z_ {
-		return r - _a_ + _A_
-	} else {
-		return r
-	}
-}
-
-func toLower(r rune) rune {
-	if r >= _A_ && r <= _Z_ {
-		return r + _a_ - _A_
-	} else {
-		return r
-	}
-}
-
-func validIdentifierChar(r rune) bool {
-	switch {
-	case r == ___:
-		return true
-	case r >= _0_ && r <= _9_:
-		return true
-	case r >= _A_ && r <= _Z_:
-		return true
-	case r >= _a_ && r <= _z_:
-		return true
-	default:
-		return false
-	}
-}
-
-func mangle(sIn string) (sOut string) {
-	capState := true
-	for _, c := range sIn {
-		if validIdentifierChar(c) {
-			if capState {
-				capState = false
-				sOut = sOut + string(toUpper(c))
-			} else {
-				sOut = sOut + string(toLower(c))
-			}
-		} else {
-			capState = true
-		}
+		fmt.Printf("%d IEs, %d messages, %d group IEs and %d message IE sets written to %s\n",
+			len(spec.Ies), len(spec.Messages), len(spec.Groups), len(spec.MessageIes), outputFile)
 	}
-	return
 }
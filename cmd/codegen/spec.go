@@ -0,0 +1,324 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// the spec file format is described in the header of ts29244.spec
+
+type ie struct {
+	TypeCode   int
+	Identifier string
+	IeType     string
+	Layout     string
+	Name       string
+	releases
+	Comment string
+}
+
+type message struct {
+	TypeCode   int
+	Identifier string
+	Name       string
+	releases
+}
+
+// releases are the 3GPP releases of an IE or message, zero when not given, i.e. in R15 and every later release
+type releases struct {
+	Introduced int
+	Removed    int // the first release without it
+}
+
+type member struct {
+	Identifier string
+	Required   bool
+	Multiple   bool
+	IsID       bool
+	Update     string // base IE identifier
+	Delete     string // base IE identifier
+	Transient  bool
+	Comment    string
+}
+
+type memberSet struct {
+	Identifier string
+	Members    []member
+}
+
+type spec struct {
+	Ies        []ie
+	Messages   []message
+	Groups     []memberSet
+	MessageIes []memberSet
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// e.g. R16, or R14-R15 for the releases in which it was used, the profiles exist for R15 to R17
+var releasesPattern = regexp.MustCompile(`^R(1[4-7])(-R(1[4-7]))?$`)
+
+// parseReleases accepts an optional releases field
+func parseReleases(fields []string) (releases releases, err error) {
+	if len(fields) == 0 {
+		return
+	} else if match := releasesPattern.FindStringSubmatch(fields[0]); match == nil {
+		return releases, fmt.Errorf("invalid releases '%s'", fields[0])
+	} else if releases.Introduced, _ = strconv.Atoi(match[1]); match[3] == "" {
+		return
+	} else if last, _ := strconv.Atoi(match[3]); last < releases.Introduced {
+		return releases, fmt.Errorf("invalid releases '%s'", fields[0])
+	} else {
+		releases.Removed = last + 1
+		return
+	}
+}
+
+func cutComment(s string) (text, comment string) {
+	if before, after, found := strings.Cut(s, "#"); found {
+		return strings.TrimRight(before, " \t"), strings.TrimSpace(after)
+	} else {
+		return s, ""
+	}
+}
+
+func parseSpec(r io.Reader) (*spec, error) {
+	spec := &spec{}
+	var current *memberSet // the group or message IE set which member lines are added to
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		text, comment := cutComment(scanner.Text())
+		if err := spec.parseLine(text, comment, &current); err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNumber, err.Error())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return spec, spec.check()
+}
+
+func (spec *spec) parseLine(text, comment string, current **memberSet) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	} else if strings.HasPrefix(text, "\t") {
+		if *current == nil {
+			return fmt.Errorf("member line outside group or messageies")
+		} else if member, err := parseMember(strings.Fields(text), comment); err != nil {
+			return err
+		} else {
+			(*current).Members = append((*current).Members, member)
+			return nil
+		}
+	}
+
+	fields := strings.Split(text, "\t")
+	*current = nil
+	switch fields[0] {
+	case "ie":
+		if len(fields) != 6 && len(fields) != 7 {
+			return fmt.Errorf("ie needs 5 fields, and optionally the releases")
+		} else if typeCode, err := strconv.Atoi(fields[1]); err != nil || typeCode < 1 || typeCode > 0x7fff {
+			return fmt.Errorf("invalid IE type code '%s'", fields[1])
+		} else if releases, err := parseReleases(fields[6:]); err != nil {
+			return err
+		} else {
+			spec.Ies = append(spec.Ies, ie{TypeCode: typeCode, Identifier: fields[2], IeType: fields[3], Layout: fields[4], Name: fields[5], releases: releases, Comment: comment})
+		}
+	case "message":
+		if len(fields) != 4 && len(fields) != 5 {
+			return fmt.Errorf("message needs 3 fields, and optionally the releases")
+		} else if typeCode, err := strconv.Atoi(fields[1]); err != nil || typeCode < 1 || typeCode > 0xff {
+			return fmt.Errorf("invalid message type code '%s'", fields[1])
+		} else if releases, err := parseReleases(fields[4:]); err != nil {
+			return err
+		} else {
+			spec.Messages = append(spec.Messages, message{TypeCode: typeCode, Identifier: fields[2], Name: fields[3], releases: releases})
+		}
+	case "group":
+		if len(fields) != 2 {
+			return fmt.Errorf("group needs 1 field")
+		} else {
+			spec.Groups = append(spec.Groups, memberSet{Identifier: fields[1]})
+			*current = &spec.Groups[len(spec.Groups)-1]
+		}
+	case "messageies":
+		if len(fields) != 2 {
+			return fmt.Errorf("messageies needs 1 field")
+		} else {
+			spec.MessageIes = append(spec.MessageIes, memberSet{Identifier: fields[1]})
+			*current = &spec.MessageIes[len(spec.MessageIes)-1]
+		}
+	default:
+		return fmt.Errorf("unknown keyword '%s'", fields[0])
+	}
+	return nil
+}
+
+func parseMember(fields []string, comment string) (member member, err error) {
+	member.Identifier = fields[0]
+	member.Comment = comment
+	for _, field := range fields[1:] {
+		switch key, value, _ := strings.Cut(field, "="); key {
+		case "required":
+			member.Required = true
+		case "multiple":
+			member.Multiple = true
+		case "id":
+			member.IsID = true
+		case "update":
+			member.Update = value
+		case "delete":
+			member.Delete = value
+		case "transient":
+			member.Transient = true
+		default:
+			return member, fmt.Errorf("unknown member attribute '%s'", field)
+		}
+	}
+	return
+}
+
+// check enforces unique type codes and identifiers, and that every reference is to a declared IE or message
+func (spec *spec) check() error {
+	ieIdentifiers := map[string]struct{}{}
+	ieTypeCodes := map[int]struct{}{}
+	for _, ie := range spec.Ies {
+		if !identifierPattern.MatchString(ie.Identifier) || !identifierPattern.MatchString(ie.IeType) {
+			return fmt.Errorf("IE %d: invalid identifier or type", ie.TypeCode)
+		} else if !ie.IsGroup() && !identifierPattern.MatchString(ie.Layout) {
+			return fmt.Errorf("IE %d: invalid layout", ie.TypeCode)
+		} else if _, found := ieIdentifiers[ie.Identifier]; found {
+			return fmt.Errorf("IE %d: duplicate identifier %s", ie.TypeCode, ie.Identifier)
+		} else if _, found := ieTypeCodes[ie.TypeCode]; found {
+			return fmt.Errorf("IE %d: duplicate type code", ie.TypeCode)
+		}
+		ieIdentifiers[ie.Identifier] = struct{}{}
+		ieTypeCodes[ie.TypeCode] = struct{}{}
+	}
+
+	messageIdentifiers := map[string]struct{}{}
+	messageTypeCodes := map[int]struct{}{}
+	for _, message := range spec.Messages {
+		if !identifierPattern.MatchString(message.Identifier) {
+			return fmt.Errorf("message %d: invalid identifier", message.TypeCode)
+		} else if _, found := messageIdentifiers[message.Identifier]; found {
+			return fmt.Errorf("message %d: duplicate identifier %s", message.TypeCode, message.Identifier)
+		} else if _, found := ieIdentifiers[message.Identifier]; found {
+			return fmt.Errorf("message %d: identifier %s is also an IE", message.TypeCode, message.Identifier)
+		} else if _, found := messageTypeCodes[message.TypeCode]; found {
+			return fmt.Errorf("message %d: duplicate type code", message.TypeCode)
+		}
+		messageIdentifiers[message.Identifier] = struct{}{}
+		messageTypeCodes[message.TypeCode] = struct{}{}
+	}
+
+	groupIes := map[string]struct{}{}
+	for _, ie := range spec.Ies {
+		if ie.IsGroup() {
+			groupIes[ie.Identifier] = struct{}{}
+		}
+	}
+
+	if err := checkMemberSets(spec.Groups, groupIes, ieIdentifiers); err != nil {
+		return fmt.Errorf("group %s", err.Error())
+	} else if err := checkMemberSets(spec.MessageIes, messageIdentifiers, ieIdentifiers); err != nil {
+		return fmt.Errorf("messageies %s", err.Error())
+	}
+	for _, set := range spec.Groups {
+		delete(groupIes, set.Identifier)
+	}
+	for _, ie := range spec.Ies {
+		if _, found := groupIes[ie.Identifier]; found {
+			return fmt.Errorf("IE %d: grouped IE %s has no member list", ie.TypeCode, ie.Identifier)
+		}
+	}
+	return nil
+}
+
+func checkMemberSets(sets []memberSet, setIdentifiers, ieIdentifiers map[string]struct{}) error {
+	seen := map[string]struct{}{}
+	for _, set := range sets {
+		if _, found := setIdentifiers[set.Identifier]; !found {
+			return fmt.Errorf("%s: not declared, or not a grouped IE", set.Identifier)
+		} else if _, found := seen[set.Identifier]; found {
+			return fmt.Errorf("%s: declared twice", set.Identifier)
+		}
+		seen[set.Identifier] = struct{}{}
+
+		members := map[string]struct{}{}
+		for _, member := range set.Members {
+			if _, found := members[member.Identifier]; found {
+				return fmt.Errorf("%s: duplicate member %s", set.Identifier, member.Identifier)
+			} else if member.Update != "" && member.Delete != "" {
+				return fmt.Errorf("%s: member %s is both update and delete", set.Identifier, member.Identifier)
+			}
+			members[member.Identifier] = struct{}{}
+			for _, identifier := range []string{member.Identifier, member.Update, member.Delete} {
+				if _, found := ieIdentifiers[identifier]; identifier != "" && !found {
+					return fmt.Errorf("%s: member %s is not a declared IE", set.Identifier, identifier)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type scalarLayout struct {
+	GoType  string
+	Decoder string
+	Encoder string
+}
+
+var scalarLayouts = map[string]scalarLayout{
+	"u8":     {"uint8", "decodeUint8", "Encode_Uint8"},
+	"u16":    {"uint16", "decodeUint16", "Encode_Uint16"},
+	"u32":    {"uint32", "decodeUint32", "Encode_Uint32"},
+	"u64":    {"uint64", "decodeUint64", "Encode_Uint64"},
+	"octets": {"[]byte", "decodeOctets", "encodeOctets"},
+	"string": {"string", "decodeString", "encodeString"},
+}
+
+func (ie ie) IsGroup() bool {
+	return ie.Layout == "-"
+}
+
+// Scalar is nil when the layout is an embedded value type
+func (ie ie) Scalar() *scalarLayout {
+	if scalar, found := scalarLayouts[ie.Layout]; found {
+		return &scalar
+	} else {
+		return nil
+	}
+}
+
+// Attributes renders the groupIeAttributes literal for the member
+func (member member) Attributes() string {
+	var attributes []string
+	if member.Required {
+		attributes = append(attributes, "required: true")
+	}
+	if member.Multiple {
+		attributes = append(attributes, "multiple: true")
+	}
+	if member.IsID {
+		attributes = append(attributes, "isID: true")
+	}
+	if member.Update != "" {
+		attributes = append(attributes, "isUpdate: true", "baseIe: "+member.Update)
+	}
+	if member.Delete != "" {
+		attributes = append(attributes, "isDelete: true", "baseIe: "+member.Delete)
+	}
+	if member.Transient {
+		attributes = append(attributes, "transient: true")
+	}
+	return "groupIeAttributes{" + strings.Join(attributes, ", ") + "}"
+}
@@ -4,45 +4,27 @@
 package main
 
 import (
+	_ "embed"
 	"fmt"
 	"go/format"
-	"os"
 	"strings"
 	"text/template"
 )
 
-const (
-	templateName = "root_template"
-	outputFile   = "generated/ie.go"
-	templateFile = "templates/ie.template"
-)
-
-func Codegen(table []line) {
-	fmt.Println("Codegen")
-	compiled_template := template.Must(template.ParseFiles(templateFile))
-
-	var code strings.Builder
-
-	if err := compiled_template.ExecuteTemplate(&code, templateName, table); err != nil {
-		fmt.Printf("template execution failed for %s, %s\n", templateName, err)
-		return
-	} else {
-		fmt.Printf("template execution succeeded for %s\n", templateName)
-	}
+const templateName = "root_template"
 
-	output := []byte(code.String())
+//go:embed catalogue.template
+var catalogueTemplate string
 
-	formatted_output, error := format.Source(output)
-	if error == nil {
-		output = formatted_output
-		fmt.Println("generated code passes syntax analysis - formatted")
-	} else {
-		fmt.Println("generated code fails syntax analysis - unformatted")
-	}
+func Codegen(spec *spec) ([]byte, error) {
+	compiledTemplate := template.Must(template.New(templateName).Parse(catalogueTemplate))
 
-	if os.WriteFile(outputFile, output, 0o644) == nil {
-		fmt.Printf(" code written to %s\n", outputFile)
+	var code strings.Builder
+	if err := compiledTemplate.ExecuteTemplate(&code, templateName, spec); err != nil {
+		return nil, fmt.Errorf("template execution failed for %s, %s", templateName, err)
+	} else if formatted, err := format.Source([]byte(code.String())); err != nil {
+		return nil, fmt.Errorf("generated code fails syntax analysis, %s", err)
 	} else {
-		fmt.Printf(" code output to %s could not be written ****\n", outputFile)
+		return formatted, nil
 	}
 }
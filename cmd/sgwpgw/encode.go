@@ -25,7 +25,7 @@ func encodeSer(csv *CommonStateValue) (ies []pfcp.IeNode) {
 	return []pfcp.IeNode{
 		b.DecapPdr(b.ModeCreate, csv.accessPdr.PfcpFteid()),
 		b.DecapFar(b.ModeCreate),
-		b.EncapPdr(b.ModeCreate, csv.corePdr),
+		b.EncapPdr(b.ModeCreate, csv.corePdr.Addrs()...),
 		encapFar,
 	}
 }
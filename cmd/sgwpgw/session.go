@@ -25,7 +25,7 @@ type SgwSessionState struct {
 
 type PgwSessionState struct {
 	// PGW only defines a core ingress rule
-	corePdr pfcp.IpV4
+	corePdr pfcp.UeIpAddress
 }
 
 type XgwSessionState interface {
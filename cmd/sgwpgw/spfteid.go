@@ -11,23 +11,26 @@ package main
 
 import (
 	"fmt"
+	"net/netip"
 
 	"pfcpcore/pfcp"
 )
 
+// spFteid carries either or both of IPv4 and IPv6, the absent address is the zero netip.Addr, so spFteid remains comparable.
 type spFteid struct {
 	pfcp.TEID
-	pfcp.IpV4
+	IpV4, IpV6 netip.Addr
 }
 
 func (spFteid spFteid) String() string {
-	return fmt.Sprintf("%s:%s", spFteid.IpV4, spFteid.TEID)
+	return fmt.Sprintf("%s:%s", spFteid.PfcpFteid().Addrs(), spFteid.TEID)
 }
 
 func (spFteid spFteid) PfcpFteid() pfcp.FTeid {
 	return pfcp.FTeid{
 		Teid: &spFteid.TEID,
-		IpV4: &spFteid.IpV4,
+		IpV4: spFteid.IpV4,
+		IpV6: spFteid.IpV6,
 	}
 }
 
@@ -35,19 +38,20 @@ var (
 	spFteidZero = spFteid{}
 	spFteidNull = spFteid{
 		TEID: 0xffffffff,
-		IpV4: 0xffffffff,
+		IpV4: netip.AddrFrom4([4]byte{0xff, 0xff, 0xff, 0xff}),
 	}
 )
 
 func coerce(pfcpFteid pfcp.FTeid) (spFteid, error) {
-	if pfcpFteid.IpV4 == nil {
-		return spFteidZero, fmt.Errorf("ipv4 field was nil")
+	if !pfcpFteid.IpV4.IsValid() && !pfcpFteid.IpV6.IsValid() {
+		return spFteidZero, fmt.Errorf("ip address fields were empty")
 	} else if pfcpFteid.Teid == nil {
 		return spFteidZero, fmt.Errorf("teid field was nil")
 	} else {
 		return spFteid{
 			TEID: *pfcpFteid.Teid,
-			IpV4: *pfcpFteid.IpV4,
+			IpV4: pfcpFteid.IpV4,
+			IpV6: pfcpFteid.IpV6,
 		}, nil
 	}
 }
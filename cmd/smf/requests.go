@@ -68,18 +68,18 @@ type SgwData struct {
 }
 
 func (SessionData *SessionData) upfFteid() pfcp.FTeid {
-	return *pfcp.NewFTeid(SessionData.TeidUl, pfcp.NetIpAddrToIpV4(SessionData.UpfIpv4))
+	return *pfcp.NewFTeid(SessionData.TeidUl, SessionData.UpfIpv4)
 }
 
 func (SessionData *SessionData) eNbFteid() pfcp.FTeid {
-	return *pfcp.NewFTeid(SessionData.TeidDl, pfcp.NetIpAddrToIpV4(SessionData.EnbIpv4))
+	return *pfcp.NewFTeid(SessionData.TeidDl, SessionData.EnbIpv4)
 }
 
 func (SessionData *SessionData) sgwCoreFteid() pfcp.FTeid {
 	if SessionData.SgwData == nil {
 		panic("no sgw present")
 	} else {
-		return *pfcp.NewFTeid(SessionData.SgwData.TeidSgw, pfcp.NetIpAddrToIpV4(SessionData.SgwData.SgwIpv4))
+		return *pfcp.NewFTeid(SessionData.SgwData.TeidSgw, SessionData.SgwData.SgwIpv4)
 	}
 }
 
@@ -87,12 +87,12 @@ func (SessionData *SessionData) pgwCoreFteid() pfcp.FTeid {
 	if SessionData.SgwData == nil {
 		panic("no sgw present")
 	} else {
-		return *pfcp.NewFTeid(SessionData.SgwData.TeidPgw, pfcp.NetIpAddrToIpV4(SessionData.SgwData.PgwIpv4))
+		return *pfcp.NewFTeid(SessionData.SgwData.TeidPgw, SessionData.SgwData.PgwIpv4)
 	}
 }
 
-func (SessionData *SessionData) ueIp() pfcp.IpV4 {
-	return pfcp.NetIpAddrToIpV4(SessionData.UeIpv4)
+func (SessionData *SessionData) ueIp() netip.Addr {
+	return SessionData.UeIpv4
 }
 
 type UeSessionRequest struct {
@@ -110,7 +110,7 @@ func (ueSessionRequest *UeSessionRequest) next() {
 
 func getLocalNodeId() pfcp.IeNode {
 	if send_nodeID_as_IPv4 {
-		return pfcp.IE_NodeIdIpV4(nodeIp)
+		return pfcp.IE_NodeIdIp(nodeIp)
 	} else {
 		return pfcp.IE_NodeIdFqdn(nodeId)
 	}
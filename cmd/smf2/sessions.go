@@ -5,6 +5,7 @@ package main
 
 import (
 	"fmt"
+	"net/netip"
 	"strconv"
 	"strings"
 
@@ -33,7 +34,7 @@ type Session struct {
 	eNbFteid     pfcp.FTeid
 	upfFteid     pfcp.FTeid
 	pgwCoreFteid pfcp.FTeid
-	ueIp         pfcp.IpV4
+	ueIp         netip.Addr
 }
 
 var session1 = Session{
@@ -41,7 +42,7 @@ var session1 = Session{
 	eNbFteid:     mustParseFTeid("172.19.0.2:20001"),
 	upfFteid:     mustParseFTeid("172.19.0.1:10001"),
 	pgwCoreFteid: mustParseFTeid("172.19.0.4:40001"),
-	ueIp:         pfcp.MustParseAddr("10.0.100.1"),
+	ueIp:         netip.MustParseAddr("10.0.100.1"),
 }
 
 func mustParseFTeid(s string) pfcp.FTeid {
@@ -55,14 +56,13 @@ func mustParseFTeid(s string) pfcp.FTeid {
 func parseFTeid(s string) (fteid pfcp.FTeid, err error) {
 	if sx := strings.Split(s, ":"); len(sx) != 2 {
 		err = fmt.Errorf("failed to parse FTeid=\"%s\" as a pair of IPv4:uint32", s)
-	} else if ip, e := pfcp.ParseAddr(sx[0]); e != nil {
+	} else if ip, e := netip.ParseAddr(sx[0]); e != nil {
 		err = fmt.Errorf("failed to parse \"%s\" as an IPv4 ()%s", sx[0], e.Error())
 	} else if teidInt, e := strconv.Atoi(sx[1]); err != nil {
 		err = fmt.Errorf("failed to parse \"%s\" as an int ()%s", sx[1], e.Error())
 	} else {
 		teid := pfcp.TEID(teidInt)
-		fteid.IpV4 = &ip
-		fteid.Teid = &teid
+		fteid = *pfcp.NewFTeid(teid, ip)
 	}
 	return
 }
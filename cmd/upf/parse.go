@@ -31,8 +31,8 @@ func ParseUpfSER(ser *pfcp.IeNode) (*PgwSessionState, error) {
 			return nil, err
 		} else if accessPdr, err := accessPdr.GetByTc(pfcp.PDI).GetByTc(pfcp.F_TEID).DeserialiseFTeid(); err != nil {
 			return nil, err
-		} else if accessPdr.IpV4 == nil {
-			return nil, fmt.Errorf("missing ipv4 in FTEID")
+		} else if !accessPdr.IpV4.IsValid() && !accessPdr.IpV6.IsValid() {
+			return nil, fmt.Errorf("missing ip address in FTEID")
 		} else if accessPdr.Teid == nil {
 			return nil, fmt.Errorf("missing teid in FTEID")
 		} else {
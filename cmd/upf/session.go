@@ -11,7 +11,7 @@ import (
 
 type PgwSessionState struct {
 	accessPdr, coreFar pfcp.FTeid
-	corePdr            pfcp.IpV4
+	corePdr            pfcp.UeIpAddress
 }
 
 func (PgwSessionState PgwSessionState) String() string {
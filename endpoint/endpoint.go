@@ -4,6 +4,7 @@
 package endpoint
 
 import (
+	"context"
 	"net/netip"
 
 	"pfcpcore/pfcp"
@@ -14,6 +15,7 @@ import (
 // TDOD consider if this wrapper is really needed around UdpServer
 type PfcpEndpoint struct {
 	*udpserver.UdpServer
+	ValidationProfile *pfcp.ValidationProfile // used by the transport of every peer
 }
 
 type PfcpPeer struct {
@@ -33,11 +35,16 @@ func NewPfcpEndpointByName(addrPortString string) (pfcpEndpoint *PfcpEndpoint, e
 }
 
 func NewPfcpEndpoint(addrPort netip.AddrPort) (pfcpEndpoint *PfcpEndpoint, err error) {
+	return NewPfcpEndpointWithProfile(addrPort, pfcp.DefaultProfile)
+}
+
+func NewPfcpEndpointWithProfile(addrPort netip.AddrPort, profile *pfcp.ValidationProfile) (pfcpEndpoint *PfcpEndpoint, err error) {
 	if udpServer, err := udpserver.NewUDPServer(addrPort); err != nil {
 		return nil, err
 	} else {
 		pfcpEndpoint = &PfcpEndpoint{
-			UdpServer: udpServer,
+			UdpServer:         udpServer,
+			ValidationProfile: profile,
 		}
 	}
 	return
@@ -51,18 +58,21 @@ func (PfcpPeer *PfcpPeer) Drop() {
 
 func (pfcpEndpoint *PfcpEndpoint) Peer(addrPort netip.AddrPort) *PfcpPeer {
 	udpPeer := pfcpEndpoint.UdpServer.Register(addrPort)
+	pfcpPeer := NewPfcpPeer(udpPeer, pfcpEndpoint.ValidationProfile)
+	pfcpPeer.UdpServer = pfcpEndpoint.UdpServer
+	pfcpPeer.UdpServerPeer = udpPeer
+	return pfcpPeer
+}
+
+// NewPfcpPeer is a peer on any datagram service, e.g. a simulated network, in which case it has no UdpServer
+func NewPfcpPeer(datagramPeer udpserver.DatagramPeer, profile *pfcp.ValidationProfile, options ...transport.Option) *PfcpPeer {
 	requestChan := make(chan transport.PeerRequest)
 	responseChan := make(chan transport.RequestReturn)
-	transport := transport.NewTransport(udpPeer, requestChan)
-
-	pfcpPeer := &PfcpPeer{
-		UdpServer:     pfcpEndpoint.UdpServer,
-		UdpServerPeer: udpPeer,
-		Transport:     transport,
-		RequestChan:   requestChan,
-		ResponseChan:  responseChan,
+	return &PfcpPeer{
+		Transport:    transport.NewTransportWithProfile(datagramPeer, requestChan, profile, options...),
+		RequestChan:  requestChan,
+		ResponseChan: responseChan,
 	}
-	return pfcpPeer
 }
 
 func (pfcpPeer *PfcpPeer) EnterRequest(pfcpMessage *pfcp.PfcpMessage) {
@@ -72,3 +82,8 @@ func (pfcpPeer *PfcpPeer) EnterRequest(pfcpMessage *pfcp.PfcpMessage) {
 func (pfcpPeer *PfcpPeer) BlockingRequest(pfcpMessage *pfcp.PfcpMessage) (*pfcp.PfcpMessage, error) {
 	return pfcpPeer.Transport.BlockingRequest(pfcpMessage)
 }
+
+// RequestContext is BlockingRequest which is cancelled with the context
+func (pfcpPeer *PfcpPeer) RequestContext(ctx context.Context, pfcpMessage *pfcp.PfcpMessage) (*pfcp.PfcpMessage, error) {
+	return pfcpPeer.Transport.RequestContext(ctx, pfcpMessage)
+}
@@ -4,8 +4,11 @@
 package endpoint_test
 
 import (
+	"context"
+	"errors"
 	"net/netip"
 	"testing"
+	"time"
 
 	"pfcpcore/endpoint"
 	"pfcpcore/pfcp"
@@ -121,3 +124,34 @@ func TestDynamicEndpoint(t *testing.T) {
 		t.Errorf(activeExitStatus.Error())
 	}
 }
+
+// endpoints for peers of different releases in the same process
+func TestEndpointProfiles(t *testing.T) {
+	for _, profile := range []*pfcp.ValidationProfile{pfcp.ProfileR15, pfcp.ProfileR16} {
+		if pfcpEndpoint, err := endpoint.NewPfcpEndpointWithProfile(testcases.AddrFactory(), profile); err != nil {
+			t.Fatal(err)
+		} else if peer := pfcpEndpoint.Peer(testcases.AddrFactory()); peer.Transport.ValidationProfile != profile {
+			t.Errorf("peer transport does not use the %s profile", profile.Name)
+		} else {
+			peer.Drop()
+		}
+	}
+}
+
+// a request to a peer which does not answer ends with the context
+func TestRequestContext(t *testing.T) {
+	if local, err := endpoint.NewPfcpEndpoint(testcases.AddrFactory()); err != nil {
+		t.Fatal(err)
+	} else {
+		peer := local.Peer(testcases.AddrFactory())
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		request := pfcp.HeartBeatRequest
+		if _, err := peer.RequestContext(ctx, &request); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("request returned %v", err)
+		} else if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("request returned after %s", elapsed)
+		}
+	}
+}
@@ -62,8 +62,71 @@ func (state *PfcpAssociationState) serviceAssociationSetupRequest(ser *IeNode) [
 	}
 }
 
-func (*PfcpAssociationState) serviceAssociationReleaseRequest(*IeNode) []IeNode {
-	return nil
+// the association ends, so its sessions are deleted, and the peer must set up a new association
+func (state *PfcpAssociationState) serviceAssociationReleaseRequest(arr *IeNode) []IeNode {
+	if nodeId, err := arr.Getter().GetByTc(pfcp.Node_ID).DeserialiseNodeIdString(); err != nil {
+		return CauseUnknown
+	} else if nodeId != state.PeerName {
+		log.Warnf("association release request from %s, but the association is with %s", nodeId, state.PeerName)
+		return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.NoEstablishedPFCPAssociation)}
+	} else {
+		upfSeids := state.SessionStateStore.RemoveAll()
+		for _, upfSeid := range upfSeids {
+			if _, err := state.Application.CallbackSessionDeletionRequest(upfSeid); err != nil {
+				log.Errorf("deletion of session %s on association release failed, %s", upfSeid, err.Error())
+			}
+		}
+		log.Infof("association with %s released, %d sessions deleted", state.PeerName, len(upfSeids))
+		state.PeerName = ""
+		state.peerRecoveryTime = 0
+		return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.CauseAccepted)}
+	}
+}
+
+func (state *PfcpAssociationState) serviceAssociationUpdateRequest(aur *IeNode) []IeNode {
+	root := aur.Getter()
+	if nodeId, err := root.GetByTc(pfcp.Node_ID).DeserialiseNodeIdString(); err != nil {
+		return CauseUnknown
+	} else if nodeId != state.PeerName {
+		log.Warnf("association update request from %s, but the association is with %s", nodeId, state.PeerName)
+		return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.NoEstablishedPFCPAssociation)}
+	} else {
+		return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.CauseAccepted)}
+	}
+}
+
+// PFDs are not used by this node, the request is acknowledged only
+func (state *PfcpAssociationState) servicePfdManagementRequest(pfdReq *IeNode) []IeNode {
+	applications := 0
+	for _, ie := range *pfdReq.Ies() {
+		if ie.IeTypeCode == pfcp.Application_IDs_PFDs {
+			applications++
+		}
+	}
+	log.Debugf("PFD management request for %d applications", applications)
+	return []IeNode{pfcp.IE_Cause(pfcp.CauseAccepted), state.nodeIdIe()}
+}
+
+func (state *PfcpAssociationState) serviceNodeReportRequest(nrReq *IeNode) []IeNode {
+	var nodeReportType pfcp.T_Node_Report_Type
+	if err := nrReq.Getter().GetByTc(pfcp.Node_Report_Type).DecodeInto(&nodeReportType); err != nil {
+		return CauseUnknown
+	} else {
+		log.Infof("node report from %s, report type %b", state.PeerName, nodeReportType.Bits)
+		return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.CauseAccepted)}
+	}
+}
+
+// sessions are not grouped by FQ-CSID in the session store, so there is no set of sessions to delete or modify,
+// and the requests are rejected rather than accepted without effect
+func (state *PfcpAssociationState) serviceSessionSetDeletionRequest(*IeNode) []IeNode {
+	log.Warn("session set deletion request rejected, FQ-CSIDs are not tracked")
+	return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.ServiceNotSupported)}
+}
+
+func (state *PfcpAssociationState) serviceSessionSetModificationRequest(*IeNode) []IeNode {
+	log.Warn("session set modification request rejected, FQ-CSIDs are not tracked")
+	return []IeNode{state.nodeIdIe(), pfcp.IE_Cause(pfcp.ServiceNotSupported)}
 }
 
 func (state *PfcpAssociationState) serviceHeartbeatRequest(hbReq *IeNode) []IeNode {
@@ -174,6 +237,8 @@ func NewPfcpAssociationState(config PfcpAssociationConfig) *PfcpAssociationState
 		SessionStateStore:     session.NewSessionStateStore(),
 	}
 
+	config.PeerEndpoint.SetLocalNodeId(state.nodeIdIe())
+
 	updateStats := func(tc pfcp.MessageTypeCode) {
 		n := state.requestStats[tc]
 		state.requestStats[tc] = n + 1
@@ -194,6 +259,31 @@ func NewPfcpAssociationState(config PfcpAssociationConfig) *PfcpAssociationState
 				reply := pfcp.NewNodeMessage(pfcp.PFCP_Association_Release_Response, response...)
 				config.PeerEndpoint.EnterResponse(reply, m)
 
+			case pfcp.PFCP_Association_Update_Request:
+				response := state.serviceAssociationUpdateRequest(m.Message.Node())
+				reply := pfcp.NewNodeMessage(pfcp.PFCP_Association_Update_Response, response...)
+				config.PeerEndpoint.EnterResponse(reply, m)
+
+			case pfcp.PFCP_PFD_Management_Request:
+				response := state.servicePfdManagementRequest(m.Message.Node())
+				reply := pfcp.NewNodeMessage(pfcp.PFCP_PFD_Management_Response, response...)
+				config.PeerEndpoint.EnterResponse(reply, m)
+
+			case pfcp.PFCP_Node_Report_Request:
+				response := state.serviceNodeReportRequest(m.Message.Node())
+				reply := pfcp.NewNodeMessage(pfcp.PFCP_Node_Report_Response, response...)
+				config.PeerEndpoint.EnterResponse(reply, m)
+
+			case pfcp.PFCP_Session_Set_Deletion_Request:
+				response := state.serviceSessionSetDeletionRequest(m.Message.Node())
+				reply := pfcp.NewNodeMessage(pfcp.PFCP_Session_Set_Deletion_Response, response...)
+				config.PeerEndpoint.EnterResponse(reply, m)
+
+			case pfcp.PFCP_Session_Set_Modification_Request:
+				response := state.serviceSessionSetModificationRequest(m.Message.Node())
+				reply := pfcp.NewNodeMessage(pfcp.PFCP_Session_Set_Modification_Response, response...)
+				config.PeerEndpoint.EnterResponse(reply, m)
+
 			case pfcp.PFCP_Heartbeat_Request:
 				response := state.serviceHeartbeatRequest(m.Message.Node())
 				reply := pfcp.NewNodeMessage(pfcp.PFCP_Heartbeat_Response, response...)
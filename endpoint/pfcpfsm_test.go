@@ -41,3 +41,43 @@ func TestPfcpFsm(t *testing.T) {
 		upfFsm.Drop()
 	}
 }
+
+func TestPfcpFsmNodeMessages(t *testing.T) {
+	if peer1, peer2, err := testcases.MakeTestPeers(); err != nil {
+		t.Errorf(err.Error())
+	} else {
+		upfFsm := endpoint.NewPfcpAssociationState(endpoint.PfcpAssociationConfig{
+			NodeName:               "upf",
+			LocalSignallingAddress: netip.MustParseAddr("169.254.169.252"),
+			Application:            session.DefaultApplication{},
+			PeerEndpoint:           peer2,
+		})
+		defer upfFsm.Drop()
+
+		smfNodeId := pfcp.IE_NodeIdFqdn("smf")
+		for _, test := range []struct {
+			request *pfcp.PfcpMessage
+			cause   uint8
+		}{
+			{pfcp.NewNodeMessage(pfcp.PFCP_Association_Setup_Request, smfNodeId, pfcp.IE_RecoveryTimeStamp(1)), pfcp.CauseAccepted},
+			{pfcp.NewNodeMessage(pfcp.PFCP_Association_Update_Request, smfNodeId), pfcp.CauseAccepted},
+			{pfcp.NewNodeMessage(pfcp.PFCP_PFD_Management_Request, smfNodeId), pfcp.CauseAccepted},
+			{pfcp.NewNodeMessage(pfcp.PFCP_Node_Report_Request, smfNodeId, pfcp.IE_Typed(&pfcp.T_Node_Report_Type{Flags: pfcp.NewFlags(0)})), pfcp.CauseAccepted},
+			{pfcp.NewNodeMessage(pfcp.PFCP_Session_Set_Deletion_Request, smfNodeId), pfcp.ServiceNotSupported},
+			{pfcp.NewNodeMessage(pfcp.PFCP_Session_Set_Modification_Request, *pfcp.NewIeNode(pfcp.Alternative_SMF_IP_Address, []byte{0x02, 192, 0, 2, 1})), pfcp.ServiceNotSupported},
+			{pfcp.NewNodeMessage(pfcp.PFCP_Association_Release_Request, smfNodeId), pfcp.CauseAccepted},
+			// the association has ended
+			{pfcp.NewNodeMessage(pfcp.PFCP_Association_Update_Request, smfNodeId), pfcp.NoEstablishedPFCPAssociation},
+			{pfcp.NewNodeMessage(pfcp.PFCP_Association_Release_Request, smfNodeId), pfcp.NoEstablishedPFCPAssociation},
+		} {
+			request := test.request
+			if response, err := peer1.BlockingRequest(request); err != nil {
+				t.Errorf("%s failed: %s", request.TypeCode(), err.Error())
+			} else if response.TypeCode() != request.TypeCode()+1 {
+				t.Errorf("%s answered with %s", request.TypeCode(), response.TypeCode())
+			} else if cause, err := response.Node().ReadCauseCode(); err != nil || cause != test.cause {
+				t.Errorf("%s answered with cause %d, expected %d", request.TypeCode(), cause, test.cause)
+			}
+		}
+	}
+}
@@ -6,10 +6,10 @@ require (
 	github.com/google/gopacket v1.1.19
 	github.com/sirupsen/logrus v1.9.3
 	golang.org/x/exp v0.0.0-20240119083558-1b970713d09a
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	golang.org/x/net v0.20.0 // indirect
 	golang.org/x/sys v0.16.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
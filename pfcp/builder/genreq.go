@@ -4,6 +4,8 @@
 package builder
 
 import (
+	"net/netip"
+
 	"pfcpcore/pfcp"
 )
 
@@ -68,7 +70,7 @@ func DecapPdr(mode RequestMode, fteid pfcp.FTeid) pfcp.IeNode {
 		pfcp.IE_Precedence(defaultPdrPrecedence),
 		pfcp.IE_Pdi(
 			pfcp.IE_SourceInterface(pfcp.EnumAccess),
-			pfcp.IE_FTeid_IpV4(*fteid.Teid, fteid.IpV4.Addr()),
+			pfcp.IE_FTeid_Ip(*fteid.Teid, fteid.Addrs()...),
 			pfcp.IE_NetworkInstance(networkInstance),
 		),
 		pfcp.IE_OuterHeaderRemoval(),
@@ -87,14 +89,14 @@ func DecapFar(mode RequestMode) pfcp.IeNode {
 	)
 }
 
-func EncapPdr(mode RequestMode, ueIp pfcp.IpV4) pfcp.IeNode {
+func EncapPdr(mode RequestMode, ueIps ...netip.Addr) pfcp.IeNode {
 	return mode.makePdr()(
 		pfcp.IE_PdrId(uint16(RoleCore)),
 		pfcp.IE_Precedence(defaultPdrPrecedence),
 		pfcp.IE_Pdi(
 			pfcp.IE_SourceInterface(pfcp.EnumCore),
 			pfcp.IE_NetworkInstance(networkInstance),
-			pfcp.IE_UeIpAddress(ueIp.Addr()),
+			pfcp.IE_UeIpAddress(ueIps...),
 		),
 		pfcp.IE_FarId(uint32(RoleCore)),
 	)
@@ -107,7 +109,7 @@ func EncapFar(mode RequestMode, fteid pfcp.FTeid) pfcp.IeNode {
 		pfcp.IE_ForwardingParameters(
 			pfcp.IE_DestinationInterface(pfcp.EnumAccess),
 			pfcp.IE_NetworkInstance(networkInstance),
-			pfcp.IE_OuterHeaderCreation(*fteid.Teid, fteid.IpV4.Addr()),
+			pfcp.IE_OuterHeaderCreation(*fteid.Teid, fteid.Addrs()...),
 		),
 	)
 }
@@ -120,7 +122,7 @@ func TunnelPdr(mode RequestMode, role InterfaceRole, fteid pfcp.FTeid) pfcp.IeNo
 		pfcp.IE_Precedence(defaultPdrPrecedence),
 		pfcp.IE_Pdi(
 			pfcp.IE_SourceInterface(sourceInterface),
-			pfcp.IE_FTeid_IpV4(*fteid.Teid, fteid.IpV4.Addr()),
+			pfcp.IE_FTeid_Ip(*fteid.Teid, fteid.Addrs()...),
 			pfcp.IE_NetworkInstance(networkInstance),
 		),
 		pfcp.IE_FarId(uint32(role)),
@@ -135,7 +137,7 @@ func CustomOhcFar(farId uint32, mode RequestMode, destinationInterface pfcp.Enum
 		pfcp.IE_ForwardingParameters(
 			pfcp.IE_DestinationInterface(destinationInterface),
 			pfcp.IE_NetworkInstance(networkInstance),
-			pfcp.IE_OuterHeaderCreation(*fteid.Teid, fteid.IpV4.Addr()),
+			pfcp.IE_OuterHeaderCreation(*fteid.Teid, fteid.Addrs()...),
 		),
 	)
 }
@@ -146,7 +148,7 @@ func CustomOhcPdr(pdrId uint16, farId uint32, mode RequestMode, sourceInterface
 		pfcp.IE_Precedence(defaultPdrPrecedence),
 		pfcp.IE_Pdi(
 			pfcp.IE_SourceInterface(sourceInterface),
-			pfcp.IE_FTeid_IpV4(*fteid.Teid, fteid.IpV4.Addr()),
+			pfcp.IE_FTeid_Ip(*fteid.Teid, fteid.Addrs()...),
 			pfcp.IE_NetworkInstance(networkInstance),
 		),
 		pfcp.IE_FarId(farId),
@@ -162,7 +164,7 @@ func TunnelFar(mode RequestMode, role InterfaceRole, fteid pfcp.FTeid) pfcp.IeNo
 		pfcp.IE_ForwardingParameters(
 			pfcp.IE_DestinationInterface(destinationInterface),
 			pfcp.IE_NetworkInstance(networkInstance),
-			pfcp.IE_OuterHeaderCreation(*fteid.Teid, fteid.IpV4.Addr()),
+			pfcp.IE_OuterHeaderCreation(*fteid.Teid, fteid.Addrs()...),
 		),
 	)
 }
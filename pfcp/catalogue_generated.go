@@ -0,0 +1,4946 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+
+// Code generated by cmd/codegen from ts29244.spec. DO NOT EDIT.
+
+package pfcp
+
+const (
+	Create_PDR                                            IeTypeCode = 1
+	PDI                                                   IeTypeCode = 2
+	Create_FAR                                            IeTypeCode = 3
+	Forwarding_Parameters                                 IeTypeCode = 4
+	Duplicating_Parameters                                IeTypeCode = 5
+	Create_URR                                            IeTypeCode = 6
+	Create_QER                                            IeTypeCode = 7
+	Created_PDR                                           IeTypeCode = 8
+	Update_PDR                                            IeTypeCode = 9
+	Update_FAR                                            IeTypeCode = 10
+	Update_Forwarding_Parameters                          IeTypeCode = 11
+	Update_BAR_SRRsp                                      IeTypeCode = 12
+	Update_URR                                            IeTypeCode = 13
+	Update_QER                                            IeTypeCode = 14
+	Remove_PDR                                            IeTypeCode = 15
+	Remove_FAR                                            IeTypeCode = 16
+	Remove_URR                                            IeTypeCode = 17
+	Remove_QER                                            IeTypeCode = 18
+	Cause                                                 IeTypeCode = 19
+	Source_Interface                                      IeTypeCode = 20
+	F_TEID                                                IeTypeCode = 21
+	Network_Instance                                      IeTypeCode = 22
+	SDF_Filter                                            IeTypeCode = 23
+	Application_ID                                        IeTypeCode = 24
+	Gate_Status                                           IeTypeCode = 25
+	MBR                                                   IeTypeCode = 26
+	GBR                                                   IeTypeCode = 27
+	QER_Correlation_ID                                    IeTypeCode = 28
+	Precedence                                            IeTypeCode = 29
+	Transport_Level_Marking                               IeTypeCode = 30
+	Volume_Threshold                                      IeTypeCode = 31
+	Time_Threshold                                        IeTypeCode = 32
+	Monitoring_Time                                       IeTypeCode = 33
+	Subsequent_Volume_Threshold                           IeTypeCode = 34
+	Subsequent_Time_Threshold                             IeTypeCode = 35
+	Inactivity_Detection_Time                             IeTypeCode = 36
+	Reporting_Triggers                                    IeTypeCode = 37
+	Redirect_Information                                  IeTypeCode = 38
+	Report_Type                                           IeTypeCode = 39
+	Offending_IE                                          IeTypeCode = 40
+	Forwarding_Policy                                     IeTypeCode = 41
+	Destination_Interface                                 IeTypeCode = 42
+	UP_Function_Features                                  IeTypeCode = 43
+	Apply_Action                                          IeTypeCode = 44
+	Downlink_Data_Service_Information                     IeTypeCode = 45
+	Downlink_Data_Notification_Delay                      IeTypeCode = 46
+	DL_Buffering_Duration                                 IeTypeCode = 47
+	DL_Buffering_Suggested_Packet_Count                   IeTypeCode = 48
+	PfcpsmreqFlags                                        IeTypeCode = 49
+	PfcpsrrspFlags                                        IeTypeCode = 50
+	Load_Control_Information                              IeTypeCode = 51
+	Sequence_Number                                       IeTypeCode = 52
+	Metric                                                IeTypeCode = 53
+	Overload_Control_Information                          IeTypeCode = 54
+	Timer                                                 IeTypeCode = 55
+	PDR_ID                                                IeTypeCode = 56
+	F_SEID                                                IeTypeCode = 57
+	Application_IDs_PFDs                                  IeTypeCode = 58
+	PFD_context                                           IeTypeCode = 59
+	Node_ID                                               IeTypeCode = 60
+	PFD_contents                                          IeTypeCode = 61
+	Measurement_Method                                    IeTypeCode = 62
+	Usage_Report_Trigger                                  IeTypeCode = 63
+	Measurement_Period                                    IeTypeCode = 64
+	FQ_CSID                                               IeTypeCode = 65
+	Volume_Measurement                                    IeTypeCode = 66
+	Duration_Measurement                                  IeTypeCode = 67
+	Application_Detection_Information                     IeTypeCode = 68
+	Time_of_First_Packet                                  IeTypeCode = 69
+	Time_of_Last_Packet                                   IeTypeCode = 70
+	Quota_Holding_Time                                    IeTypeCode = 71
+	Dropped_DL_Traffic_Threshold                          IeTypeCode = 72
+	Volume_Quota                                          IeTypeCode = 73
+	Time_Quota                                            IeTypeCode = 74
+	Start_Time                                            IeTypeCode = 75
+	End_Time                                              IeTypeCode = 76
+	Query_URR                                             IeTypeCode = 77
+	Usage_Report_SMR                                      IeTypeCode = 78
+	Usage_Report_SDR                                      IeTypeCode = 79
+	Usage_Report_SRR                                      IeTypeCode = 80
+	URR_ID                                                IeTypeCode = 81
+	Linked_URR_ID                                         IeTypeCode = 82
+	Downlink_Data_Report                                  IeTypeCode = 83
+	Outer_Header_Creation                                 IeTypeCode = 84
+	Create_BAR                                            IeTypeCode = 85
+	Update_BAR                                            IeTypeCode = 86
+	Remove_BAR                                            IeTypeCode = 87
+	BAR_ID                                                IeTypeCode = 88
+	CP_Function_Features                                  IeTypeCode = 89
+	Usage_Information                                     IeTypeCode = 90
+	Application_Instance_ID                               IeTypeCode = 91
+	Flow_Information                                      IeTypeCode = 92
+	UE_IP_Address                                         IeTypeCode = 93
+	Packet_Rate                                           IeTypeCode = 94
+	Outer_Header_Removal                                  IeTypeCode = 95
+	Recovery_Time_Stamp                                   IeTypeCode = 96
+	DL_Flow_Level_Marking                                 IeTypeCode = 97
+	Header_Enrichment                                     IeTypeCode = 98
+	Error_Indication_Report                               IeTypeCode = 99
+	Measurement_Information                               IeTypeCode = 100
+	Node_Report_Type                                      IeTypeCode = 101
+	User_Plane_Path_Failure_Report                        IeTypeCode = 102
+	Remote_GTP_U_Peer                                     IeTypeCode = 103
+	UR_SEQN                                               IeTypeCode = 104
+	Update_Duplicating_Parameters                         IeTypeCode = 105
+	Activate_Predefined_Rules                             IeTypeCode = 106
+	Deactivate_Predefined_Rules                           IeTypeCode = 107
+	FAR_ID                                                IeTypeCode = 108
+	QER_ID                                                IeTypeCode = 109
+	OCI_Flags                                             IeTypeCode = 110
+	PfcparreqFlags                                        IeTypeCode = 111
+	Graceful_Release_Period                               IeTypeCode = 112
+	PDN_Type                                              IeTypeCode = 113
+	Failed_Rule_ID                                        IeTypeCode = 114
+	Time_Quota_Mechanism                                  IeTypeCode = 115
+	User_Plane_IP_Resource_Information                    IeTypeCode = 116
+	User_Plane_Inactivity_Timer                           IeTypeCode = 117
+	Aggregated_URRs                                       IeTypeCode = 118
+	Multiplier                                            IeTypeCode = 119
+	Aggregated_URR_ID                                     IeTypeCode = 120
+	Subsequent_Volume_Quota                               IeTypeCode = 121
+	Subsequent_Time_Quota                                 IeTypeCode = 122
+	RQI                                                   IeTypeCode = 123
+	QFI                                                   IeTypeCode = 124
+	Query_URR_Reference                                   IeTypeCode = 125
+	Additional_Usage_Reports_Information                  IeTypeCode = 126
+	Create_Traffic_Endpoint                               IeTypeCode = 127
+	Created_Traffic_Endpoint                              IeTypeCode = 128
+	Update_Traffic_Endpoint                               IeTypeCode = 129
+	Remove_Traffic_Endpoint                               IeTypeCode = 130
+	Traffic_Endpoint_ID                                   IeTypeCode = 131
+	Ethernet_Packet_Filter                                IeTypeCode = 132
+	MAC_address                                           IeTypeCode = 133
+	C_TAG                                                 IeTypeCode = 134
+	S_TAG                                                 IeTypeCode = 135
+	Ethertype                                             IeTypeCode = 136
+	Proxying                                              IeTypeCode = 137
+	Ethernet_Filter_ID                                    IeTypeCode = 138
+	Ethernet_Filter_Properties                            IeTypeCode = 139
+	Suggested_Buffering_Packets_Count                     IeTypeCode = 140
+	User_ID                                               IeTypeCode = 141
+	Ethernet_PDU_Session_Information                      IeTypeCode = 142
+	Ethernet_Traffic_Information                          IeTypeCode = 143
+	MAC_Addresses_Detected                                IeTypeCode = 144
+	MAC_Addresses_Removed                                 IeTypeCode = 145
+	Ethernet_Inactivity_Timer                             IeTypeCode = 146
+	Additional_Monitoring_Time                            IeTypeCode = 147
+	Event_Quota                                           IeTypeCode = 148
+	Event_Threshold                                       IeTypeCode = 149
+	Subsequent_Event_Quota                                IeTypeCode = 150
+	Subsequent_Event_Threshold                            IeTypeCode = 151
+	Trace_Information                                     IeTypeCode = 152
+	Framed_Route                                          IeTypeCode = 153
+	Framed_Routing                                        IeTypeCode = 154
+	Framed_IPv6_Route                                     IeTypeCode = 155
+	Time_Stamp                                            IeTypeCode = 156
+	Averaging_Window                                      IeTypeCode = 157
+	Paging_Policy_Indicator                               IeTypeCode = 158
+	APN_DNN                                               IeTypeCode = 159
+	TGPP_Interface_Type                                   IeTypeCode = 160
+	PfcpsrreqFlags                                        IeTypeCode = 161
+	PfcpaureqFlags                                        IeTypeCode = 162
+	Activation_Time                                       IeTypeCode = 163
+	Deactivation_Time                                     IeTypeCode = 164
+	Create_MAR                                            IeTypeCode = 165
+	TGPP_Access_Forwarding_Action_Information             IeTypeCode = 166
+	Non_3GPP_Access_Forwarding_Action_Information         IeTypeCode = 167
+	Remove_MAR                                            IeTypeCode = 168
+	Update_MAR                                            IeTypeCode = 169
+	MAR_ID                                                IeTypeCode = 170
+	Steering_Functionality                                IeTypeCode = 171
+	Steering_Mode                                         IeTypeCode = 172
+	Weight                                                IeTypeCode = 173
+	Priority                                              IeTypeCode = 174
+	Update_TGPP_Access_Forwarding_Action_Information      IeTypeCode = 175
+	Update_Non_3GPP_Access_Forwarding_Action_Information  IeTypeCode = 176
+	UE_IP_address_Pool_Identity                           IeTypeCode = 177
+	Alternative_SMF_IP_Address                            IeTypeCode = 178
+	Packet_Replication_and_Detection_Carry_On_Information IeTypeCode = 179
+	SMF_Set_ID                                            IeTypeCode = 180
+	Quota_Validity_Time                                   IeTypeCode = 181
+	Number_of_Reports                                     IeTypeCode = 182
+	PFCP_Session_Retention_Information                    IeTypeCode = 183
+	PfcpasrspFlags                                        IeTypeCode = 184
+	CP_PFCP_Entity_IP_Address                             IeTypeCode = 185
+	PfcpsereqFlags                                        IeTypeCode = 186
+	User_Plane_Path_Recovery_Report                       IeTypeCode = 187
+	IP_Multicast_Addressing_Info                          IeTypeCode = 188
+	Join_IP_Multicast_Information                         IeTypeCode = 189
+	Leave_IP_Multicast_Information                        IeTypeCode = 190
+	IP_Multicast_Address                                  IeTypeCode = 191
+	Source_IP_Address                                     IeTypeCode = 192
+	Packet_Rate_Status                                    IeTypeCode = 193
+	Create_Bridge_Info_for_TSC                            IeTypeCode = 194
+	Created_Bridge_Info_for_TSC                           IeTypeCode = 195
+	DS_TT_Port_Number                                     IeTypeCode = 196
+	NW_TT_Port_Number                                     IeTypeCode = 197
+	TSN_Bridge_ID                                         IeTypeCode = 198
+	TSC_Management_Information_SMReq                      IeTypeCode = 199
+	TSC_Management_Information_SMRsp                      IeTypeCode = 200
+	TSC_Management_Information_SRReq                      IeTypeCode = 201
+	Port_Management_Information_Container                 IeTypeCode = 202
+	Clock_Drift_Control_Information                       IeTypeCode = 203
+	Requested_Clock_Drift_Information                     IeTypeCode = 204
+	Clock_Drift_Report                                    IeTypeCode = 205
+	TSN_Time_Domain_Number                                IeTypeCode = 206
+	Time_Offset_Threshold                                 IeTypeCode = 207
+	Cumulative_rateRatio_Threshold                        IeTypeCode = 208
+	Time_Offset_Measurement                               IeTypeCode = 209
+	Cumulative_rateRatio_Measurement                      IeTypeCode = 210
+	Remove_SRR                                            IeTypeCode = 211
+	Create_SRR                                            IeTypeCode = 212
+	Update_SRR                                            IeTypeCode = 213
+	Session_Report                                        IeTypeCode = 214
+	SRR_ID                                                IeTypeCode = 215
+	Access_Availability_Control_Information               IeTypeCode = 216
+	Requested_Access_Availability_Information             IeTypeCode = 217
+	Access_Availability_Report                            IeTypeCode = 218
+	Access_Availability_Information                       IeTypeCode = 219
+	Provide_ATSSS_Control_Information                     IeTypeCode = 220
+	ATSSS_Control_Parameters                              IeTypeCode = 221
+	MPTCP_Control_Information                             IeTypeCode = 222
+	ATSSS_LL_Control_Information                          IeTypeCode = 223
+	PMF_Control_Information                               IeTypeCode = 224
+	MPTCP_Parameters                                      IeTypeCode = 225
+	ATSSS_LL_Parameters                                   IeTypeCode = 226
+	PMF_Parameters                                        IeTypeCode = 227
+	MPTCP_Address_Information                             IeTypeCode = 228
+	UE_Link_Specific_IP_Address                           IeTypeCode = 229
+	PMF_Address_Information                               IeTypeCode = 230
+	ATSSS_LL_Information                                  IeTypeCode = 231
+	Data_Network_Access_Identifier                        IeTypeCode = 232
+	UE_IP_address_Pool_Information                        IeTypeCode = 233
+	Average_Packet_Delay                                  IeTypeCode = 234
+	Minimum_Packet_Delay                                  IeTypeCode = 235
+	Maximum_Packet_Delay                                  IeTypeCode = 236
+	QoS_Report_Trigger                                    IeTypeCode = 237
+	GTP_U_Path_QoS_Control_Information                    IeTypeCode = 238
+	GTP_U_Path_QoS_Report                                 IeTypeCode = 239
+	QoS_Information_In_GTP_U_Path_QoS_Report              IeTypeCode = 240
+	GTP_U_Path_Interface_Type                             IeTypeCode = 241
+	QoS_Monitoring_per_QoS_flow_Control_Information       IeTypeCode = 242
+	Requested_QoS_Monitoring                              IeTypeCode = 243
+	Reporting_Frequency                                   IeTypeCode = 244
+	Packet_Delay_Thresholds                               IeTypeCode = 245
+	Minimum_Wait_Time                                     IeTypeCode = 246
+	QoS_Monitoring_Report                                 IeTypeCode = 247
+	QoS_Monitoring_Measurement                            IeTypeCode = 248
+	MT_EDT_Control_Information                            IeTypeCode = 249
+	DL_Data_Packets_Size                                  IeTypeCode = 250
+	QER_Control_Indications                               IeTypeCode = 251
+	Packet_Rate_Status_Report                             IeTypeCode = 252
+	NF_Instance_ID                                        IeTypeCode = 253
+	Ethernet_Context_Information                          IeTypeCode = 254
+	Redundant_Transmission_Parameters                     IeTypeCode = 255
+	Updated_PDR                                           IeTypeCode = 256
+	S_NSSAI                                               IeTypeCode = 257
+	IP_version                                            IeTypeCode = 258
+	PfcpasreqFlags                                        IeTypeCode = 259
+	Data_Status                                           IeTypeCode = 260
+	Provide_RDS_configuration_information                 IeTypeCode = 261
+	RDS_configuration_information                         IeTypeCode = 262
+	Query_Packet_Rate_Status                              IeTypeCode = 263
+	Packet_Rate_Status_Report_SMRsp                       IeTypeCode = 264
+	MPTCP_Applicable_Indication                           IeTypeCode = 265
+	Bridge_Management_Information_Container               IeTypeCode = 266
+	UE_IP_Address_Usage_Information                       IeTypeCode = 267
+	Number_of_UE_IP_Addresses                             IeTypeCode = 268
+	Validity_Timer                                        IeTypeCode = 269
+	Redundant_Transmission_Forwarding_Parameters          IeTypeCode = 270
+	Transport_Delay_Reporting                             IeTypeCode = 271
+	Partial_Failure_Information_SERsp                     IeTypeCode = 272
+	Partial_Failure_Information_SMRsp                     IeTypeCode = 273
+	Offending_IE_Information                              IeTypeCode = 274
+	RAT_Type                                              IeTypeCode = 275
+	L2TP_Tunnel_Information                               IeTypeCode = 276
+	L2TP_Session_Information                              IeTypeCode = 277
+	L2TP_User_Authentication                              IeTypeCode = 278
+	Created_L2TP_Session                                  IeTypeCode = 279
+	LNS_Address                                           IeTypeCode = 280
+	Tunnel_Preference                                     IeTypeCode = 281
+	Calling_Number                                        IeTypeCode = 282
+	Called_Number                                         IeTypeCode = 283
+	L2TP_Session_Indications                              IeTypeCode = 284
+	DNS_Server_Address                                    IeTypeCode = 285
+	NBNS_Server_Address                                   IeTypeCode = 286
+	Maximum_Receive_Unit                                  IeTypeCode = 287
+	Thresholds                                            IeTypeCode = 288
+	Steering_Mode_Indicator                               IeTypeCode = 289
+	PFCP_Session_Change_Info                              IeTypeCode = 290
+	Group_Id                                              IeTypeCode = 291
+	CP_IP_Address                                         IeTypeCode = 292
+	IP_Address_and_Port_Number_Replacement                IeTypeCode = 293
+	DNS_Query_Filter                                      IeTypeCode = 294
+	Direct_Reporting_Information                          IeTypeCode = 295
+	Event_Notification_URI                                IeTypeCode = 296
+	Notification_Correlation_ID                           IeTypeCode = 297
+	Reporting_Flags                                       IeTypeCode = 298
+	Predefined_Rules_Name                                 IeTypeCode = 299
+	MBS_Session_N4mb_Control_Information                  IeTypeCode = 300
+	MBS_Multicast_Parameters                              IeTypeCode = 301
+	Add_MBS_Unicast_Parameters                            IeTypeCode = 302
+	MBS_Session_N4mb_Information                          IeTypeCode = 303
+	Remove_MBS_Unicast_Parameters                         IeTypeCode = 304
+	MBS_Session_Identifier                                IeTypeCode = 305
+	Multicast_Transport_Information                       IeTypeCode = 306
+	MBSN4mbReq_Flags                                      IeTypeCode = 307
+	Local_Ingress_Tunnel                                  IeTypeCode = 308
+	MBS_Unicast_Parameters_ID                             IeTypeCode = 309
+	MBS_Session_N4_Control_Information                    IeTypeCode = 310
+	MBS_Session_N4_Information                            IeTypeCode = 311
+	MBSN4Resp_Flags                                       IeTypeCode = 312
+	Tunnel_Password                                       IeTypeCode = 313
+	Area_Session_ID                                       IeTypeCode = 314
+	Peer_UP_Restart_Report                                IeTypeCode = 315
+	DSCP_to_PPI_Control_Information                       IeTypeCode = 316
+	DSCP_to_PPI_Mapping_Information                       IeTypeCode = 317
+	PfcpsdrspFlags                                        IeTypeCode = 318
+	QER_Indications                                       IeTypeCode = 319
+	Vendor_Specific_Node_Report_Type                      IeTypeCode = 320
+	Configured_Time_Domain                                IeTypeCode = 321
+)
+
+var ieNames = map[IeTypeCode]string{
+	Create_PDR:                           "Create PDR",
+	PDI:                                  "PDI",
+	Create_FAR:                           "Create FAR",
+	Forwarding_Parameters:                "Forwarding Parameters",
+	Duplicating_Parameters:               "Duplicating Parameters",
+	Create_URR:                           "Create URR",
+	Create_QER:                           "Create QER",
+	Created_PDR:                          "Created PDR",
+	Update_PDR:                           "Update PDR",
+	Update_FAR:                           "Update FAR",
+	Update_Forwarding_Parameters:         "Update Forwarding Parameters",
+	Update_BAR_SRRsp:                     "Update BAR (PFCP Session Report Response)",
+	Update_URR:                           "Update URR",
+	Update_QER:                           "Update QER",
+	Remove_PDR:                           "Remove PDR",
+	Remove_FAR:                           "Remove FAR",
+	Remove_URR:                           "Remove URR",
+	Remove_QER:                           "Remove QER",
+	Cause:                                "Cause",
+	Source_Interface:                     "Source Interface",
+	F_TEID:                               "F-TEID",
+	Network_Instance:                     "Network Instance",
+	SDF_Filter:                           "SDF Filter",
+	Application_ID:                       "Application ID",
+	Gate_Status:                          "Gate Status",
+	MBR:                                  "MBR",
+	GBR:                                  "GBR",
+	QER_Correlation_ID:                   "QER Correlation ID",
+	Precedence:                           "Precedence",
+	Transport_Level_Marking:              "Transport Level Marking",
+	Volume_Threshold:                     "Volume Threshold",
+	Time_Threshold:                       "Time Threshold",
+	Monitoring_Time:                      "Monitoring Time",
+	Subsequent_Volume_Threshold:          "Subsequent Volume Threshold",
+	Subsequent_Time_Threshold:            "Subsequent Time Threshold",
+	Inactivity_Detection_Time:            "Inactivity Detection Time",
+	Reporting_Triggers:                   "Reporting Triggers",
+	Redirect_Information:                 "Redirect Information",
+	Report_Type:                          "Report Type",
+	Offending_IE:                         "Offending IE",
+	Forwarding_Policy:                    "Forwarding Policy",
+	Destination_Interface:                "Destination Interface",
+	UP_Function_Features:                 "UP Function Features",
+	Apply_Action:                         "Apply Action",
+	Downlink_Data_Service_Information:    "Downlink Data Service Information",
+	Downlink_Data_Notification_Delay:     "Downlink Data Notification Delay",
+	DL_Buffering_Duration:                "DL Buffering Duration",
+	DL_Buffering_Suggested_Packet_Count:  "DL Buffering Suggested Packet Count",
+	PfcpsmreqFlags:                       "PFCPSMReq-Flags",
+	PfcpsrrspFlags:                       "PFCPSRRsp-Flags",
+	Load_Control_Information:             "Load Control Information",
+	Sequence_Number:                      "Sequence Number",
+	Metric:                               "Metric",
+	Overload_Control_Information:         "Overload Control Information",
+	Timer:                                "Timer",
+	PDR_ID:                               "PDR ID",
+	F_SEID:                               "F-SEID",
+	Application_IDs_PFDs:                 "Application ID's PFDs",
+	PFD_context:                          "PFD context",
+	Node_ID:                              "Node ID",
+	PFD_contents:                         "PFD contents",
+	Measurement_Method:                   "Measurement Method",
+	Usage_Report_Trigger:                 "Usage Report Trigger",
+	Measurement_Period:                   "Measurement Period",
+	FQ_CSID:                              "FQ-CSID",
+	Volume_Measurement:                   "Volume Measurement",
+	Duration_Measurement:                 "Duration Measurement",
+	Application_Detection_Information:    "Application Detection Information",
+	Time_of_First_Packet:                 "Time of First Packet",
+	Time_of_Last_Packet:                  "Time of Last Packet",
+	Quota_Holding_Time:                   "Quota Holding Time",
+	Dropped_DL_Traffic_Threshold:         "Dropped DL Traffic Threshold",
+	Volume_Quota:                         "Volume Quota",
+	Time_Quota:                           "Time Quota",
+	Start_Time:                           "Start Time",
+	End_Time:                             "End Time",
+	Query_URR:                            "Query URR",
+	Usage_Report_SMR:                     "Usage Report (Session Modification Response)",
+	Usage_Report_SDR:                     "Usage Report (Session Deletion Response)",
+	Usage_Report_SRR:                     "Usage Report (Session Report Request)",
+	URR_ID:                               "URR ID",
+	Linked_URR_ID:                        "Linked URR ID",
+	Downlink_Data_Report:                 "Downlink Data Report",
+	Outer_Header_Creation:                "Outer Header Creation",
+	Create_BAR:                           "Create BAR",
+	Update_BAR:                           "Update BAR (Session Modification Request)",
+	Remove_BAR:                           "Remove BAR",
+	BAR_ID:                               "BAR ID",
+	CP_Function_Features:                 "CP Function Features",
+	Usage_Information:                    "Usage Information",
+	Application_Instance_ID:              "Application Instance ID",
+	Flow_Information:                     "Flow Information",
+	UE_IP_Address:                        "UE IP Address",
+	Packet_Rate:                          "Packet Rate",
+	Outer_Header_Removal:                 "Outer Header Removal",
+	Recovery_Time_Stamp:                  "Recovery Time Stamp",
+	DL_Flow_Level_Marking:                "DL Flow Level Marking",
+	Header_Enrichment:                    "Header Enrichment",
+	Error_Indication_Report:              "Error Indication Report",
+	Measurement_Information:              "Measurement Information",
+	Node_Report_Type:                     "Node Report Type",
+	User_Plane_Path_Failure_Report:       "User Plane Path Failure Report",
+	Remote_GTP_U_Peer:                    "Remote GTP-U Peer",
+	UR_SEQN:                              "UR-SEQN",
+	Update_Duplicating_Parameters:        "Update Duplicating Parameters",
+	Activate_Predefined_Rules:            "Activate Predefined Rules",
+	Deactivate_Predefined_Rules:          "Deactivate Predefined Rules",
+	FAR_ID:                               "FAR ID",
+	QER_ID:                               "QER ID",
+	OCI_Flags:                            "OCI Flags",
+	PfcparreqFlags:                       "PFCP Association Release Request",
+	Graceful_Release_Period:              "Graceful Release Period",
+	PDN_Type:                             "PDN Type",
+	Failed_Rule_ID:                       "Failed Rule ID",
+	Time_Quota_Mechanism:                 "Time Quota Mechanism",
+	User_Plane_IP_Resource_Information:   "User Plane IP Resource Information (rel 15 only)",
+	User_Plane_Inactivity_Timer:          "User Plane Inactivity Timer",
+	Aggregated_URRs:                      "Aggregated URRs",
+	Multiplier:                           "Multiplier",
+	Aggregated_URR_ID:                    "Aggregated URR ID",
+	Subsequent_Volume_Quota:              "Subsequent Volume Quota",
+	Subsequent_Time_Quota:                "Subsequent Time Quota",
+	RQI:                                  "RQI",
+	QFI:                                  "QFI",
+	Query_URR_Reference:                  "Query URR Reference",
+	Additional_Usage_Reports_Information: "Additional Usage Reports Information",
+	Create_Traffic_Endpoint:              "Create Traffic Endpoint",
+	Created_Traffic_Endpoint:             "Created Traffic Endpoint",
+	Update_Traffic_Endpoint:              "Update Traffic Endpoint",
+	Remove_Traffic_Endpoint:              "Remove Traffic Endpoint",
+	Traffic_Endpoint_ID:                  "Traffic Endpoint ID",
+	Ethernet_Packet_Filter:               "Ethernet Packet Filter",
+	MAC_address:                          "MAC address",
+	C_TAG:                                "C-TAG",
+	S_TAG:                                "S-TAG",
+	Ethertype:                            "Ethertype",
+	Proxying:                             "Proxying",
+	Ethernet_Filter_ID:                   "Ethernet Filter ID",
+	Ethernet_Filter_Properties:           "Ethernet Filter Properties",
+	Suggested_Buffering_Packets_Count:    "Suggested Buffering Packets Count",
+	User_ID:                              "User ID",
+	Ethernet_PDU_Session_Information:     "Ethernet PDU Session Information",
+	Ethernet_Traffic_Information:         "Ethernet Traffic Information",
+	MAC_Addresses_Detected:               "MAC Addresses Detected",
+	MAC_Addresses_Removed:                "MAC Addresses Removed",
+	Ethernet_Inactivity_Timer:            "Ethernet Inactivity Timer",
+	Additional_Monitoring_Time:           "Additional Monitoring Time",
+	Event_Quota:                          "Event Quota",
+	Event_Threshold:                      "Event Threshold",
+	Subsequent_Event_Quota:               "Subsequent Event Quota",
+	Subsequent_Event_Threshold:           "Subsequent Event Threshold",
+	Trace_Information:                    "Trace Information",
+	Framed_Route:                         "Framed-Route",
+	Framed_Routing:                       "Framed-Routing",
+	Framed_IPv6_Route:                    "Framed-IPv6-Route",
+	Time_Stamp:                           "Time Stamp",
+	Averaging_Window:                     "Averaging Window",
+	Paging_Policy_Indicator:              "Paging Policy Indicator",
+	APN_DNN:                              "APN/DNN",
+	TGPP_Interface_Type:                  "3GPP Interface Type",
+	PfcpsrreqFlags:                       "PFCPSRReq-Flags",
+	PfcpaureqFlags:                       "PFCPAUReq-Flags",
+	Activation_Time:                      "Activation Time",
+	Deactivation_Time:                    "Deactivation Time",
+	Create_MAR:                           "Create MAR",
+	TGPP_Access_Forwarding_Action_Information:     "3GPP Access Forwarding Action Information",
+	Non_3GPP_Access_Forwarding_Action_Information: "Non-3GPP Access Forwarding Action Information",
+	Remove_MAR:             "Remove MAR",
+	Update_MAR:             "Update MAR",
+	MAR_ID:                 "MAR ID",
+	Steering_Functionality: "Steering Functionality",
+	Steering_Mode:          "Steering Mode",
+	Weight:                 "Weight",
+	Priority:               "Priority",
+	Update_TGPP_Access_Forwarding_Action_Information:      "Update 3GPP Access Forwarding Action Information",
+	Update_Non_3GPP_Access_Forwarding_Action_Information:  "Update Non 3GPP Access Forwarding Action Information",
+	UE_IP_address_Pool_Identity:                           "UE IP address Pool Identity",
+	Alternative_SMF_IP_Address:                            "Alternative SMF IP Address",
+	Packet_Replication_and_Detection_Carry_On_Information: "Packet Replication and Detection Carry-On Information",
+	SMF_Set_ID:                                      "SMF Set ID",
+	Quota_Validity_Time:                             "Quota Validity Time",
+	Number_of_Reports:                               "Number of Reports",
+	PFCP_Session_Retention_Information:              "PFCP Session Retention Information (within PFCP Association Setup Request)",
+	PfcpasrspFlags:                                  "PFCPASRsp-Flags",
+	CP_PFCP_Entity_IP_Address:                       "CP PFCP Entity IP Address",
+	PfcpsereqFlags:                                  "PFCPSEReq-Flags",
+	User_Plane_Path_Recovery_Report:                 "User Plane Path Recovery Report",
+	IP_Multicast_Addressing_Info:                    "IP Multicast Addressing Info within PFCP Session Establishment Request",
+	Join_IP_Multicast_Information:                   "Join IP Multicast Information IE within Usage Report",
+	Leave_IP_Multicast_Information:                  "Leave IP Multicast Information IE within Usage Report",
+	IP_Multicast_Address:                            "IP Multicast Address",
+	Source_IP_Address:                               "Source IP Address",
+	Packet_Rate_Status:                              "Packet Rate Status",
+	Create_Bridge_Info_for_TSC:                      "Create Bridge Info for TSC",
+	Created_Bridge_Info_for_TSC:                     "Created Bridge Info for TSC",
+	DS_TT_Port_Number:                               "DS-TT Port Number",
+	NW_TT_Port_Number:                               "NW-TT Port Number",
+	TSN_Bridge_ID:                                   "TSN Bridge ID",
+	TSC_Management_Information_SMReq:                "TSC Management Information IE within PFCP Session Modification Request",
+	TSC_Management_Information_SMRsp:                "TSC Management Information IE within PFCP Session Modification Response",
+	TSC_Management_Information_SRReq:                "TSC Management Information IE within PFCP Session Report Request",
+	Port_Management_Information_Container:           "Port Management Information Container",
+	Clock_Drift_Control_Information:                 "Clock Drift Control Information",
+	Requested_Clock_Drift_Information:               "Requested Clock Drift Information",
+	Clock_Drift_Report:                              "Clock Drift Report",
+	TSN_Time_Domain_Number:                          "TSN Time Domain Number",
+	Time_Offset_Threshold:                           "Time Offset Threshold",
+	Cumulative_rateRatio_Threshold:                  "Cumulative rateRatio Threshold",
+	Time_Offset_Measurement:                         "Time Offset Measurement",
+	Cumulative_rateRatio_Measurement:                "Cumulative rateRatio Measurement",
+	Remove_SRR:                                      "Remove SRR",
+	Create_SRR:                                      "Create SRR",
+	Update_SRR:                                      "Update SRR",
+	Session_Report:                                  "Session Report",
+	SRR_ID:                                          "SRR ID",
+	Access_Availability_Control_Information:         "Access Availability Control Information",
+	Requested_Access_Availability_Information:       "Requested Access Availability Information",
+	Access_Availability_Report:                      "Access Availability Report",
+	Access_Availability_Information:                 "Access Availability Information",
+	Provide_ATSSS_Control_Information:               "Provide ATSSS Control Information",
+	ATSSS_Control_Parameters:                        "ATSSS Control Parameters",
+	MPTCP_Control_Information:                       "MPTCP Control Information",
+	ATSSS_LL_Control_Information:                    "ATSSS-LL Control Information",
+	PMF_Control_Information:                         "PMF Control Information",
+	MPTCP_Parameters:                                "MPTCP Parameters",
+	ATSSS_LL_Parameters:                             "ATSSS-LL Parameters",
+	PMF_Parameters:                                  "PMF Parameters",
+	MPTCP_Address_Information:                       "MPTCP Address Information",
+	UE_Link_Specific_IP_Address:                     "UE Link-Specific IP Address",
+	PMF_Address_Information:                         "PMF Address Information",
+	ATSSS_LL_Information:                            "ATSSS-LL Information",
+	Data_Network_Access_Identifier:                  "Data Network Access Identifier",
+	UE_IP_address_Pool_Information:                  "UE IP address Pool Information",
+	Average_Packet_Delay:                            "Average Packet Delay",
+	Minimum_Packet_Delay:                            "Minimum Packet Delay",
+	Maximum_Packet_Delay:                            "Maximum Packet Delay",
+	QoS_Report_Trigger:                              "QoS Report Trigger",
+	GTP_U_Path_QoS_Control_Information:              "GTP-U Path QoS Control Information",
+	GTP_U_Path_QoS_Report:                           "GTP-U Path QoS Report (PFCP Node Report Request)",
+	QoS_Information_In_GTP_U_Path_QoS_Report:        "QoS Information in GTP-U Path QoS Report",
+	GTP_U_Path_Interface_Type:                       "GTP-U Path Interface Type",
+	QoS_Monitoring_per_QoS_flow_Control_Information: "QoS Monitoring per QoS flow Control Information",
+	Requested_QoS_Monitoring:                        "Requested QoS Monitoring",
+	Reporting_Frequency:                             "Reporting Frequency",
+	Packet_Delay_Thresholds:                         "Packet Delay Thresholds",
+	Minimum_Wait_Time:                               "Minimum Wait Time",
+	QoS_Monitoring_Report:                           "QoS Monitoring Report",
+	QoS_Monitoring_Measurement:                      "QoS Monitoring Measurement",
+	MT_EDT_Control_Information:                      "MT-EDT Control Information",
+	DL_Data_Packets_Size:                            "DL Data Packets Size",
+	QER_Control_Indications:                         "QER Control Indications",
+	Packet_Rate_Status_Report:                       "Packet Rate Status Report",
+	NF_Instance_ID:                                  "NF Instance ID",
+	Ethernet_Context_Information:                    "Ethernet Context Information",
+	Redundant_Transmission_Parameters:               "Redundant Transmission Parameters",
+	Updated_PDR:                                     "Updated PDR",
+	S_NSSAI:                                         "S-NSSAI",
+	IP_version:                                      "IP version",
+	PfcpasreqFlags:                                  "PFCPASReq-Flags",
+	Data_Status:                                     "Data Status",
+	Provide_RDS_configuration_information:           "Provide RDS configuration information",
+	RDS_configuration_information:                   "RDS configuration information",
+	Query_Packet_Rate_Status:                        "Query Packet Rate Status IE within PFCP Session Modification Request",
+	Packet_Rate_Status_Report_SMRsp:                 "Packet Rate Status Report IE within PFCP Session Modification Response",
+	MPTCP_Applicable_Indication:                     "MPTCP Applicable Indication",
+	Bridge_Management_Information_Container:         "Bridge Management Information Container",
+	UE_IP_Address_Usage_Information:                 "UE IP Address Usage Information",
+	Number_of_UE_IP_Addresses:                       "Number of UE IP Addresses",
+	Validity_Timer:                                  "Validity Timer",
+	Redundant_Transmission_Forwarding_Parameters:    "Redundant Transmission Forwarding Parameters",
+	Transport_Delay_Reporting:                       "Transport Delay Reporting",
+	Partial_Failure_Information_SERsp:               "Partial Failure Information within PFCP Session Establishment Response",
+	Partial_Failure_Information_SMRsp:               "Partial Failure Information within PFCP Session Modification Response",
+	Offending_IE_Information:                        "Offending IE Information",
+	RAT_Type:                                        "RAT Type",
+	L2TP_Tunnel_Information:                         "L2TP Tunnel Information",
+	L2TP_Session_Information:                        "L2TP Session Information",
+	L2TP_User_Authentication:                        "L2TP User Authentication",
+	Created_L2TP_Session:                            "Created L2TP Session",
+	LNS_Address:                                     "LNS Address",
+	Tunnel_Preference:                               "Tunnel Preference",
+	Calling_Number:                                  "Calling Number",
+	Called_Number:                                   "Called Number",
+	L2TP_Session_Indications:                        "L2TP Session Indications",
+	DNS_Server_Address:                              "DNS Server Address",
+	NBNS_Server_Address:                             "NBNS Server Address",
+	Maximum_Receive_Unit:                            "Maximum Receive Unit",
+	Thresholds:                                      "Thresholds",
+	Steering_Mode_Indicator:                         "Steering Mode Indicator",
+	PFCP_Session_Change_Info:                        "PFCP Session Change Info",
+	Group_Id:                                        "Group Id",
+	CP_IP_Address:                                   "CP IP Address",
+	IP_Address_and_Port_Number_Replacement:          "IP Address and Port Number Replacement",
+	DNS_Query_Filter:                                "DNS Query Filter",
+	Direct_Reporting_Information:                    "Direct Reporting Information",
+	Event_Notification_URI:                          "Event Notification URI",
+	Notification_Correlation_ID:                     "Notification Correlation ID",
+	Reporting_Flags:                                 "Reporting Flags",
+	Predefined_Rules_Name:                           "Predefined Rules Name",
+	MBS_Session_N4mb_Control_Information:            "MBS Session N4mb Control Information",
+	MBS_Multicast_Parameters:                        "MBS Multicast Parameters",
+	Add_MBS_Unicast_Parameters:                      "Add MBS Unicast Parameters",
+	MBS_Session_N4mb_Information:                    "MBS Session N4mb Information",
+	Remove_MBS_Unicast_Parameters:                   "Remove MBS Unicast Parameters",
+	MBS_Session_Identifier:                          "MBS Session Identifier",
+	Multicast_Transport_Information:                 "Multicast Transport Information",
+	MBSN4mbReq_Flags:                                "MBSN4mbReq Flags",
+	Local_Ingress_Tunnel:                            "Local Ingress Tunnel",
+	MBS_Unicast_Parameters_ID:                       "MBS Unicast Parameters ID",
+	MBS_Session_N4_Control_Information:              "MBS Session N4 Control Information",
+	MBS_Session_N4_Information:                      "MBS Session N4 Information",
+	MBSN4Resp_Flags:                                 "MBSN4Resp Flags",
+	Tunnel_Password:                                 "Tunnel Password",
+	Area_Session_ID:                                 "Area Session ID",
+	Peer_UP_Restart_Report:                          "Peer UP Restart Report",
+	DSCP_to_PPI_Control_Information:                 "DSCP to PPI Control Information",
+	DSCP_to_PPI_Mapping_Information:                 "DSCP to PPI Mapping Information",
+	PfcpsdrspFlags:                                  "PFCPSDRsp-Flags",
+	QER_Indications:                                 "QER Indications",
+	Vendor_Specific_Node_Report_Type:                "Vendor-Specific Node Report Type",
+	Configured_Time_Domain:                          "Configured Time Domain",
+}
+
+// ieIdentifiers are the Go identifiers of the type codes, used in IE paths
+var ieIdentifiers = map[IeTypeCode]string{
+	Create_PDR:                           "Create_PDR",
+	PDI:                                  "PDI",
+	Create_FAR:                           "Create_FAR",
+	Forwarding_Parameters:                "Forwarding_Parameters",
+	Duplicating_Parameters:               "Duplicating_Parameters",
+	Create_URR:                           "Create_URR",
+	Create_QER:                           "Create_QER",
+	Created_PDR:                          "Created_PDR",
+	Update_PDR:                           "Update_PDR",
+	Update_FAR:                           "Update_FAR",
+	Update_Forwarding_Parameters:         "Update_Forwarding_Parameters",
+	Update_BAR_SRRsp:                     "Update_BAR_SRRsp",
+	Update_URR:                           "Update_URR",
+	Update_QER:                           "Update_QER",
+	Remove_PDR:                           "Remove_PDR",
+	Remove_FAR:                           "Remove_FAR",
+	Remove_URR:                           "Remove_URR",
+	Remove_QER:                           "Remove_QER",
+	Cause:                                "Cause",
+	Source_Interface:                     "Source_Interface",
+	F_TEID:                               "F_TEID",
+	Network_Instance:                     "Network_Instance",
+	SDF_Filter:                           "SDF_Filter",
+	Application_ID:                       "Application_ID",
+	Gate_Status:                          "Gate_Status",
+	MBR:                                  "MBR",
+	GBR:                                  "GBR",
+	QER_Correlation_ID:                   "QER_Correlation_ID",
+	Precedence:                           "Precedence",
+	Transport_Level_Marking:              "Transport_Level_Marking",
+	Volume_Threshold:                     "Volume_Threshold",
+	Time_Threshold:                       "Time_Threshold",
+	Monitoring_Time:                      "Monitoring_Time",
+	Subsequent_Volume_Threshold:          "Subsequent_Volume_Threshold",
+	Subsequent_Time_Threshold:            "Subsequent_Time_Threshold",
+	Inactivity_Detection_Time:            "Inactivity_Detection_Time",
+	Reporting_Triggers:                   "Reporting_Triggers",
+	Redirect_Information:                 "Redirect_Information",
+	Report_Type:                          "Report_Type",
+	Offending_IE:                         "Offending_IE",
+	Forwarding_Policy:                    "Forwarding_Policy",
+	Destination_Interface:                "Destination_Interface",
+	UP_Function_Features:                 "UP_Function_Features",
+	Apply_Action:                         "Apply_Action",
+	Downlink_Data_Service_Information:    "Downlink_Data_Service_Information",
+	Downlink_Data_Notification_Delay:     "Downlink_Data_Notification_Delay",
+	DL_Buffering_Duration:                "DL_Buffering_Duration",
+	DL_Buffering_Suggested_Packet_Count:  "DL_Buffering_Suggested_Packet_Count",
+	PfcpsmreqFlags:                       "PfcpsmreqFlags",
+	PfcpsrrspFlags:                       "PfcpsrrspFlags",
+	Load_Control_Information:             "Load_Control_Information",
+	Sequence_Number:                      "Sequence_Number",
+	Metric:                               "Metric",
+	Overload_Control_Information:         "Overload_Control_Information",
+	Timer:                                "Timer",
+	PDR_ID:                               "PDR_ID",
+	F_SEID:                               "F_SEID",
+	Application_IDs_PFDs:                 "Application_IDs_PFDs",
+	PFD_context:                          "PFD_context",
+	Node_ID:                              "Node_ID",
+	PFD_contents:                         "PFD_contents",
+	Measurement_Method:                   "Measurement_Method",
+	Usage_Report_Trigger:                 "Usage_Report_Trigger",
+	Measurement_Period:                   "Measurement_Period",
+	FQ_CSID:                              "FQ_CSID",
+	Volume_Measurement:                   "Volume_Measurement",
+	Duration_Measurement:                 "Duration_Measurement",
+	Application_Detection_Information:    "Application_Detection_Information",
+	Time_of_First_Packet:                 "Time_of_First_Packet",
+	Time_of_Last_Packet:                  "Time_of_Last_Packet",
+	Quota_Holding_Time:                   "Quota_Holding_Time",
+	Dropped_DL_Traffic_Threshold:         "Dropped_DL_Traffic_Threshold",
+	Volume_Quota:                         "Volume_Quota",
+	Time_Quota:                           "Time_Quota",
+	Start_Time:                           "Start_Time",
+	End_Time:                             "End_Time",
+	Query_URR:                            "Query_URR",
+	Usage_Report_SMR:                     "Usage_Report_SMR",
+	Usage_Report_SDR:                     "Usage_Report_SDR",
+	Usage_Report_SRR:                     "Usage_Report_SRR",
+	URR_ID:                               "URR_ID",
+	Linked_URR_ID:                        "Linked_URR_ID",
+	Downlink_Data_Report:                 "Downlink_Data_Report",
+	Outer_Header_Creation:                "Outer_Header_Creation",
+	Create_BAR:                           "Create_BAR",
+	Update_BAR:                           "Update_BAR",
+	Remove_BAR:                           "Remove_BAR",
+	BAR_ID:                               "BAR_ID",
+	CP_Function_Features:                 "CP_Function_Features",
+	Usage_Information:                    "Usage_Information",
+	Application_Instance_ID:              "Application_Instance_ID",
+	Flow_Information:                     "Flow_Information",
+	UE_IP_Address:                        "UE_IP_Address",
+	Packet_Rate:                          "Packet_Rate",
+	Outer_Header_Removal:                 "Outer_Header_Removal",
+	Recovery_Time_Stamp:                  "Recovery_Time_Stamp",
+	DL_Flow_Level_Marking:                "DL_Flow_Level_Marking",
+	Header_Enrichment:                    "Header_Enrichment",
+	Error_Indication_Report:              "Error_Indication_Report",
+	Measurement_Information:              "Measurement_Information",
+	Node_Report_Type:                     "Node_Report_Type",
+	User_Plane_Path_Failure_Report:       "User_Plane_Path_Failure_Report",
+	Remote_GTP_U_Peer:                    "Remote_GTP_U_Peer",
+	UR_SEQN:                              "UR_SEQN",
+	Update_Duplicating_Parameters:        "Update_Duplicating_Parameters",
+	Activate_Predefined_Rules:            "Activate_Predefined_Rules",
+	Deactivate_Predefined_Rules:          "Deactivate_Predefined_Rules",
+	FAR_ID:                               "FAR_ID",
+	QER_ID:                               "QER_ID",
+	OCI_Flags:                            "OCI_Flags",
+	PfcparreqFlags:                       "PfcparreqFlags",
+	Graceful_Release_Period:              "Graceful_Release_Period",
+	PDN_Type:                             "PDN_Type",
+	Failed_Rule_ID:                       "Failed_Rule_ID",
+	Time_Quota_Mechanism:                 "Time_Quota_Mechanism",
+	User_Plane_IP_Resource_Information:   "User_Plane_IP_Resource_Information",
+	User_Plane_Inactivity_Timer:          "User_Plane_Inactivity_Timer",
+	Aggregated_URRs:                      "Aggregated_URRs",
+	Multiplier:                           "Multiplier",
+	Aggregated_URR_ID:                    "Aggregated_URR_ID",
+	Subsequent_Volume_Quota:              "Subsequent_Volume_Quota",
+	Subsequent_Time_Quota:                "Subsequent_Time_Quota",
+	RQI:                                  "RQI",
+	QFI:                                  "QFI",
+	Query_URR_Reference:                  "Query_URR_Reference",
+	Additional_Usage_Reports_Information: "Additional_Usage_Reports_Information",
+	Create_Traffic_Endpoint:              "Create_Traffic_Endpoint",
+	Created_Traffic_Endpoint:             "Created_Traffic_Endpoint",
+	Update_Traffic_Endpoint:              "Update_Traffic_Endpoint",
+	Remove_Traffic_Endpoint:              "Remove_Traffic_Endpoint",
+	Traffic_Endpoint_ID:                  "Traffic_Endpoint_ID",
+	Ethernet_Packet_Filter:               "Ethernet_Packet_Filter",
+	MAC_address:                          "MAC_address",
+	C_TAG:                                "C_TAG",
+	S_TAG:                                "S_TAG",
+	Ethertype:                            "Ethertype",
+	Proxying:                             "Proxying",
+	Ethernet_Filter_ID:                   "Ethernet_Filter_ID",
+	Ethernet_Filter_Properties:           "Ethernet_Filter_Properties",
+	Suggested_Buffering_Packets_Count:    "Suggested_Buffering_Packets_Count",
+	User_ID:                              "User_ID",
+	Ethernet_PDU_Session_Information:     "Ethernet_PDU_Session_Information",
+	Ethernet_Traffic_Information:         "Ethernet_Traffic_Information",
+	MAC_Addresses_Detected:               "MAC_Addresses_Detected",
+	MAC_Addresses_Removed:                "MAC_Addresses_Removed",
+	Ethernet_Inactivity_Timer:            "Ethernet_Inactivity_Timer",
+	Additional_Monitoring_Time:           "Additional_Monitoring_Time",
+	Event_Quota:                          "Event_Quota",
+	Event_Threshold:                      "Event_Threshold",
+	Subsequent_Event_Quota:               "Subsequent_Event_Quota",
+	Subsequent_Event_Threshold:           "Subsequent_Event_Threshold",
+	Trace_Information:                    "Trace_Information",
+	Framed_Route:                         "Framed_Route",
+	Framed_Routing:                       "Framed_Routing",
+	Framed_IPv6_Route:                    "Framed_IPv6_Route",
+	Time_Stamp:                           "Time_Stamp",
+	Averaging_Window:                     "Averaging_Window",
+	Paging_Policy_Indicator:              "Paging_Policy_Indicator",
+	APN_DNN:                              "APN_DNN",
+	TGPP_Interface_Type:                  "TGPP_Interface_Type",
+	PfcpsrreqFlags:                       "PfcpsrreqFlags",
+	PfcpaureqFlags:                       "PfcpaureqFlags",
+	Activation_Time:                      "Activation_Time",
+	Deactivation_Time:                    "Deactivation_Time",
+	Create_MAR:                           "Create_MAR",
+	TGPP_Access_Forwarding_Action_Information:     "TGPP_Access_Forwarding_Action_Information",
+	Non_3GPP_Access_Forwarding_Action_Information: "Non_3GPP_Access_Forwarding_Action_Information",
+	Remove_MAR:             "Remove_MAR",
+	Update_MAR:             "Update_MAR",
+	MAR_ID:                 "MAR_ID",
+	Steering_Functionality: "Steering_Functionality",
+	Steering_Mode:          "Steering_Mode",
+	Weight:                 "Weight",
+	Priority:               "Priority",
+	Update_TGPP_Access_Forwarding_Action_Information:      "Update_TGPP_Access_Forwarding_Action_Information",
+	Update_Non_3GPP_Access_Forwarding_Action_Information:  "Update_Non_3GPP_Access_Forwarding_Action_Information",
+	UE_IP_address_Pool_Identity:                           "UE_IP_address_Pool_Identity",
+	Alternative_SMF_IP_Address:                            "Alternative_SMF_IP_Address",
+	Packet_Replication_and_Detection_Carry_On_Information: "Packet_Replication_and_Detection_Carry_On_Information",
+	SMF_Set_ID:                                      "SMF_Set_ID",
+	Quota_Validity_Time:                             "Quota_Validity_Time",
+	Number_of_Reports:                               "Number_of_Reports",
+	PFCP_Session_Retention_Information:              "PFCP_Session_Retention_Information",
+	PfcpasrspFlags:                                  "PfcpasrspFlags",
+	CP_PFCP_Entity_IP_Address:                       "CP_PFCP_Entity_IP_Address",
+	PfcpsereqFlags:                                  "PfcpsereqFlags",
+	User_Plane_Path_Recovery_Report:                 "User_Plane_Path_Recovery_Report",
+	IP_Multicast_Addressing_Info:                    "IP_Multicast_Addressing_Info",
+	Join_IP_Multicast_Information:                   "Join_IP_Multicast_Information",
+	Leave_IP_Multicast_Information:                  "Leave_IP_Multicast_Information",
+	IP_Multicast_Address:                            "IP_Multicast_Address",
+	Source_IP_Address:                               "Source_IP_Address",
+	Packet_Rate_Status:                              "Packet_Rate_Status",
+	Create_Bridge_Info_for_TSC:                      "Create_Bridge_Info_for_TSC",
+	Created_Bridge_Info_for_TSC:                     "Created_Bridge_Info_for_TSC",
+	DS_TT_Port_Number:                               "DS_TT_Port_Number",
+	NW_TT_Port_Number:                               "NW_TT_Port_Number",
+	TSN_Bridge_ID:                                   "TSN_Bridge_ID",
+	TSC_Management_Information_SMReq:                "TSC_Management_Information_SMReq",
+	TSC_Management_Information_SMRsp:                "TSC_Management_Information_SMRsp",
+	TSC_Management_Information_SRReq:                "TSC_Management_Information_SRReq",
+	Port_Management_Information_Container:           "Port_Management_Information_Container",
+	Clock_Drift_Control_Information:                 "Clock_Drift_Control_Information",
+	Requested_Clock_Drift_Information:               "Requested_Clock_Drift_Information",
+	Clock_Drift_Report:                              "Clock_Drift_Report",
+	TSN_Time_Domain_Number:                          "TSN_Time_Domain_Number",
+	Time_Offset_Threshold:                           "Time_Offset_Threshold",
+	Cumulative_rateRatio_Threshold:                  "Cumulative_rateRatio_Threshold",
+	Time_Offset_Measurement:                         "Time_Offset_Measurement",
+	Cumulative_rateRatio_Measurement:                "Cumulative_rateRatio_Measurement",
+	Remove_SRR:                                      "Remove_SRR",
+	Create_SRR:                                      "Create_SRR",
+	Update_SRR:                                      "Update_SRR",
+	Session_Report:                                  "Session_Report",
+	SRR_ID:                                          "SRR_ID",
+	Access_Availability_Control_Information:         "Access_Availability_Control_Information",
+	Requested_Access_Availability_Information:       "Requested_Access_Availability_Information",
+	Access_Availability_Report:                      "Access_Availability_Report",
+	Access_Availability_Information:                 "Access_Availability_Information",
+	Provide_ATSSS_Control_Information:               "Provide_ATSSS_Control_Information",
+	ATSSS_Control_Parameters:                        "ATSSS_Control_Parameters",
+	MPTCP_Control_Information:                       "MPTCP_Control_Information",
+	ATSSS_LL_Control_Information:                    "ATSSS_LL_Control_Information",
+	PMF_Control_Information:                         "PMF_Control_Information",
+	MPTCP_Parameters:                                "MPTCP_Parameters",
+	ATSSS_LL_Parameters:                             "ATSSS_LL_Parameters",
+	PMF_Parameters:                                  "PMF_Parameters",
+	MPTCP_Address_Information:                       "MPTCP_Address_Information",
+	UE_Link_Specific_IP_Address:                     "UE_Link_Specific_IP_Address",
+	PMF_Address_Information:                         "PMF_Address_Information",
+	ATSSS_LL_Information:                            "ATSSS_LL_Information",
+	Data_Network_Access_Identifier:                  "Data_Network_Access_Identifier",
+	UE_IP_address_Pool_Information:                  "UE_IP_address_Pool_Information",
+	Average_Packet_Delay:                            "Average_Packet_Delay",
+	Minimum_Packet_Delay:                            "Minimum_Packet_Delay",
+	Maximum_Packet_Delay:                            "Maximum_Packet_Delay",
+	QoS_Report_Trigger:                              "QoS_Report_Trigger",
+	GTP_U_Path_QoS_Control_Information:              "GTP_U_Path_QoS_Control_Information",
+	GTP_U_Path_QoS_Report:                           "GTP_U_Path_QoS_Report",
+	QoS_Information_In_GTP_U_Path_QoS_Report:        "QoS_Information_In_GTP_U_Path_QoS_Report",
+	GTP_U_Path_Interface_Type:                       "GTP_U_Path_Interface_Type",
+	QoS_Monitoring_per_QoS_flow_Control_Information: "QoS_Monitoring_per_QoS_flow_Control_Information",
+	Requested_QoS_Monitoring:                        "Requested_QoS_Monitoring",
+	Reporting_Frequency:                             "Reporting_Frequency",
+	Packet_Delay_Thresholds:                         "Packet_Delay_Thresholds",
+	Minimum_Wait_Time:                               "Minimum_Wait_Time",
+	QoS_Monitoring_Report:                           "QoS_Monitoring_Report",
+	QoS_Monitoring_Measurement:                      "QoS_Monitoring_Measurement",
+	MT_EDT_Control_Information:                      "MT_EDT_Control_Information",
+	DL_Data_Packets_Size:                            "DL_Data_Packets_Size",
+	QER_Control_Indications:                         "QER_Control_Indications",
+	Packet_Rate_Status_Report:                       "Packet_Rate_Status_Report",
+	NF_Instance_ID:                                  "NF_Instance_ID",
+	Ethernet_Context_Information:                    "Ethernet_Context_Information",
+	Redundant_Transmission_Parameters:               "Redundant_Transmission_Parameters",
+	Updated_PDR:                                     "Updated_PDR",
+	S_NSSAI:                                         "S_NSSAI",
+	IP_version:                                      "IP_version",
+	PfcpasreqFlags:                                  "PfcpasreqFlags",
+	Data_Status:                                     "Data_Status",
+	Provide_RDS_configuration_information:           "Provide_RDS_configuration_information",
+	RDS_configuration_information:                   "RDS_configuration_information",
+	Query_Packet_Rate_Status:                        "Query_Packet_Rate_Status",
+	Packet_Rate_Status_Report_SMRsp:                 "Packet_Rate_Status_Report_SMRsp",
+	MPTCP_Applicable_Indication:                     "MPTCP_Applicable_Indication",
+	Bridge_Management_Information_Container:         "Bridge_Management_Information_Container",
+	UE_IP_Address_Usage_Information:                 "UE_IP_Address_Usage_Information",
+	Number_of_UE_IP_Addresses:                       "Number_of_UE_IP_Addresses",
+	Validity_Timer:                                  "Validity_Timer",
+	Redundant_Transmission_Forwarding_Parameters:    "Redundant_Transmission_Forwarding_Parameters",
+	Transport_Delay_Reporting:                       "Transport_Delay_Reporting",
+	Partial_Failure_Information_SERsp:               "Partial_Failure_Information_SERsp",
+	Partial_Failure_Information_SMRsp:               "Partial_Failure_Information_SMRsp",
+	Offending_IE_Information:                        "Offending_IE_Information",
+	RAT_Type:                                        "RAT_Type",
+	L2TP_Tunnel_Information:                         "L2TP_Tunnel_Information",
+	L2TP_Session_Information:                        "L2TP_Session_Information",
+	L2TP_User_Authentication:                        "L2TP_User_Authentication",
+	Created_L2TP_Session:                            "Created_L2TP_Session",
+	LNS_Address:                                     "LNS_Address",
+	Tunnel_Preference:                               "Tunnel_Preference",
+	Calling_Number:                                  "Calling_Number",
+	Called_Number:                                   "Called_Number",
+	L2TP_Session_Indications:                        "L2TP_Session_Indications",
+	DNS_Server_Address:                              "DNS_Server_Address",
+	NBNS_Server_Address:                             "NBNS_Server_Address",
+	Maximum_Receive_Unit:                            "Maximum_Receive_Unit",
+	Thresholds:                                      "Thresholds",
+	Steering_Mode_Indicator:                         "Steering_Mode_Indicator",
+	PFCP_Session_Change_Info:                        "PFCP_Session_Change_Info",
+	Group_Id:                                        "Group_Id",
+	CP_IP_Address:                                   "CP_IP_Address",
+	IP_Address_and_Port_Number_Replacement:          "IP_Address_and_Port_Number_Replacement",
+	DNS_Query_Filter:                                "DNS_Query_Filter",
+	Direct_Reporting_Information:                    "Direct_Reporting_Information",
+	Event_Notification_URI:                          "Event_Notification_URI",
+	Notification_Correlation_ID:                     "Notification_Correlation_ID",
+	Reporting_Flags:                                 "Reporting_Flags",
+	Predefined_Rules_Name:                           "Predefined_Rules_Name",
+	MBS_Session_N4mb_Control_Information:            "MBS_Session_N4mb_Control_Information",
+	MBS_Multicast_Parameters:                        "MBS_Multicast_Parameters",
+	Add_MBS_Unicast_Parameters:                      "Add_MBS_Unicast_Parameters",
+	MBS_Session_N4mb_Information:                    "MBS_Session_N4mb_Information",
+	Remove_MBS_Unicast_Parameters:                   "Remove_MBS_Unicast_Parameters",
+	MBS_Session_Identifier:                          "MBS_Session_Identifier",
+	Multicast_Transport_Information:                 "Multicast_Transport_Information",
+	MBSN4mbReq_Flags:                                "MBSN4mbReq_Flags",
+	Local_Ingress_Tunnel:                            "Local_Ingress_Tunnel",
+	MBS_Unicast_Parameters_ID:                       "MBS_Unicast_Parameters_ID",
+	MBS_Session_N4_Control_Information:              "MBS_Session_N4_Control_Information",
+	MBS_Session_N4_Information:                      "MBS_Session_N4_Information",
+	MBSN4Resp_Flags:                                 "MBSN4Resp_Flags",
+	Tunnel_Password:                                 "Tunnel_Password",
+	Area_Session_ID:                                 "Area_Session_ID",
+	Peer_UP_Restart_Report:                          "Peer_UP_Restart_Report",
+	DSCP_to_PPI_Control_Information:                 "DSCP_to_PPI_Control_Information",
+	DSCP_to_PPI_Mapping_Information:                 "DSCP_to_PPI_Mapping_Information",
+	PfcpsdrspFlags:                                  "PfcpsdrspFlags",
+	QER_Indications:                                 "QER_Indications",
+	Vendor_Specific_Node_Report_Type:                "Vendor_Specific_Node_Report_Type",
+	Configured_Time_Domain:                          "Configured_Time_Domain",
+}
+
+var ieTypes = map[IeTypeCode]ieType{
+	Create_PDR:                           ieTgroup,
+	PDI:                                  ieTgroup,
+	Create_FAR:                           ieTgroup,
+	Forwarding_Parameters:                ieTgroup,
+	Duplicating_Parameters:               ieTgroup,
+	Create_URR:                           ieTgroup,
+	Create_QER:                           ieTgroup,
+	Created_PDR:                          ieTgroup,
+	Update_PDR:                           ieTgroup,
+	Update_FAR:                           ieTgroup,
+	Update_Forwarding_Parameters:         ieTgroup,
+	Update_BAR_SRRsp:                     ieTgroup,
+	Update_URR:                           ieTgroup,
+	Update_QER:                           ieTgroup,
+	Remove_PDR:                           ieTgroup,
+	Remove_FAR:                           ieTgroup,
+	Remove_URR:                           ieTgroup,
+	Remove_QER:                           ieTgroup,
+	Cause:                                ieTenum,
+	Source_Interface:                     ieTenumInterface, // only 4 bits used
+	F_TEID:                               ieTfteid,         // IPV4/6+TEID, TEID 32 bits mandatory
+	Network_Instance:                     ieTapn,
+	SDF_Filter:                           ieTbytes,
+	Application_ID:                       ieTbytes,
+	Gate_Status:                          ieTbits,     // only LSB 1,3of 8 used
+	MBR:                                  ieTbitRates, // two 32 bit numbers
+	GBR:                                  ieTbitRates, // two 32 bit numbers
+	QER_Correlation_ID:                   ieTbytes,
+	Precedence:                           ieTintegral, // 32 bits
+	Transport_Level_Marking:              ieTbytes,
+	Volume_Threshold:                     ieTspecial, // up to 3 64 bit numbers
+	Time_Threshold:                       ieTintegral,
+	Monitoring_Time:                      ieTbytes,
+	Subsequent_Volume_Threshold:          ieTbytes,
+	Subsequent_Time_Threshold:            ieTintegral,
+	Inactivity_Detection_Time:            ieTintegral,
+	Reporting_Triggers:                   ieTbytes,
+	Redirect_Information:                 ieTbytes,
+	Report_Type:                          ieTbytes,
+	Offending_IE:                         ieTbytes,
+	Forwarding_Policy:                    ieTbytes,
+	Destination_Interface:                ieTenumInterface, // only 4 bits used - see Source_Interface
+	UP_Function_Features:                 ieTbytes,
+	Apply_Action:                         ieTApplyAction, // 11bits used
+	Downlink_Data_Service_Information:    ieTbytes,
+	Downlink_Data_Notification_Delay:     ieTbytes,
+	DL_Buffering_Duration:                ieTbytes,
+	DL_Buffering_Suggested_Packet_Count:  ieTbytes,
+	PfcpsmreqFlags:                       ieTbytes,
+	PfcpsrrspFlags:                       ieTbytes,
+	Load_Control_Information:             ieTgroup,
+	Sequence_Number:                      ieTbytes,
+	Metric:                               ieTbytes,
+	Overload_Control_Information:         ieTgroup,
+	Timer:                                ieTbytes,
+	PDR_ID:                               ieTid,    // 16 bits
+	F_SEID:                               ieTfseid, // IPV4/6+SEID, SEID 64 bits mandatory
+	Application_IDs_PFDs:                 ieTgroup,
+	PFD_context:                          ieTgroup,
+	Node_ID:                              ieTnodeid, // one of string or IPv4/6, IPs not strings...
+	PFD_contents:                         ieTbytes,
+	Measurement_Method:                   ieTbytes,
+	Usage_Report_Trigger:                 ieTbytes,
+	Measurement_Period:                   ieTintegral,
+	FQ_CSID:                              ieTbytes,
+	Volume_Measurement:                   ieTbytes,
+	Duration_Measurement:                 ieTbytes,
+	Application_Detection_Information:    ieTgroup,
+	Time_of_First_Packet:                 ieTbytes,
+	Time_of_Last_Packet:                  ieTbytes,
+	Quota_Holding_Time:                   ieTintegral,
+	Dropped_DL_Traffic_Threshold:         ieTbytes,
+	Volume_Quota:                         ieTbytes,
+	Time_Quota:                           ieTintegral,
+	Start_Time:                           ieTbytes,
+	End_Time:                             ieTbytes,
+	Query_URR:                            ieTgroup,
+	Usage_Report_SMR:                     ieTgroup,
+	Usage_Report_SDR:                     ieTgroup,
+	Usage_Report_SRR:                     ieTgroup,
+	URR_ID:                               ieTid, // 32 bits
+	Linked_URR_ID:                        ieTintegral,
+	Downlink_Data_Report:                 ieTgroup,
+	Outer_Header_Creation:                ieTOuterHeaderCreate, // many forms, GTPu TEID is our main interest, 32 bits
+	Create_BAR:                           ieTgroup,
+	Update_BAR:                           ieTgroup,
+	Remove_BAR:                           ieTgroup,
+	BAR_ID:                               ieTid, // 8 bits
+	CP_Function_Features:                 ieTbytes,
+	Usage_Information:                    ieTbytes,
+	Application_Instance_ID:              ieTbytes,
+	Flow_Information:                     ieTbytes,
+	UE_IP_Address:                        ieTueIpAddress, // can be ipv4 or 6, or empty, requesting them...
+	Packet_Rate:                          ieTbytes,
+	Outer_Header_Removal:                 ieTenum,     // strictly not an enum, because another bit can be set...
+	Recovery_Time_Stamp:                  ieTintegral, // 32 bits, seconds since 01/01/1900 00:00:00
+	DL_Flow_Level_Marking:                ieTbytes,
+	Header_Enrichment:                    ieTbytes,
+	Error_Indication_Report:              ieTgroup,
+	Measurement_Information:              ieTbytes,
+	Node_Report_Type:                     ieTbytes,
+	User_Plane_Path_Failure_Report:       ieTgroup,
+	Remote_GTP_U_Peer:                    ieTbytes,
+	UR_SEQN:                              ieTbytes,
+	Update_Duplicating_Parameters:        ieTgroup,
+	Activate_Predefined_Rules:            ieTbytes,
+	Deactivate_Predefined_Rules:          ieTbytes,
+	FAR_ID:                               ieTid, // 32 bits
+	QER_ID:                               ieTid, // 32 bits
+	OCI_Flags:                            ieTbytes,
+	PfcparreqFlags:                       ieTbytes,
+	Graceful_Release_Period:              ieTbytes,
+	PDN_Type:                             ieTenum, // 3 bits, ip4 ip6 ip4/6 eth other
+	Failed_Rule_ID:                       ieTbytes,
+	Time_Quota_Mechanism:                 ieTbytes,
+	User_Plane_IP_Resource_Information:   ieTUPIpResInfo,
+	User_Plane_Inactivity_Timer:          ieTintegral,
+	Aggregated_URRs:                      ieTgroup,
+	Multiplier:                           ieTbytes,
+	Aggregated_URR_ID:                    ieTintegral,
+	Subsequent_Volume_Quota:              ieTbytes,
+	Subsequent_Time_Quota:                ieTintegral,
+	RQI:                                  ieTbytes,
+	QFI:                                  ieTintegral,
+	Query_URR_Reference:                  ieTbytes,
+	Additional_Usage_Reports_Information: ieTbytes,
+	Create_Traffic_Endpoint:              ieTgroup,
+	Created_Traffic_Endpoint:             ieTgroup,
+	Update_Traffic_Endpoint:              ieTgroup,
+	Remove_Traffic_Endpoint:              ieTgroup,
+	Traffic_Endpoint_ID:                  ieTid,
+	Ethernet_Packet_Filter:               ieTgroup,
+	MAC_address:                          ieTbytes,
+	C_TAG:                                ieTbytes,
+	S_TAG:                                ieTbytes,
+	Ethertype:                            ieTbytes,
+	Proxying:                             ieTbytes,
+	Ethernet_Filter_ID:                   ieTbytes,
+	Ethernet_Filter_Properties:           ieTbytes,
+	Suggested_Buffering_Packets_Count:    ieTbytes,
+	User_ID:                              ieTbytes,
+	Ethernet_PDU_Session_Information:     ieTbytes,
+	Ethernet_Traffic_Information:         ieTgroup,
+	MAC_Addresses_Detected:               ieTbytes,
+	MAC_Addresses_Removed:                ieTbytes,
+	Ethernet_Inactivity_Timer:            ieTintegral,
+	Additional_Monitoring_Time:           ieTgroup,
+	Event_Quota:                          ieTintegral,
+	Event_Threshold:                      ieTintegral,
+	Subsequent_Event_Quota:               ieTintegral,
+	Subsequent_Event_Threshold:           ieTintegral,
+	Trace_Information:                    ieTbytes,
+	Framed_Route:                         ieTbytes,
+	Framed_Routing:                       ieTbytes,
+	Framed_IPv6_Route:                    ieTbytes,
+	Time_Stamp:                           ieTbytes,
+	Averaging_Window:                     ieTintegral,
+	Paging_Policy_Indicator:              ieTbytes,
+	APN_DNN:                              ieTapn,
+	TGPP_Interface_Type:                  ieTenum,
+	PfcpsrreqFlags:                       ieTbytes,
+	PfcpaureqFlags:                       ieTbytes,
+	Activation_Time:                      ieTbytes,
+	Deactivation_Time:                    ieTbytes,
+	Create_MAR:                           ieTgroup,
+	TGPP_Access_Forwarding_Action_Information:     ieTgroup,
+	Non_3GPP_Access_Forwarding_Action_Information: ieTgroup,
+	Remove_MAR:             ieTgroup,
+	Update_MAR:             ieTgroup,
+	MAR_ID:                 ieTid,
+	Steering_Functionality: ieTenum,
+	Steering_Mode:          ieTenum,
+	Weight:                 ieTbytes,
+	Priority:               ieTbytes,
+	Update_TGPP_Access_Forwarding_Action_Information:      ieTgroup,
+	Update_Non_3GPP_Access_Forwarding_Action_Information:  ieTgroup,
+	UE_IP_address_Pool_Identity:                           ieTbytes,
+	Alternative_SMF_IP_Address:                            ieTbytes,
+	Packet_Replication_and_Detection_Carry_On_Information: ieTbytes,
+	SMF_Set_ID:                                      ieTbytes,
+	Quota_Validity_Time:                             ieTintegral,
+	Number_of_Reports:                               ieTintegral,
+	PFCP_Session_Retention_Information:              ieTgroup,
+	PfcpasrspFlags:                                  ieTbytes,
+	CP_PFCP_Entity_IP_Address:                       ieTbytes,
+	PfcpsereqFlags:                                  ieTbytes,
+	User_Plane_Path_Recovery_Report:                 ieTgroup,
+	IP_Multicast_Addressing_Info:                    ieTgroup,
+	Join_IP_Multicast_Information:                   ieTgroup,
+	Leave_IP_Multicast_Information:                  ieTgroup,
+	IP_Multicast_Address:                            ieTbytes,
+	Source_IP_Address:                               ieTsourceIpAddress, // can be ipv4 or 6,optionally with a mask
+	Packet_Rate_Status:                              ieTbytes,
+	Create_Bridge_Info_for_TSC:                      ieTbytes,
+	Created_Bridge_Info_for_TSC:                     ieTgroup,
+	DS_TT_Port_Number:                               ieTbytes,
+	NW_TT_Port_Number:                               ieTbytes,
+	TSN_Bridge_ID:                                   ieTbytes,
+	TSC_Management_Information_SMReq:                ieTgroup,
+	TSC_Management_Information_SMRsp:                ieTgroup,
+	TSC_Management_Information_SRReq:                ieTgroup,
+	Port_Management_Information_Container:           ieTbytes,
+	Clock_Drift_Control_Information:                 ieTgroup,
+	Requested_Clock_Drift_Information:               ieTbytes,
+	Clock_Drift_Report:                              ieTgroup,
+	TSN_Time_Domain_Number:                          ieTbytes,
+	Time_Offset_Threshold:                           ieTbytes,
+	Cumulative_rateRatio_Threshold:                  ieTbytes,
+	Time_Offset_Measurement:                         ieTbytes,
+	Cumulative_rateRatio_Measurement:                ieTbytes,
+	Remove_SRR:                                      ieTgroup,
+	Create_SRR:                                      ieTgroup,
+	Update_SRR:                                      ieTgroup,
+	Session_Report:                                  ieTgroup,
+	SRR_ID:                                          ieTid,
+	Access_Availability_Control_Information:         ieTgroup,
+	Requested_Access_Availability_Information:       ieTbytes,
+	Access_Availability_Report:                      ieTgroup,
+	Access_Availability_Information:                 ieTbytes,
+	Provide_ATSSS_Control_Information:               ieTgroup,
+	ATSSS_Control_Parameters:                        ieTgroup,
+	MPTCP_Control_Information:                       ieTbytes,
+	ATSSS_LL_Control_Information:                    ieTbytes,
+	PMF_Control_Information:                         ieTbytes,
+	MPTCP_Parameters:                                ieTgroup,
+	ATSSS_LL_Parameters:                             ieTgroup,
+	PMF_Parameters:                                  ieTgroup,
+	MPTCP_Address_Information:                       ieTbytes,
+	UE_Link_Specific_IP_Address:                     ieTbytes,
+	PMF_Address_Information:                         ieTbytes,
+	ATSSS_LL_Information:                            ieTbytes,
+	Data_Network_Access_Identifier:                  ieTbytes,
+	UE_IP_address_Pool_Information:                  ieTgroup,
+	Average_Packet_Delay:                            ieTbytes,
+	Minimum_Packet_Delay:                            ieTbytes,
+	Maximum_Packet_Delay:                            ieTbytes,
+	QoS_Report_Trigger:                              ieTbytes,
+	GTP_U_Path_QoS_Control_Information:              ieTgroup,
+	GTP_U_Path_QoS_Report:                           ieTgroup,
+	QoS_Information_In_GTP_U_Path_QoS_Report:        ieTgroup,
+	GTP_U_Path_Interface_Type:                       ieTbytes,
+	QoS_Monitoring_per_QoS_flow_Control_Information: ieTgroup,
+	Requested_QoS_Monitoring:                        ieTbytes,
+	Reporting_Frequency:                             ieTbytes,
+	Packet_Delay_Thresholds:                         ieTbytes,
+	Minimum_Wait_Time:                               ieTbytes,
+	QoS_Monitoring_Report:                           ieTgroup,
+	QoS_Monitoring_Measurement:                      ieTbytes,
+	MT_EDT_Control_Information:                      ieTbytes,
+	DL_Data_Packets_Size:                            ieTbytes,
+	QER_Control_Indications:                         ieTbytes,
+	Packet_Rate_Status_Report:                       ieTgroup,
+	NF_Instance_ID:                                  ieTbytes,
+	Ethernet_Context_Information:                    ieTgroup,
+	Redundant_Transmission_Parameters:               ieTgroup,
+	Updated_PDR:                                     ieTgroup,
+	S_NSSAI:                                         ieTbytes,
+	IP_version:                                      ieTbytes,
+	PfcpasreqFlags:                                  ieTbytes,
+	Data_Status:                                     ieTbytes,
+	Provide_RDS_configuration_information:           ieTgroup,
+	RDS_configuration_information:                   ieTbytes,
+	Query_Packet_Rate_Status:                        ieTgroup,
+	Packet_Rate_Status_Report_SMRsp:                 ieTgroup,
+	MPTCP_Applicable_Indication:                     ieTbytes,
+	Bridge_Management_Information_Container:         ieTbytes,
+	UE_IP_Address_Usage_Information:                 ieTgroup,
+	Number_of_UE_IP_Addresses:                       ieTbytes,
+	Validity_Timer:                                  ieTbytes,
+	Redundant_Transmission_Forwarding_Parameters:    ieTgroup,
+	Transport_Delay_Reporting:                       ieTgroup,
+	Partial_Failure_Information_SERsp:               ieTgroup,
+	Partial_Failure_Information_SMRsp:               ieTgroup,
+	Offending_IE_Information:                        ieTbytes,
+	RAT_Type:                                        ieTenum,
+	L2TP_Tunnel_Information:                         ieTgroup,
+	L2TP_Session_Information:                        ieTgroup,
+	L2TP_User_Authentication:                        ieTbytes,
+	Created_L2TP_Session:                            ieTgroup,
+	LNS_Address:                                     ieTbytes,
+	Tunnel_Preference:                               ieTintegral,
+	Calling_Number:                                  ieTbytes,
+	Called_Number:                                   ieTbytes,
+	L2TP_Session_Indications:                        ieTbytes,
+	DNS_Server_Address:                              ieTbytes,
+	NBNS_Server_Address:                             ieTbytes,
+	Maximum_Receive_Unit:                            ieTintegral,
+	Thresholds:                                      ieTbytes,
+	Steering_Mode_Indicator:                         ieTbytes,
+	PFCP_Session_Change_Info:                        ieTgroup,
+	Group_Id:                                        ieTbytes,
+	CP_IP_Address:                                   ieTbytes,
+	IP_Address_and_Port_Number_Replacement:          ieTgroup,
+	DNS_Query_Filter:                                ieTbytes,
+	Direct_Reporting_Information:                    ieTgroup,
+	Event_Notification_URI:                          ieTbytes,
+	Notification_Correlation_ID:                     ieTbytes,
+	Reporting_Flags:                                 ieTbytes,
+	Predefined_Rules_Name:                           ieTbytes,
+	MBS_Session_N4mb_Control_Information:            ieTgroup,
+	MBS_Multicast_Parameters:                        ieTgroup,
+	Add_MBS_Unicast_Parameters:                      ieTgroup,
+	MBS_Session_N4mb_Information:                    ieTgroup,
+	Remove_MBS_Unicast_Parameters:                   ieTgroup,
+	MBS_Session_Identifier:                          ieTbytes,
+	Multicast_Transport_Information:                 ieTbytes,
+	MBSN4mbReq_Flags:                                ieTbytes,
+	Local_Ingress_Tunnel:                            ieTbytes,
+	MBS_Unicast_Parameters_ID:                       ieTid,
+	MBS_Session_N4_Control_Information:              ieTgroup,
+	MBS_Session_N4_Information:                      ieTgroup,
+	MBSN4Resp_Flags:                                 ieTbytes,
+	Tunnel_Password:                                 ieTbytes,
+	Area_Session_ID:                                 ieTintegral,
+	Peer_UP_Restart_Report:                          ieTgroup,
+	DSCP_to_PPI_Control_Information:                 ieTgroup,
+	DSCP_to_PPI_Mapping_Information:                 ieTbytes,
+	PfcpsdrspFlags:                                  ieTbytes,
+	QER_Indications:                                 ieTbytes,
+	Vendor_Specific_Node_Report_Type:                ieTbytes,
+	Configured_Time_Domain:                          ieTbytes,
+}
+
+const (
+	PFCP_Heartbeat_Request                 MessageTypeCode = 1
+	PFCP_Heartbeat_Response                MessageTypeCode = 2
+	PFCP_PFD_Management_Request            MessageTypeCode = 3
+	PFCP_PFD_Management_Response           MessageTypeCode = 4
+	PFCP_Association_Setup_Request         MessageTypeCode = 5
+	PFCP_Association_Setup_Response        MessageTypeCode = 6
+	PFCP_Association_Update_Request        MessageTypeCode = 7
+	PFCP_Association_Update_Response       MessageTypeCode = 8
+	PFCP_Association_Release_Request       MessageTypeCode = 9
+	PFCP_Association_Release_Response      MessageTypeCode = 10
+	PFCP_Version_Not_Supported_Response    MessageTypeCode = 11
+	PFCP_Node_Report_Request               MessageTypeCode = 12
+	PFCP_Node_Report_Response              MessageTypeCode = 13
+	PFCP_Session_Set_Deletion_Request      MessageTypeCode = 14
+	PFCP_Session_Set_Deletion_Response     MessageTypeCode = 15
+	PFCP_Session_Set_Modification_Request  MessageTypeCode = 16
+	PFCP_Session_Set_Modification_Response MessageTypeCode = 17
+	PFCP_Session_Establishment_Request     MessageTypeCode = 50
+	PFCP_Session_Establishment_Response    MessageTypeCode = 51
+	PFCP_Session_Modification_Request      MessageTypeCode = 52
+	PFCP_Session_Modification_Response     MessageTypeCode = 53
+	PFCP_Session_Deletion_Request          MessageTypeCode = 54
+	PFCP_Session_Deletion_Response         MessageTypeCode = 55
+	PFCP_Session_Report_Request            MessageTypeCode = 56
+	PFCP_Session_Report_Response           MessageTypeCode = 57
+)
+
+var messageNames = map[MessageTypeCode]string{
+	PFCP_Heartbeat_Request:                 "Heartbeat Request",
+	PFCP_Heartbeat_Response:                "Heartbeat Response",
+	PFCP_PFD_Management_Request:            "PFD Management Request",
+	PFCP_PFD_Management_Response:           "PFD Management Response",
+	PFCP_Association_Setup_Request:         "Association Setup Request",
+	PFCP_Association_Setup_Response:        "Association Setup Response",
+	PFCP_Association_Update_Request:        "Association Update Request",
+	PFCP_Association_Update_Response:       "Association Update Response",
+	PFCP_Association_Release_Request:       "Association Release Request",
+	PFCP_Association_Release_Response:      "Association Release Response",
+	PFCP_Version_Not_Supported_Response:    "Version Not Supported Response",
+	PFCP_Node_Report_Request:               "Node Report Request",
+	PFCP_Node_Report_Response:              "Node Report Response",
+	PFCP_Session_Set_Deletion_Request:      "Session Set Deletion Request",
+	PFCP_Session_Set_Deletion_Response:     "Session Set Deletion Response",
+	PFCP_Session_Set_Modification_Request:  "Session Set Modification Request",
+	PFCP_Session_Set_Modification_Response: "Session Set Modification Response",
+	PFCP_Session_Establishment_Request:     "Session Establishment Request",
+	PFCP_Session_Establishment_Response:    "Session Establishment Response",
+	PFCP_Session_Modification_Request:      "Session Modification Request",
+	PFCP_Session_Modification_Response:     "Session Modification Response",
+	PFCP_Session_Deletion_Request:          "Session Deletion Request",
+	PFCP_Session_Deletion_Response:         "Session Deletion Response",
+	PFCP_Session_Report_Request:            "Session Report Request",
+	PFCP_Session_Report_Response:           "Session Report Response",
+}
+
+// the release in which an IE was introduced, when later than R15
+var ieIntroducedIn = map[IeTypeCode]Release{
+	Create_MAR: Release16,
+	TGPP_Access_Forwarding_Action_Information:     Release16,
+	Non_3GPP_Access_Forwarding_Action_Information: Release16,
+	Remove_MAR:             Release16,
+	Update_MAR:             Release16,
+	MAR_ID:                 Release16,
+	Steering_Functionality: Release16,
+	Steering_Mode:          Release16,
+	Weight:                 Release16,
+	Priority:               Release16,
+	Update_TGPP_Access_Forwarding_Action_Information:      Release16,
+	Update_Non_3GPP_Access_Forwarding_Action_Information:  Release16,
+	UE_IP_address_Pool_Identity:                           Release16,
+	Alternative_SMF_IP_Address:                            Release16,
+	Packet_Replication_and_Detection_Carry_On_Information: Release16,
+	SMF_Set_ID:                                      Release16,
+	Quota_Validity_Time:                             Release16,
+	Number_of_Reports:                               Release16,
+	PFCP_Session_Retention_Information:              Release16,
+	PfcpasrspFlags:                                  Release16,
+	CP_PFCP_Entity_IP_Address:                       Release16,
+	User_Plane_Path_Recovery_Report:                 Release16,
+	IP_Multicast_Addressing_Info:                    Release16,
+	Join_IP_Multicast_Information:                   Release16,
+	Leave_IP_Multicast_Information:                  Release16,
+	IP_Multicast_Address:                            Release16,
+	Source_IP_Address:                               Release16,
+	Packet_Rate_Status:                              Release16,
+	Create_Bridge_Info_for_TSC:                      Release16,
+	Created_Bridge_Info_for_TSC:                     Release16,
+	DS_TT_Port_Number:                               Release16,
+	NW_TT_Port_Number:                               Release16,
+	TSN_Bridge_ID:                                   Release16,
+	TSC_Management_Information_SMReq:                Release16,
+	TSC_Management_Information_SMRsp:                Release16,
+	TSC_Management_Information_SRReq:                Release16,
+	Port_Management_Information_Container:           Release16,
+	Clock_Drift_Control_Information:                 Release16,
+	Requested_Clock_Drift_Information:               Release16,
+	Clock_Drift_Report:                              Release16,
+	TSN_Time_Domain_Number:                          Release16,
+	Time_Offset_Threshold:                           Release16,
+	Cumulative_rateRatio_Threshold:                  Release16,
+	Time_Offset_Measurement:                         Release16,
+	Cumulative_rateRatio_Measurement:                Release16,
+	Remove_SRR:                                      Release16,
+	Create_SRR:                                      Release16,
+	Update_SRR:                                      Release16,
+	Session_Report:                                  Release16,
+	SRR_ID:                                          Release16,
+	Access_Availability_Control_Information:         Release16,
+	Requested_Access_Availability_Information:       Release16,
+	Access_Availability_Report:                      Release16,
+	Access_Availability_Information:                 Release16,
+	Provide_ATSSS_Control_Information:               Release16,
+	ATSSS_Control_Parameters:                        Release16,
+	MPTCP_Control_Information:                       Release16,
+	ATSSS_LL_Control_Information:                    Release16,
+	PMF_Control_Information:                         Release16,
+	MPTCP_Parameters:                                Release16,
+	ATSSS_LL_Parameters:                             Release16,
+	PMF_Parameters:                                  Release16,
+	MPTCP_Address_Information:                       Release16,
+	UE_Link_Specific_IP_Address:                     Release16,
+	PMF_Address_Information:                         Release16,
+	ATSSS_LL_Information:                            Release16,
+	Data_Network_Access_Identifier:                  Release16,
+	UE_IP_address_Pool_Information:                  Release16,
+	Average_Packet_Delay:                            Release16,
+	Minimum_Packet_Delay:                            Release16,
+	Maximum_Packet_Delay:                            Release16,
+	QoS_Report_Trigger:                              Release16,
+	GTP_U_Path_QoS_Control_Information:              Release16,
+	GTP_U_Path_QoS_Report:                           Release16,
+	QoS_Information_In_GTP_U_Path_QoS_Report:        Release16,
+	GTP_U_Path_Interface_Type:                       Release16,
+	QoS_Monitoring_per_QoS_flow_Control_Information: Release16,
+	Requested_QoS_Monitoring:                        Release16,
+	Reporting_Frequency:                             Release16,
+	Packet_Delay_Thresholds:                         Release16,
+	Minimum_Wait_Time:                               Release16,
+	QoS_Monitoring_Report:                           Release16,
+	QoS_Monitoring_Measurement:                      Release16,
+	MT_EDT_Control_Information:                      Release16,
+	DL_Data_Packets_Size:                            Release16,
+	QER_Control_Indications:                         Release16,
+	Packet_Rate_Status_Report:                       Release16,
+	NF_Instance_ID:                                  Release16,
+	Ethernet_Context_Information:                    Release16,
+	Redundant_Transmission_Parameters:               Release16,
+	Updated_PDR:                                     Release16,
+	S_NSSAI:                                         Release16,
+	IP_version:                                      Release16,
+	PfcpasreqFlags:                                  Release16,
+	Data_Status:                                     Release16,
+	Provide_RDS_configuration_information:           Release16,
+	RDS_configuration_information:                   Release16,
+	Query_Packet_Rate_Status:                        Release16,
+	Packet_Rate_Status_Report_SMRsp:                 Release16,
+	MPTCP_Applicable_Indication:                     Release17,
+	Bridge_Management_Information_Container:         Release17,
+	UE_IP_Address_Usage_Information:                 Release17,
+	Number_of_UE_IP_Addresses:                       Release17,
+	Validity_Timer:                                  Release17,
+	Redundant_Transmission_Forwarding_Parameters:    Release17,
+	Transport_Delay_Reporting:                       Release17,
+	Partial_Failure_Information_SERsp:               Release17,
+	Partial_Failure_Information_SMRsp:               Release17,
+	Offending_IE_Information:                        Release17,
+	RAT_Type:                                        Release17,
+	L2TP_Tunnel_Information:                         Release17,
+	L2TP_Session_Information:                        Release17,
+	L2TP_User_Authentication:                        Release17,
+	Created_L2TP_Session:                            Release17,
+	LNS_Address:                                     Release17,
+	Tunnel_Preference:                               Release17,
+	Calling_Number:                                  Release17,
+	Called_Number:                                   Release17,
+	L2TP_Session_Indications:                        Release17,
+	DNS_Server_Address:                              Release17,
+	NBNS_Server_Address:                             Release17,
+	Maximum_Receive_Unit:                            Release17,
+	Thresholds:                                      Release17,
+	Steering_Mode_Indicator:                         Release17,
+	PFCP_Session_Change_Info:                        Release17,
+	Group_Id:                                        Release17,
+	CP_IP_Address:                                   Release17,
+	IP_Address_and_Port_Number_Replacement:          Release17,
+	DNS_Query_Filter:                                Release17,
+	Direct_Reporting_Information:                    Release17,
+	Event_Notification_URI:                          Release17,
+	Notification_Correlation_ID:                     Release17,
+	Reporting_Flags:                                 Release17,
+	Predefined_Rules_Name:                           Release17,
+	MBS_Session_N4mb_Control_Information:            Release17,
+	MBS_Multicast_Parameters:                        Release17,
+	Add_MBS_Unicast_Parameters:                      Release17,
+	MBS_Session_N4mb_Information:                    Release17,
+	Remove_MBS_Unicast_Parameters:                   Release17,
+	MBS_Session_Identifier:                          Release17,
+	Multicast_Transport_Information:                 Release17,
+	MBSN4mbReq_Flags:                                Release17,
+	Local_Ingress_Tunnel:                            Release17,
+	MBS_Unicast_Parameters_ID:                       Release17,
+	MBS_Session_N4_Control_Information:              Release17,
+	MBS_Session_N4_Information:                      Release17,
+	MBSN4Resp_Flags:                                 Release17,
+	Tunnel_Password:                                 Release17,
+	Area_Session_ID:                                 Release17,
+	Peer_UP_Restart_Report:                          Release17,
+	DSCP_to_PPI_Control_Information:                 Release17,
+	DSCP_to_PPI_Mapping_Information:                 Release17,
+	PfcpsdrspFlags:                                  Release17,
+	QER_Indications:                                 Release17,
+	Vendor_Specific_Node_Report_Type:                Release17,
+	Configured_Time_Domain:                          Release17,
+}
+
+// the first release in which an IE is no longer used
+var ieRemovedIn = map[IeTypeCode]Release{
+	User_Plane_IP_Resource_Information: Release16,
+}
+
+var messageIntroducedIn = map[MessageTypeCode]Release{
+	PFCP_Session_Set_Modification_Request:  Release17,
+	PFCP_Session_Set_Modification_Response: Release17,
+}
+
+var typedIes = map[IeTypeCode]func() TypedIe{
+	Cause:                                func() TypedIe { return &T_Cause{} },
+	Source_Interface:                     func() TypedIe { return &T_Source_Interface{} },
+	F_TEID:                               func() TypedIe { return &T_F_TEID{} },
+	Network_Instance:                     func() TypedIe { return &T_Network_Instance{} },
+	SDF_Filter:                           func() TypedIe { return &T_SDF_Filter{} },
+	Application_ID:                       func() TypedIe { return &T_Application_ID{} },
+	Gate_Status:                          func() TypedIe { return &T_Gate_Status{} },
+	MBR:                                  func() TypedIe { return &T_MBR{} },
+	GBR:                                  func() TypedIe { return &T_GBR{} },
+	QER_Correlation_ID:                   func() TypedIe { return &T_QER_Correlation_ID{} },
+	Precedence:                           func() TypedIe { return &T_Precedence{} },
+	Transport_Level_Marking:              func() TypedIe { return &T_Transport_Level_Marking{} },
+	Volume_Threshold:                     func() TypedIe { return &T_Volume_Threshold{} },
+	Time_Threshold:                       func() TypedIe { return &T_Time_Threshold{} },
+	Monitoring_Time:                      func() TypedIe { return &T_Monitoring_Time{} },
+	Subsequent_Volume_Threshold:          func() TypedIe { return &T_Subsequent_Volume_Threshold{} },
+	Subsequent_Time_Threshold:            func() TypedIe { return &T_Subsequent_Time_Threshold{} },
+	Inactivity_Detection_Time:            func() TypedIe { return &T_Inactivity_Detection_Time{} },
+	Reporting_Triggers:                   func() TypedIe { return &T_Reporting_Triggers{} },
+	Redirect_Information:                 func() TypedIe { return &T_Redirect_Information{} },
+	Report_Type:                          func() TypedIe { return &T_Report_Type{} },
+	Offending_IE:                         func() TypedIe { return &T_Offending_IE{} },
+	Forwarding_Policy:                    func() TypedIe { return &T_Forwarding_Policy{} },
+	Destination_Interface:                func() TypedIe { return &T_Destination_Interface{} },
+	UP_Function_Features:                 func() TypedIe { return &T_UP_Function_Features{} },
+	Apply_Action:                         func() TypedIe { return &T_Apply_Action{} },
+	Downlink_Data_Service_Information:    func() TypedIe { return &T_Downlink_Data_Service_Information{} },
+	Downlink_Data_Notification_Delay:     func() TypedIe { return &T_Downlink_Data_Notification_Delay{} },
+	DL_Buffering_Duration:                func() TypedIe { return &T_DL_Buffering_Duration{} },
+	DL_Buffering_Suggested_Packet_Count:  func() TypedIe { return &T_DL_Buffering_Suggested_Packet_Count{} },
+	PfcpsmreqFlags:                       func() TypedIe { return &T_PfcpsmreqFlags{} },
+	PfcpsrrspFlags:                       func() TypedIe { return &T_PfcpsrrspFlags{} },
+	Sequence_Number:                      func() TypedIe { return &T_Sequence_Number{} },
+	Metric:                               func() TypedIe { return &T_Metric{} },
+	Timer:                                func() TypedIe { return &T_Timer{} },
+	PDR_ID:                               func() TypedIe { return &T_PDR_ID{} },
+	F_SEID:                               func() TypedIe { return &T_F_SEID{} },
+	Node_ID:                              func() TypedIe { return &T_Node_ID{} },
+	PFD_contents:                         func() TypedIe { return &T_PFD_contents{} },
+	Measurement_Method:                   func() TypedIe { return &T_Measurement_Method{} },
+	Usage_Report_Trigger:                 func() TypedIe { return &T_Usage_Report_Trigger{} },
+	Measurement_Period:                   func() TypedIe { return &T_Measurement_Period{} },
+	FQ_CSID:                              func() TypedIe { return &T_FQ_CSID{} },
+	Volume_Measurement:                   func() TypedIe { return &T_Volume_Measurement{} },
+	Duration_Measurement:                 func() TypedIe { return &T_Duration_Measurement{} },
+	Time_of_First_Packet:                 func() TypedIe { return &T_Time_of_First_Packet{} },
+	Time_of_Last_Packet:                  func() TypedIe { return &T_Time_of_Last_Packet{} },
+	Quota_Holding_Time:                   func() TypedIe { return &T_Quota_Holding_Time{} },
+	Dropped_DL_Traffic_Threshold:         func() TypedIe { return &T_Dropped_DL_Traffic_Threshold{} },
+	Volume_Quota:                         func() TypedIe { return &T_Volume_Quota{} },
+	Time_Quota:                           func() TypedIe { return &T_Time_Quota{} },
+	Start_Time:                           func() TypedIe { return &T_Start_Time{} },
+	End_Time:                             func() TypedIe { return &T_End_Time{} },
+	URR_ID:                               func() TypedIe { return &T_URR_ID{} },
+	Linked_URR_ID:                        func() TypedIe { return &T_Linked_URR_ID{} },
+	Outer_Header_Creation:                func() TypedIe { return &T_Outer_Header_Creation{} },
+	BAR_ID:                               func() TypedIe { return &T_BAR_ID{} },
+	CP_Function_Features:                 func() TypedIe { return &T_CP_Function_Features{} },
+	Usage_Information:                    func() TypedIe { return &T_Usage_Information{} },
+	Application_Instance_ID:              func() TypedIe { return &T_Application_Instance_ID{} },
+	Flow_Information:                     func() TypedIe { return &T_Flow_Information{} },
+	UE_IP_Address:                        func() TypedIe { return &T_UE_IP_Address{} },
+	Packet_Rate:                          func() TypedIe { return &T_Packet_Rate{} },
+	Outer_Header_Removal:                 func() TypedIe { return &T_Outer_Header_Removal{} },
+	Recovery_Time_Stamp:                  func() TypedIe { return &T_Recovery_Time_Stamp{} },
+	DL_Flow_Level_Marking:                func() TypedIe { return &T_DL_Flow_Level_Marking{} },
+	Header_Enrichment:                    func() TypedIe { return &T_Header_Enrichment{} },
+	Measurement_Information:              func() TypedIe { return &T_Measurement_Information{} },
+	Node_Report_Type:                     func() TypedIe { return &T_Node_Report_Type{} },
+	Remote_GTP_U_Peer:                    func() TypedIe { return &T_Remote_GTP_U_Peer{} },
+	UR_SEQN:                              func() TypedIe { return &T_UR_SEQN{} },
+	Activate_Predefined_Rules:            func() TypedIe { return &T_Activate_Predefined_Rules{} },
+	Deactivate_Predefined_Rules:          func() TypedIe { return &T_Deactivate_Predefined_Rules{} },
+	FAR_ID:                               func() TypedIe { return &T_FAR_ID{} },
+	QER_ID:                               func() TypedIe { return &T_QER_ID{} },
+	OCI_Flags:                            func() TypedIe { return &T_OCI_Flags{} },
+	PfcparreqFlags:                       func() TypedIe { return &T_PfcparreqFlags{} },
+	Graceful_Release_Period:              func() TypedIe { return &T_Graceful_Release_Period{} },
+	PDN_Type:                             func() TypedIe { return &T_PDN_Type{} },
+	Failed_Rule_ID:                       func() TypedIe { return &T_Failed_Rule_ID{} },
+	Time_Quota_Mechanism:                 func() TypedIe { return &T_Time_Quota_Mechanism{} },
+	User_Plane_IP_Resource_Information:   func() TypedIe { return &T_User_Plane_IP_Resource_Information{} },
+	User_Plane_Inactivity_Timer:          func() TypedIe { return &T_User_Plane_Inactivity_Timer{} },
+	Multiplier:                           func() TypedIe { return &T_Multiplier{} },
+	Aggregated_URR_ID:                    func() TypedIe { return &T_Aggregated_URR_ID{} },
+	Subsequent_Volume_Quota:              func() TypedIe { return &T_Subsequent_Volume_Quota{} },
+	Subsequent_Time_Quota:                func() TypedIe { return &T_Subsequent_Time_Quota{} },
+	RQI:                                  func() TypedIe { return &T_RQI{} },
+	QFI:                                  func() TypedIe { return &T_QFI{} },
+	Query_URR_Reference:                  func() TypedIe { return &T_Query_URR_Reference{} },
+	Additional_Usage_Reports_Information: func() TypedIe { return &T_Additional_Usage_Reports_Information{} },
+	Traffic_Endpoint_ID:                  func() TypedIe { return &T_Traffic_Endpoint_ID{} },
+	MAC_address:                          func() TypedIe { return &T_MAC_address{} },
+	C_TAG:                                func() TypedIe { return &T_C_TAG{} },
+	S_TAG:                                func() TypedIe { return &T_S_TAG{} },
+	Ethertype:                            func() TypedIe { return &T_Ethertype{} },
+	Proxying:                             func() TypedIe { return &T_Proxying{} },
+	Ethernet_Filter_ID:                   func() TypedIe { return &T_Ethernet_Filter_ID{} },
+	Ethernet_Filter_Properties:           func() TypedIe { return &T_Ethernet_Filter_Properties{} },
+	Suggested_Buffering_Packets_Count:    func() TypedIe { return &T_Suggested_Buffering_Packets_Count{} },
+	User_ID:                              func() TypedIe { return &T_User_ID{} },
+	Ethernet_PDU_Session_Information:     func() TypedIe { return &T_Ethernet_PDU_Session_Information{} },
+	MAC_Addresses_Detected:               func() TypedIe { return &T_MAC_Addresses_Detected{} },
+	MAC_Addresses_Removed:                func() TypedIe { return &T_MAC_Addresses_Removed{} },
+	Ethernet_Inactivity_Timer:            func() TypedIe { return &T_Ethernet_Inactivity_Timer{} },
+	Event_Quota:                          func() TypedIe { return &T_Event_Quota{} },
+	Event_Threshold:                      func() TypedIe { return &T_Event_Threshold{} },
+	Subsequent_Event_Quota:               func() TypedIe { return &T_Subsequent_Event_Quota{} },
+	Subsequent_Event_Threshold:           func() TypedIe { return &T_Subsequent_Event_Threshold{} },
+	Trace_Information:                    func() TypedIe { return &T_Trace_Information{} },
+	Framed_Route:                         func() TypedIe { return &T_Framed_Route{} },
+	Framed_Routing:                       func() TypedIe { return &T_Framed_Routing{} },
+	Framed_IPv6_Route:                    func() TypedIe { return &T_Framed_IPv6_Route{} },
+	Time_Stamp:                           func() TypedIe { return &T_Time_Stamp{} },
+	Averaging_Window:                     func() TypedIe { return &T_Averaging_Window{} },
+	Paging_Policy_Indicator:              func() TypedIe { return &T_Paging_Policy_Indicator{} },
+	APN_DNN:                              func() TypedIe { return &T_APN_DNN{} },
+	TGPP_Interface_Type:                  func() TypedIe { return &T_TGPP_Interface_Type{} },
+	PfcpsrreqFlags:                       func() TypedIe { return &T_PfcpsrreqFlags{} },
+	PfcpaureqFlags:                       func() TypedIe { return &T_PfcpaureqFlags{} },
+	Activation_Time:                      func() TypedIe { return &T_Activation_Time{} },
+	Deactivation_Time:                    func() TypedIe { return &T_Deactivation_Time{} },
+	MAR_ID:                               func() TypedIe { return &T_MAR_ID{} },
+	Steering_Functionality:               func() TypedIe { return &T_Steering_Functionality{} },
+	Steering_Mode:                        func() TypedIe { return &T_Steering_Mode{} },
+	Weight:                               func() TypedIe { return &T_Weight{} },
+	Priority:                             func() TypedIe { return &T_Priority{} },
+	UE_IP_address_Pool_Identity:          func() TypedIe { return &T_UE_IP_address_Pool_Identity{} },
+	Alternative_SMF_IP_Address:           func() TypedIe { return &T_Alternative_SMF_IP_Address{} },
+	Packet_Replication_and_Detection_Carry_On_Information: func() TypedIe { return &T_Packet_Replication_and_Detection_Carry_On_Information{} },
+	SMF_Set_ID:                                func() TypedIe { return &T_SMF_Set_ID{} },
+	Quota_Validity_Time:                       func() TypedIe { return &T_Quota_Validity_Time{} },
+	Number_of_Reports:                         func() TypedIe { return &T_Number_of_Reports{} },
+	PfcpasrspFlags:                            func() TypedIe { return &T_PfcpasrspFlags{} },
+	CP_PFCP_Entity_IP_Address:                 func() TypedIe { return &T_CP_PFCP_Entity_IP_Address{} },
+	PfcpsereqFlags:                            func() TypedIe { return &T_PfcpsereqFlags{} },
+	IP_Multicast_Address:                      func() TypedIe { return &T_IP_Multicast_Address{} },
+	Source_IP_Address:                         func() TypedIe { return &T_Source_IP_Address{} },
+	Packet_Rate_Status:                        func() TypedIe { return &T_Packet_Rate_Status{} },
+	Create_Bridge_Info_for_TSC:                func() TypedIe { return &T_Create_Bridge_Info_for_TSC{} },
+	DS_TT_Port_Number:                         func() TypedIe { return &T_DS_TT_Port_Number{} },
+	NW_TT_Port_Number:                         func() TypedIe { return &T_NW_TT_Port_Number{} },
+	TSN_Bridge_ID:                             func() TypedIe { return &T_TSN_Bridge_ID{} },
+	Port_Management_Information_Container:     func() TypedIe { return &T_Port_Management_Information_Container{} },
+	Requested_Clock_Drift_Information:         func() TypedIe { return &T_Requested_Clock_Drift_Information{} },
+	TSN_Time_Domain_Number:                    func() TypedIe { return &T_TSN_Time_Domain_Number{} },
+	Time_Offset_Threshold:                     func() TypedIe { return &T_Time_Offset_Threshold{} },
+	Cumulative_rateRatio_Threshold:            func() TypedIe { return &T_Cumulative_rateRatio_Threshold{} },
+	Time_Offset_Measurement:                   func() TypedIe { return &T_Time_Offset_Measurement{} },
+	Cumulative_rateRatio_Measurement:          func() TypedIe { return &T_Cumulative_rateRatio_Measurement{} },
+	SRR_ID:                                    func() TypedIe { return &T_SRR_ID{} },
+	Requested_Access_Availability_Information: func() TypedIe { return &T_Requested_Access_Availability_Information{} },
+	Access_Availability_Information:           func() TypedIe { return &T_Access_Availability_Information{} },
+	MPTCP_Control_Information:                 func() TypedIe { return &T_MPTCP_Control_Information{} },
+	ATSSS_LL_Control_Information:              func() TypedIe { return &T_ATSSS_LL_Control_Information{} },
+	PMF_Control_Information:                   func() TypedIe { return &T_PMF_Control_Information{} },
+	MPTCP_Address_Information:                 func() TypedIe { return &T_MPTCP_Address_Information{} },
+	UE_Link_Specific_IP_Address:               func() TypedIe { return &T_UE_Link_Specific_IP_Address{} },
+	PMF_Address_Information:                   func() TypedIe { return &T_PMF_Address_Information{} },
+	ATSSS_LL_Information:                      func() TypedIe { return &T_ATSSS_LL_Information{} },
+	Data_Network_Access_Identifier:            func() TypedIe { return &T_Data_Network_Access_Identifier{} },
+	Average_Packet_Delay:                      func() TypedIe { return &T_Average_Packet_Delay{} },
+	Minimum_Packet_Delay:                      func() TypedIe { return &T_Minimum_Packet_Delay{} },
+	Maximum_Packet_Delay:                      func() TypedIe { return &T_Maximum_Packet_Delay{} },
+	QoS_Report_Trigger:                        func() TypedIe { return &T_QoS_Report_Trigger{} },
+	GTP_U_Path_Interface_Type:                 func() TypedIe { return &T_GTP_U_Path_Interface_Type{} },
+	Requested_QoS_Monitoring:                  func() TypedIe { return &T_Requested_QoS_Monitoring{} },
+	Reporting_Frequency:                       func() TypedIe { return &T_Reporting_Frequency{} },
+	Packet_Delay_Thresholds:                   func() TypedIe { return &T_Packet_Delay_Thresholds{} },
+	Minimum_Wait_Time:                         func() TypedIe { return &T_Minimum_Wait_Time{} },
+	QoS_Monitoring_Measurement:                func() TypedIe { return &T_QoS_Monitoring_Measurement{} },
+	MT_EDT_Control_Information:                func() TypedIe { return &T_MT_EDT_Control_Information{} },
+	DL_Data_Packets_Size:                      func() TypedIe { return &T_DL_Data_Packets_Size{} },
+	QER_Control_Indications:                   func() TypedIe { return &T_QER_Control_Indications{} },
+	NF_Instance_ID:                            func() TypedIe { return &T_NF_Instance_ID{} },
+	S_NSSAI:                                   func() TypedIe { return &T_S_NSSAI{} },
+	IP_version:                                func() TypedIe { return &T_IP_version{} },
+	PfcpasreqFlags:                            func() TypedIe { return &T_PfcpasreqFlags{} },
+	Data_Status:                               func() TypedIe { return &T_Data_Status{} },
+	RDS_configuration_information:             func() TypedIe { return &T_RDS_configuration_information{} },
+	MPTCP_Applicable_Indication:               func() TypedIe { return &T_MPTCP_Applicable_Indication{} },
+	Bridge_Management_Information_Container:   func() TypedIe { return &T_Bridge_Management_Information_Container{} },
+	Number_of_UE_IP_Addresses:                 func() TypedIe { return &T_Number_of_UE_IP_Addresses{} },
+	Validity_Timer:                            func() TypedIe { return &T_Validity_Timer{} },
+	Offending_IE_Information:                  func() TypedIe { return &T_Offending_IE_Information{} },
+	RAT_Type:                                  func() TypedIe { return &T_RAT_Type{} },
+	L2TP_User_Authentication:                  func() TypedIe { return &T_L2TP_User_Authentication{} },
+	LNS_Address:                               func() TypedIe { return &T_LNS_Address{} },
+	Tunnel_Preference:                         func() TypedIe { return &T_Tunnel_Preference{} },
+	Calling_Number:                            func() TypedIe { return &T_Calling_Number{} },
+	Called_Number:                             func() TypedIe { return &T_Called_Number{} },
+	L2TP_Session_Indications:                  func() TypedIe { return &T_L2TP_Session_Indications{} },
+	DNS_Server_Address:                        func() TypedIe { return &T_DNS_Server_Address{} },
+	NBNS_Server_Address:                       func() TypedIe { return &T_NBNS_Server_Address{} },
+	Maximum_Receive_Unit:                      func() TypedIe { return &T_Maximum_Receive_Unit{} },
+	Thresholds:                                func() TypedIe { return &T_Thresholds{} },
+	Steering_Mode_Indicator:                   func() TypedIe { return &T_Steering_Mode_Indicator{} },
+	Group_Id:                                  func() TypedIe { return &T_Group_Id{} },
+	CP_IP_Address:                             func() TypedIe { return &T_CP_IP_Address{} },
+	DNS_Query_Filter:                          func() TypedIe { return &T_DNS_Query_Filter{} },
+	Event_Notification_URI:                    func() TypedIe { return &T_Event_Notification_URI{} },
+	Notification_Correlation_ID:               func() TypedIe { return &T_Notification_Correlation_ID{} },
+	Reporting_Flags:                           func() TypedIe { return &T_Reporting_Flags{} },
+	Predefined_Rules_Name:                     func() TypedIe { return &T_Predefined_Rules_Name{} },
+	MBS_Session_Identifier:                    func() TypedIe { return &T_MBS_Session_Identifier{} },
+	Multicast_Transport_Information:           func() TypedIe { return &T_Multicast_Transport_Information{} },
+	MBSN4mbReq_Flags:                          func() TypedIe { return &T_MBSN4mbReq_Flags{} },
+	Local_Ingress_Tunnel:                      func() TypedIe { return &T_Local_Ingress_Tunnel{} },
+	MBS_Unicast_Parameters_ID:                 func() TypedIe { return &T_MBS_Unicast_Parameters_ID{} },
+	MBSN4Resp_Flags:                           func() TypedIe { return &T_MBSN4Resp_Flags{} },
+	Tunnel_Password:                           func() TypedIe { return &T_Tunnel_Password{} },
+	Area_Session_ID:                           func() TypedIe { return &T_Area_Session_ID{} },
+	DSCP_to_PPI_Mapping_Information:           func() TypedIe { return &T_DSCP_to_PPI_Mapping_Information{} },
+	PfcpsdrspFlags:                            func() TypedIe { return &T_PfcpsdrspFlags{} },
+	QER_Indications:                           func() TypedIe { return &T_QER_Indications{} },
+	Vendor_Specific_Node_Report_Type:          func() TypedIe { return &T_Vendor_Specific_Node_Report_Type{} },
+	Configured_Time_Domain:                    func() TypedIe { return &T_Configured_Time_Domain{} },
+}
+
+// T_Cause is the typed value of the Cause IE
+type T_Cause struct{ Value uint8 }
+
+func (T_Cause) TypeCode() IeTypeCode { return Cause }
+
+func (ie *T_Cause) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Cause) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Source_Interface is the typed value of the Source Interface IE
+type T_Source_Interface struct{ EnumInterface }
+
+func (T_Source_Interface) TypeCode() IeTypeCode { return Source_Interface }
+
+// T_F_TEID is the typed value of the F-TEID IE
+type T_F_TEID struct{ FTeid }
+
+func (T_F_TEID) TypeCode() IeTypeCode { return F_TEID }
+
+// T_Network_Instance is the typed value of the Network Instance IE
+type T_Network_Instance struct{ Value []byte }
+
+func (T_Network_Instance) TypeCode() IeTypeCode { return Network_Instance }
+
+func (ie *T_Network_Instance) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Network_Instance) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_SDF_Filter is the typed value of the SDF Filter IE
+type T_SDF_Filter struct{ SdfFilter }
+
+func (T_SDF_Filter) TypeCode() IeTypeCode { return SDF_Filter }
+
+// T_Application_ID is the typed value of the Application ID IE
+type T_Application_ID struct{ Value []byte }
+
+func (T_Application_ID) TypeCode() IeTypeCode { return Application_ID }
+
+func (ie *T_Application_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Application_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Gate_Status is the typed value of the Gate Status IE
+type T_Gate_Status struct{ GateStatus }
+
+func (T_Gate_Status) TypeCode() IeTypeCode { return Gate_Status }
+
+// T_MBR is the typed value of the MBR IE
+type T_MBR struct{ BitRate }
+
+func (T_MBR) TypeCode() IeTypeCode { return MBR }
+
+// T_GBR is the typed value of the GBR IE
+type T_GBR struct{ BitRate }
+
+func (T_GBR) TypeCode() IeTypeCode { return GBR }
+
+// T_QER_Correlation_ID is the typed value of the QER Correlation ID IE
+type T_QER_Correlation_ID struct{ Value uint32 }
+
+func (T_QER_Correlation_ID) TypeCode() IeTypeCode { return QER_Correlation_ID }
+
+func (ie *T_QER_Correlation_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_QER_Correlation_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Precedence is the typed value of the Precedence IE
+type T_Precedence struct{ Value uint32 }
+
+func (T_Precedence) TypeCode() IeTypeCode { return Precedence }
+
+func (ie *T_Precedence) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Precedence) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Transport_Level_Marking is the typed value of the Transport Level Marking IE
+type T_Transport_Level_Marking struct{ Value uint16 }
+
+func (T_Transport_Level_Marking) TypeCode() IeTypeCode { return Transport_Level_Marking }
+
+func (ie *T_Transport_Level_Marking) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_Transport_Level_Marking) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_Volume_Threshold is the typed value of the Volume Threshold IE
+type T_Volume_Threshold struct{ Volume }
+
+func (T_Volume_Threshold) TypeCode() IeTypeCode { return Volume_Threshold }
+
+// T_Time_Threshold is the typed value of the Time Threshold IE
+type T_Time_Threshold struct{ Value uint32 }
+
+func (T_Time_Threshold) TypeCode() IeTypeCode { return Time_Threshold }
+
+func (ie *T_Time_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Time_Threshold) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Monitoring_Time is the typed value of the Monitoring Time IE
+type T_Monitoring_Time struct{ Value uint32 }
+
+func (T_Monitoring_Time) TypeCode() IeTypeCode { return Monitoring_Time }
+
+func (ie *T_Monitoring_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Monitoring_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Subsequent_Volume_Threshold is the typed value of the Subsequent Volume Threshold IE
+type T_Subsequent_Volume_Threshold struct{ Volume }
+
+func (T_Subsequent_Volume_Threshold) TypeCode() IeTypeCode { return Subsequent_Volume_Threshold }
+
+// T_Subsequent_Time_Threshold is the typed value of the Subsequent Time Threshold IE
+type T_Subsequent_Time_Threshold struct{ Value uint32 }
+
+func (T_Subsequent_Time_Threshold) TypeCode() IeTypeCode { return Subsequent_Time_Threshold }
+
+func (ie *T_Subsequent_Time_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Subsequent_Time_Threshold) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Inactivity_Detection_Time is the typed value of the Inactivity Detection Time IE
+type T_Inactivity_Detection_Time struct{ Value uint32 }
+
+func (T_Inactivity_Detection_Time) TypeCode() IeTypeCode { return Inactivity_Detection_Time }
+
+func (ie *T_Inactivity_Detection_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Inactivity_Detection_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Reporting_Triggers is the typed value of the Reporting Triggers IE
+type T_Reporting_Triggers struct{ Flags }
+
+func (T_Reporting_Triggers) TypeCode() IeTypeCode { return Reporting_Triggers }
+
+// T_Redirect_Information is the typed value of the Redirect Information IE
+type T_Redirect_Information struct{ RedirectInformation }
+
+func (T_Redirect_Information) TypeCode() IeTypeCode { return Redirect_Information }
+
+// T_Report_Type is the typed value of the Report Type IE
+type T_Report_Type struct{ Flags }
+
+func (T_Report_Type) TypeCode() IeTypeCode { return Report_Type }
+
+// T_Offending_IE is the typed value of the Offending IE IE
+type T_Offending_IE struct{ Value uint16 }
+
+func (T_Offending_IE) TypeCode() IeTypeCode { return Offending_IE }
+
+func (ie *T_Offending_IE) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_Offending_IE) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_Forwarding_Policy is the typed value of the Forwarding Policy IE
+type T_Forwarding_Policy struct{ ForwardingPolicy }
+
+func (T_Forwarding_Policy) TypeCode() IeTypeCode { return Forwarding_Policy }
+
+// T_Destination_Interface is the typed value of the Destination Interface IE
+type T_Destination_Interface struct{ EnumInterface }
+
+func (T_Destination_Interface) TypeCode() IeTypeCode { return Destination_Interface }
+
+// T_UP_Function_Features is the typed value of the UP Function Features IE
+type T_UP_Function_Features struct{ Flags }
+
+func (T_UP_Function_Features) TypeCode() IeTypeCode { return UP_Function_Features }
+
+// T_Apply_Action is the typed value of the Apply Action IE
+type T_Apply_Action struct{ Flags }
+
+func (T_Apply_Action) TypeCode() IeTypeCode { return Apply_Action }
+
+// T_Downlink_Data_Service_Information is the typed value of the Downlink Data Service Information IE
+type T_Downlink_Data_Service_Information struct{ DownlinkDataServiceInformation }
+
+func (T_Downlink_Data_Service_Information) TypeCode() IeTypeCode {
+	return Downlink_Data_Service_Information
+}
+
+// T_Downlink_Data_Notification_Delay is the typed value of the Downlink Data Notification Delay IE
+type T_Downlink_Data_Notification_Delay struct{ Value uint8 }
+
+func (T_Downlink_Data_Notification_Delay) TypeCode() IeTypeCode {
+	return Downlink_Data_Notification_Delay
+}
+
+func (ie *T_Downlink_Data_Notification_Delay) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Downlink_Data_Notification_Delay) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_DL_Buffering_Duration is the typed value of the DL Buffering Duration IE
+type T_DL_Buffering_Duration struct{ Value uint8 }
+
+func (T_DL_Buffering_Duration) TypeCode() IeTypeCode { return DL_Buffering_Duration }
+
+func (ie *T_DL_Buffering_Duration) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_DL_Buffering_Duration) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_DL_Buffering_Suggested_Packet_Count is the typed value of the DL Buffering Suggested Packet Count IE
+type T_DL_Buffering_Suggested_Packet_Count struct{ Value []byte }
+
+func (T_DL_Buffering_Suggested_Packet_Count) TypeCode() IeTypeCode {
+	return DL_Buffering_Suggested_Packet_Count
+}
+
+func (ie *T_DL_Buffering_Suggested_Packet_Count) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_DL_Buffering_Suggested_Packet_Count) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_PfcpsmreqFlags is the typed value of the PFCPSMReq-Flags IE
+type T_PfcpsmreqFlags struct{ Flags }
+
+func (T_PfcpsmreqFlags) TypeCode() IeTypeCode { return PfcpsmreqFlags }
+
+// T_PfcpsrrspFlags is the typed value of the PFCPSRRsp-Flags IE
+type T_PfcpsrrspFlags struct{ Flags }
+
+func (T_PfcpsrrspFlags) TypeCode() IeTypeCode { return PfcpsrrspFlags }
+
+// T_Sequence_Number is the typed value of the Sequence Number IE
+type T_Sequence_Number struct{ Value uint32 }
+
+func (T_Sequence_Number) TypeCode() IeTypeCode { return Sequence_Number }
+
+func (ie *T_Sequence_Number) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Sequence_Number) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Metric is the typed value of the Metric IE
+type T_Metric struct{ Value uint8 }
+
+func (T_Metric) TypeCode() IeTypeCode { return Metric }
+
+func (ie *T_Metric) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Metric) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Timer is the typed value of the Timer IE
+type T_Timer struct{ Value uint8 }
+
+func (T_Timer) TypeCode() IeTypeCode { return Timer }
+
+func (ie *T_Timer) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Timer) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_PDR_ID is the typed value of the PDR ID IE
+type T_PDR_ID struct{ Value uint16 }
+
+func (T_PDR_ID) TypeCode() IeTypeCode { return PDR_ID }
+
+func (ie *T_PDR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_PDR_ID) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_F_SEID is the typed value of the F-SEID IE
+type T_F_SEID struct{ FSeid }
+
+func (T_F_SEID) TypeCode() IeTypeCode { return F_SEID }
+
+// T_Node_ID is the typed value of the Node ID IE
+type T_Node_ID struct{ NodeId }
+
+func (T_Node_ID) TypeCode() IeTypeCode { return Node_ID }
+
+// T_PFD_contents is the typed value of the PFD contents IE
+type T_PFD_contents struct{ Value []byte }
+
+func (T_PFD_contents) TypeCode() IeTypeCode { return PFD_contents }
+
+func (ie *T_PFD_contents) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_PFD_contents) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Measurement_Method is the typed value of the Measurement Method IE
+type T_Measurement_Method struct{ Flags }
+
+func (T_Measurement_Method) TypeCode() IeTypeCode { return Measurement_Method }
+
+// T_Usage_Report_Trigger is the typed value of the Usage Report Trigger IE
+type T_Usage_Report_Trigger struct{ Flags }
+
+func (T_Usage_Report_Trigger) TypeCode() IeTypeCode { return Usage_Report_Trigger }
+
+// T_Measurement_Period is the typed value of the Measurement Period IE
+type T_Measurement_Period struct{ Value uint32 }
+
+func (T_Measurement_Period) TypeCode() IeTypeCode { return Measurement_Period }
+
+func (ie *T_Measurement_Period) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Measurement_Period) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_FQ_CSID is the typed value of the FQ-CSID IE
+type T_FQ_CSID struct{ FqCsid }
+
+func (T_FQ_CSID) TypeCode() IeTypeCode { return FQ_CSID }
+
+// T_Volume_Measurement is the typed value of the Volume Measurement IE
+type T_Volume_Measurement struct{ Volume }
+
+func (T_Volume_Measurement) TypeCode() IeTypeCode { return Volume_Measurement }
+
+// T_Duration_Measurement is the typed value of the Duration Measurement IE
+type T_Duration_Measurement struct{ Value uint32 }
+
+func (T_Duration_Measurement) TypeCode() IeTypeCode { return Duration_Measurement }
+
+func (ie *T_Duration_Measurement) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Duration_Measurement) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Time_of_First_Packet is the typed value of the Time of First Packet IE
+type T_Time_of_First_Packet struct{ Value uint32 }
+
+func (T_Time_of_First_Packet) TypeCode() IeTypeCode { return Time_of_First_Packet }
+
+func (ie *T_Time_of_First_Packet) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Time_of_First_Packet) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Time_of_Last_Packet is the typed value of the Time of Last Packet IE
+type T_Time_of_Last_Packet struct{ Value uint32 }
+
+func (T_Time_of_Last_Packet) TypeCode() IeTypeCode { return Time_of_Last_Packet }
+
+func (ie *T_Time_of_Last_Packet) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Time_of_Last_Packet) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Quota_Holding_Time is the typed value of the Quota Holding Time IE
+type T_Quota_Holding_Time struct{ Value uint32 }
+
+func (T_Quota_Holding_Time) TypeCode() IeTypeCode { return Quota_Holding_Time }
+
+func (ie *T_Quota_Holding_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Quota_Holding_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Dropped_DL_Traffic_Threshold is the typed value of the Dropped DL Traffic Threshold IE
+type T_Dropped_DL_Traffic_Threshold struct{ Value []byte }
+
+func (T_Dropped_DL_Traffic_Threshold) TypeCode() IeTypeCode { return Dropped_DL_Traffic_Threshold }
+
+func (ie *T_Dropped_DL_Traffic_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Dropped_DL_Traffic_Threshold) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Volume_Quota is the typed value of the Volume Quota IE
+type T_Volume_Quota struct{ Volume }
+
+func (T_Volume_Quota) TypeCode() IeTypeCode { return Volume_Quota }
+
+// T_Time_Quota is the typed value of the Time Quota IE
+type T_Time_Quota struct{ Value uint32 }
+
+func (T_Time_Quota) TypeCode() IeTypeCode { return Time_Quota }
+
+func (ie *T_Time_Quota) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Time_Quota) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Start_Time is the typed value of the Start Time IE
+type T_Start_Time struct{ Value uint32 }
+
+func (T_Start_Time) TypeCode() IeTypeCode { return Start_Time }
+
+func (ie *T_Start_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Start_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_End_Time is the typed value of the End Time IE
+type T_End_Time struct{ Value uint32 }
+
+func (T_End_Time) TypeCode() IeTypeCode { return End_Time }
+
+func (ie *T_End_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_End_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_URR_ID is the typed value of the URR ID IE
+type T_URR_ID struct{ Value uint32 }
+
+func (T_URR_ID) TypeCode() IeTypeCode { return URR_ID }
+
+func (ie *T_URR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_URR_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Linked_URR_ID is the typed value of the Linked URR ID IE
+type T_Linked_URR_ID struct{ Value uint32 }
+
+func (T_Linked_URR_ID) TypeCode() IeTypeCode { return Linked_URR_ID }
+
+func (ie *T_Linked_URR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Linked_URR_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Outer_Header_Creation is the typed value of the Outer Header Creation IE
+type T_Outer_Header_Creation struct{ OuterHeaderCreate }
+
+func (T_Outer_Header_Creation) TypeCode() IeTypeCode { return Outer_Header_Creation }
+
+// T_BAR_ID is the typed value of the BAR ID IE
+type T_BAR_ID struct{ Value uint8 }
+
+func (T_BAR_ID) TypeCode() IeTypeCode { return BAR_ID }
+
+func (ie *T_BAR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_BAR_ID) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_CP_Function_Features is the typed value of the CP Function Features IE
+type T_CP_Function_Features struct{ Flags }
+
+func (T_CP_Function_Features) TypeCode() IeTypeCode { return CP_Function_Features }
+
+// T_Usage_Information is the typed value of the Usage Information IE
+type T_Usage_Information struct{ Flags }
+
+func (T_Usage_Information) TypeCode() IeTypeCode { return Usage_Information }
+
+// T_Application_Instance_ID is the typed value of the Application Instance ID IE
+type T_Application_Instance_ID struct{ Value []byte }
+
+func (T_Application_Instance_ID) TypeCode() IeTypeCode { return Application_Instance_ID }
+
+func (ie *T_Application_Instance_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Application_Instance_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Flow_Information is the typed value of the Flow Information IE
+type T_Flow_Information struct{ Value []byte }
+
+func (T_Flow_Information) TypeCode() IeTypeCode { return Flow_Information }
+
+func (ie *T_Flow_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Flow_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_UE_IP_Address is the typed value of the UE IP Address IE
+type T_UE_IP_Address struct{ UeIpAddress }
+
+func (T_UE_IP_Address) TypeCode() IeTypeCode { return UE_IP_Address }
+
+// T_Packet_Rate is the typed value of the Packet Rate IE
+type T_Packet_Rate struct{ Value []byte }
+
+func (T_Packet_Rate) TypeCode() IeTypeCode { return Packet_Rate }
+
+func (ie *T_Packet_Rate) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Packet_Rate) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Outer_Header_Removal is the typed value of the Outer Header Removal IE
+type T_Outer_Header_Removal struct{ OuterHeaderRemoval }
+
+func (T_Outer_Header_Removal) TypeCode() IeTypeCode { return Outer_Header_Removal }
+
+// T_Recovery_Time_Stamp is the typed value of the Recovery Time Stamp IE
+type T_Recovery_Time_Stamp struct{ Value uint32 }
+
+func (T_Recovery_Time_Stamp) TypeCode() IeTypeCode { return Recovery_Time_Stamp }
+
+func (ie *T_Recovery_Time_Stamp) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Recovery_Time_Stamp) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_DL_Flow_Level_Marking is the typed value of the DL Flow Level Marking IE
+type T_DL_Flow_Level_Marking struct{ Value []byte }
+
+func (T_DL_Flow_Level_Marking) TypeCode() IeTypeCode { return DL_Flow_Level_Marking }
+
+func (ie *T_DL_Flow_Level_Marking) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_DL_Flow_Level_Marking) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Header_Enrichment is the typed value of the Header Enrichment IE
+type T_Header_Enrichment struct{ HeaderEnrichment }
+
+func (T_Header_Enrichment) TypeCode() IeTypeCode { return Header_Enrichment }
+
+// T_Measurement_Information is the typed value of the Measurement Information IE
+type T_Measurement_Information struct{ Value []byte }
+
+func (T_Measurement_Information) TypeCode() IeTypeCode { return Measurement_Information }
+
+func (ie *T_Measurement_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Measurement_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Node_Report_Type is the typed value of the Node Report Type IE
+type T_Node_Report_Type struct{ Flags }
+
+func (T_Node_Report_Type) TypeCode() IeTypeCode { return Node_Report_Type }
+
+// T_Remote_GTP_U_Peer is the typed value of the Remote GTP-U Peer IE
+type T_Remote_GTP_U_Peer struct{ RemoteGtpUPeer }
+
+func (T_Remote_GTP_U_Peer) TypeCode() IeTypeCode { return Remote_GTP_U_Peer }
+
+// T_UR_SEQN is the typed value of the UR-SEQN IE
+type T_UR_SEQN struct{ Value uint32 }
+
+func (T_UR_SEQN) TypeCode() IeTypeCode { return UR_SEQN }
+
+func (ie *T_UR_SEQN) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_UR_SEQN) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Activate_Predefined_Rules is the typed value of the Activate Predefined Rules IE
+type T_Activate_Predefined_Rules struct{ Value string }
+
+func (T_Activate_Predefined_Rules) TypeCode() IeTypeCode { return Activate_Predefined_Rules }
+
+func (ie *T_Activate_Predefined_Rules) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeString(bytes)
+	return
+}
+
+func (ie T_Activate_Predefined_Rules) Encode() []byte { return encodeString(ie.Value) }
+
+// T_Deactivate_Predefined_Rules is the typed value of the Deactivate Predefined Rules IE
+type T_Deactivate_Predefined_Rules struct{ Value string }
+
+func (T_Deactivate_Predefined_Rules) TypeCode() IeTypeCode { return Deactivate_Predefined_Rules }
+
+func (ie *T_Deactivate_Predefined_Rules) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeString(bytes)
+	return
+}
+
+func (ie T_Deactivate_Predefined_Rules) Encode() []byte { return encodeString(ie.Value) }
+
+// T_FAR_ID is the typed value of the FAR ID IE
+type T_FAR_ID struct{ Value uint32 }
+
+func (T_FAR_ID) TypeCode() IeTypeCode { return FAR_ID }
+
+func (ie *T_FAR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_FAR_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_QER_ID is the typed value of the QER ID IE
+type T_QER_ID struct{ Value uint32 }
+
+func (T_QER_ID) TypeCode() IeTypeCode { return QER_ID }
+
+func (ie *T_QER_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_QER_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_OCI_Flags is the typed value of the OCI Flags IE
+type T_OCI_Flags struct{ Flags }
+
+func (T_OCI_Flags) TypeCode() IeTypeCode { return OCI_Flags }
+
+// T_PfcparreqFlags is the typed value of the PFCP Association Release Request IE
+type T_PfcparreqFlags struct{ Flags }
+
+func (T_PfcparreqFlags) TypeCode() IeTypeCode { return PfcparreqFlags }
+
+// T_Graceful_Release_Period is the typed value of the Graceful Release Period IE
+type T_Graceful_Release_Period struct{ Value uint8 }
+
+func (T_Graceful_Release_Period) TypeCode() IeTypeCode { return Graceful_Release_Period }
+
+func (ie *T_Graceful_Release_Period) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Graceful_Release_Period) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_PDN_Type is the typed value of the PDN Type IE
+type T_PDN_Type struct{ Value uint8 }
+
+func (T_PDN_Type) TypeCode() IeTypeCode { return PDN_Type }
+
+func (ie *T_PDN_Type) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_PDN_Type) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Failed_Rule_ID is the typed value of the Failed Rule ID IE
+type T_Failed_Rule_ID struct{ FailedRuleId }
+
+func (T_Failed_Rule_ID) TypeCode() IeTypeCode { return Failed_Rule_ID }
+
+// T_Time_Quota_Mechanism is the typed value of the Time Quota Mechanism IE
+type T_Time_Quota_Mechanism struct{ Value []byte }
+
+func (T_Time_Quota_Mechanism) TypeCode() IeTypeCode { return Time_Quota_Mechanism }
+
+func (ie *T_Time_Quota_Mechanism) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Time_Quota_Mechanism) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_User_Plane_IP_Resource_Information is the typed value of the User Plane IP Resource Information (rel 15 only) IE
+type T_User_Plane_IP_Resource_Information struct{ UpIpResInfo }
+
+func (T_User_Plane_IP_Resource_Information) TypeCode() IeTypeCode {
+	return User_Plane_IP_Resource_Information
+}
+
+// T_User_Plane_Inactivity_Timer is the typed value of the User Plane Inactivity Timer IE
+type T_User_Plane_Inactivity_Timer struct{ Value uint32 }
+
+func (T_User_Plane_Inactivity_Timer) TypeCode() IeTypeCode { return User_Plane_Inactivity_Timer }
+
+func (ie *T_User_Plane_Inactivity_Timer) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_User_Plane_Inactivity_Timer) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Multiplier is the typed value of the Multiplier IE
+type T_Multiplier struct{ MultiplierValue }
+
+func (T_Multiplier) TypeCode() IeTypeCode { return Multiplier }
+
+// T_Aggregated_URR_ID is the typed value of the Aggregated URR ID IE
+type T_Aggregated_URR_ID struct{ Value uint32 }
+
+func (T_Aggregated_URR_ID) TypeCode() IeTypeCode { return Aggregated_URR_ID }
+
+func (ie *T_Aggregated_URR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Aggregated_URR_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Subsequent_Volume_Quota is the typed value of the Subsequent Volume Quota IE
+type T_Subsequent_Volume_Quota struct{ Volume }
+
+func (T_Subsequent_Volume_Quota) TypeCode() IeTypeCode { return Subsequent_Volume_Quota }
+
+// T_Subsequent_Time_Quota is the typed value of the Subsequent Time Quota IE
+type T_Subsequent_Time_Quota struct{ Value uint32 }
+
+func (T_Subsequent_Time_Quota) TypeCode() IeTypeCode { return Subsequent_Time_Quota }
+
+func (ie *T_Subsequent_Time_Quota) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Subsequent_Time_Quota) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_RQI is the typed value of the RQI IE
+type T_RQI struct{ Flags }
+
+func (T_RQI) TypeCode() IeTypeCode { return RQI }
+
+// T_QFI is the typed value of the QFI IE
+type T_QFI struct{ Value uint8 }
+
+func (T_QFI) TypeCode() IeTypeCode { return QFI }
+
+func (ie *T_QFI) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_QFI) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Query_URR_Reference is the typed value of the Query URR Reference IE
+type T_Query_URR_Reference struct{ Value uint32 }
+
+func (T_Query_URR_Reference) TypeCode() IeTypeCode { return Query_URR_Reference }
+
+func (ie *T_Query_URR_Reference) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Query_URR_Reference) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Additional_Usage_Reports_Information is the typed value of the Additional Usage Reports Information IE
+type T_Additional_Usage_Reports_Information struct{ Value []byte }
+
+func (T_Additional_Usage_Reports_Information) TypeCode() IeTypeCode {
+	return Additional_Usage_Reports_Information
+}
+
+func (ie *T_Additional_Usage_Reports_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Additional_Usage_Reports_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Traffic_Endpoint_ID is the typed value of the Traffic Endpoint ID IE
+type T_Traffic_Endpoint_ID struct{ Value uint8 }
+
+func (T_Traffic_Endpoint_ID) TypeCode() IeTypeCode { return Traffic_Endpoint_ID }
+
+func (ie *T_Traffic_Endpoint_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Traffic_Endpoint_ID) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_MAC_address is the typed value of the MAC address IE
+type T_MAC_address struct{ Value []byte }
+
+func (T_MAC_address) TypeCode() IeTypeCode { return MAC_address }
+
+func (ie *T_MAC_address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MAC_address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_C_TAG is the typed value of the C-TAG IE
+type T_C_TAG struct{ Value []byte }
+
+func (T_C_TAG) TypeCode() IeTypeCode { return C_TAG }
+
+func (ie *T_C_TAG) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_C_TAG) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_S_TAG is the typed value of the S-TAG IE
+type T_S_TAG struct{ Value []byte }
+
+func (T_S_TAG) TypeCode() IeTypeCode { return S_TAG }
+
+func (ie *T_S_TAG) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_S_TAG) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Ethertype is the typed value of the Ethertype IE
+type T_Ethertype struct{ Value []byte }
+
+func (T_Ethertype) TypeCode() IeTypeCode { return Ethertype }
+
+func (ie *T_Ethertype) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Ethertype) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Proxying is the typed value of the Proxying IE
+type T_Proxying struct{ Flags }
+
+func (T_Proxying) TypeCode() IeTypeCode { return Proxying }
+
+// T_Ethernet_Filter_ID is the typed value of the Ethernet Filter ID IE
+type T_Ethernet_Filter_ID struct{ Value uint32 }
+
+func (T_Ethernet_Filter_ID) TypeCode() IeTypeCode { return Ethernet_Filter_ID }
+
+func (ie *T_Ethernet_Filter_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Ethernet_Filter_ID) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Ethernet_Filter_Properties is the typed value of the Ethernet Filter Properties IE
+type T_Ethernet_Filter_Properties struct{ Flags }
+
+func (T_Ethernet_Filter_Properties) TypeCode() IeTypeCode { return Ethernet_Filter_Properties }
+
+// T_Suggested_Buffering_Packets_Count is the typed value of the Suggested Buffering Packets Count IE
+type T_Suggested_Buffering_Packets_Count struct{ Value uint8 }
+
+func (T_Suggested_Buffering_Packets_Count) TypeCode() IeTypeCode {
+	return Suggested_Buffering_Packets_Count
+}
+
+func (ie *T_Suggested_Buffering_Packets_Count) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Suggested_Buffering_Packets_Count) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_User_ID is the typed value of the User ID IE
+type T_User_ID struct{ Value []byte }
+
+func (T_User_ID) TypeCode() IeTypeCode { return User_ID }
+
+func (ie *T_User_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_User_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Ethernet_PDU_Session_Information is the typed value of the Ethernet PDU Session Information IE
+type T_Ethernet_PDU_Session_Information struct{ Value []byte }
+
+func (T_Ethernet_PDU_Session_Information) TypeCode() IeTypeCode {
+	return Ethernet_PDU_Session_Information
+}
+
+func (ie *T_Ethernet_PDU_Session_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Ethernet_PDU_Session_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MAC_Addresses_Detected is the typed value of the MAC Addresses Detected IE
+type T_MAC_Addresses_Detected struct{ Value []byte }
+
+func (T_MAC_Addresses_Detected) TypeCode() IeTypeCode { return MAC_Addresses_Detected }
+
+func (ie *T_MAC_Addresses_Detected) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MAC_Addresses_Detected) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MAC_Addresses_Removed is the typed value of the MAC Addresses Removed IE
+type T_MAC_Addresses_Removed struct{ Value []byte }
+
+func (T_MAC_Addresses_Removed) TypeCode() IeTypeCode { return MAC_Addresses_Removed }
+
+func (ie *T_MAC_Addresses_Removed) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MAC_Addresses_Removed) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Ethernet_Inactivity_Timer is the typed value of the Ethernet Inactivity Timer IE
+type T_Ethernet_Inactivity_Timer struct{ Value uint32 }
+
+func (T_Ethernet_Inactivity_Timer) TypeCode() IeTypeCode { return Ethernet_Inactivity_Timer }
+
+func (ie *T_Ethernet_Inactivity_Timer) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Ethernet_Inactivity_Timer) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Event_Quota is the typed value of the Event Quota IE
+type T_Event_Quota struct{ Value uint32 }
+
+func (T_Event_Quota) TypeCode() IeTypeCode { return Event_Quota }
+
+func (ie *T_Event_Quota) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Event_Quota) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Event_Threshold is the typed value of the Event Threshold IE
+type T_Event_Threshold struct{ Value uint32 }
+
+func (T_Event_Threshold) TypeCode() IeTypeCode { return Event_Threshold }
+
+func (ie *T_Event_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Event_Threshold) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Subsequent_Event_Quota is the typed value of the Subsequent Event Quota IE
+type T_Subsequent_Event_Quota struct{ Value uint32 }
+
+func (T_Subsequent_Event_Quota) TypeCode() IeTypeCode { return Subsequent_Event_Quota }
+
+func (ie *T_Subsequent_Event_Quota) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Subsequent_Event_Quota) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Subsequent_Event_Threshold is the typed value of the Subsequent Event Threshold IE
+type T_Subsequent_Event_Threshold struct{ Value uint32 }
+
+func (T_Subsequent_Event_Threshold) TypeCode() IeTypeCode { return Subsequent_Event_Threshold }
+
+func (ie *T_Subsequent_Event_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Subsequent_Event_Threshold) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Trace_Information is the typed value of the Trace Information IE
+type T_Trace_Information struct{ Value []byte }
+
+func (T_Trace_Information) TypeCode() IeTypeCode { return Trace_Information }
+
+func (ie *T_Trace_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Trace_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Framed_Route is the typed value of the Framed-Route IE
+type T_Framed_Route struct{ Value []byte }
+
+func (T_Framed_Route) TypeCode() IeTypeCode { return Framed_Route }
+
+func (ie *T_Framed_Route) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Framed_Route) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Framed_Routing is the typed value of the Framed-Routing IE
+type T_Framed_Routing struct{ Value []byte }
+
+func (T_Framed_Routing) TypeCode() IeTypeCode { return Framed_Routing }
+
+func (ie *T_Framed_Routing) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Framed_Routing) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Framed_IPv6_Route is the typed value of the Framed-IPv6-Route IE
+type T_Framed_IPv6_Route struct{ Value []byte }
+
+func (T_Framed_IPv6_Route) TypeCode() IeTypeCode { return Framed_IPv6_Route }
+
+func (ie *T_Framed_IPv6_Route) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Framed_IPv6_Route) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Time_Stamp is the typed value of the Time Stamp IE
+type T_Time_Stamp struct{ Value uint32 }
+
+func (T_Time_Stamp) TypeCode() IeTypeCode { return Time_Stamp }
+
+func (ie *T_Time_Stamp) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Time_Stamp) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Averaging_Window is the typed value of the Averaging Window IE
+type T_Averaging_Window struct{ Value uint32 }
+
+func (T_Averaging_Window) TypeCode() IeTypeCode { return Averaging_Window }
+
+func (ie *T_Averaging_Window) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Averaging_Window) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Paging_Policy_Indicator is the typed value of the Paging Policy Indicator IE
+type T_Paging_Policy_Indicator struct{ Value uint8 }
+
+func (T_Paging_Policy_Indicator) TypeCode() IeTypeCode { return Paging_Policy_Indicator }
+
+func (ie *T_Paging_Policy_Indicator) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Paging_Policy_Indicator) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_APN_DNN is the typed value of the APN/DNN IE
+type T_APN_DNN struct{ Value []byte }
+
+func (T_APN_DNN) TypeCode() IeTypeCode { return APN_DNN }
+
+func (ie *T_APN_DNN) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_APN_DNN) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_TGPP_Interface_Type is the typed value of the 3GPP Interface Type IE
+type T_TGPP_Interface_Type struct{ Value uint8 }
+
+func (T_TGPP_Interface_Type) TypeCode() IeTypeCode { return TGPP_Interface_Type }
+
+func (ie *T_TGPP_Interface_Type) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_TGPP_Interface_Type) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_PfcpsrreqFlags is the typed value of the PFCPSRReq-Flags IE
+type T_PfcpsrreqFlags struct{ Flags }
+
+func (T_PfcpsrreqFlags) TypeCode() IeTypeCode { return PfcpsrreqFlags }
+
+// T_PfcpaureqFlags is the typed value of the PFCPAUReq-Flags IE
+type T_PfcpaureqFlags struct{ Flags }
+
+func (T_PfcpaureqFlags) TypeCode() IeTypeCode { return PfcpaureqFlags }
+
+// T_Activation_Time is the typed value of the Activation Time IE
+type T_Activation_Time struct{ Value uint32 }
+
+func (T_Activation_Time) TypeCode() IeTypeCode { return Activation_Time }
+
+func (ie *T_Activation_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Activation_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Deactivation_Time is the typed value of the Deactivation Time IE
+type T_Deactivation_Time struct{ Value uint32 }
+
+func (T_Deactivation_Time) TypeCode() IeTypeCode { return Deactivation_Time }
+
+func (ie *T_Deactivation_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Deactivation_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_MAR_ID is the typed value of the MAR ID IE
+type T_MAR_ID struct{ Value uint16 }
+
+func (T_MAR_ID) TypeCode() IeTypeCode { return MAR_ID }
+
+func (ie *T_MAR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_MAR_ID) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_Steering_Functionality is the typed value of the Steering Functionality IE
+type T_Steering_Functionality struct{ Value uint8 }
+
+func (T_Steering_Functionality) TypeCode() IeTypeCode { return Steering_Functionality }
+
+func (ie *T_Steering_Functionality) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Steering_Functionality) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Steering_Mode is the typed value of the Steering Mode IE
+type T_Steering_Mode struct{ Value uint8 }
+
+func (T_Steering_Mode) TypeCode() IeTypeCode { return Steering_Mode }
+
+func (ie *T_Steering_Mode) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Steering_Mode) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Weight is the typed value of the Weight IE
+type T_Weight struct{ Value uint8 }
+
+func (T_Weight) TypeCode() IeTypeCode { return Weight }
+
+func (ie *T_Weight) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Weight) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Priority is the typed value of the Priority IE
+type T_Priority struct{ Value uint8 }
+
+func (T_Priority) TypeCode() IeTypeCode { return Priority }
+
+func (ie *T_Priority) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_Priority) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_UE_IP_address_Pool_Identity is the typed value of the UE IP address Pool Identity IE
+type T_UE_IP_address_Pool_Identity struct{ Value []byte }
+
+func (T_UE_IP_address_Pool_Identity) TypeCode() IeTypeCode { return UE_IP_address_Pool_Identity }
+
+func (ie *T_UE_IP_address_Pool_Identity) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_UE_IP_address_Pool_Identity) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Alternative_SMF_IP_Address is the typed value of the Alternative SMF IP Address IE
+type T_Alternative_SMF_IP_Address struct{ Value []byte }
+
+func (T_Alternative_SMF_IP_Address) TypeCode() IeTypeCode { return Alternative_SMF_IP_Address }
+
+func (ie *T_Alternative_SMF_IP_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Alternative_SMF_IP_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Packet_Replication_and_Detection_Carry_On_Information is the typed value of the Packet Replication and Detection Carry-On Information IE
+type T_Packet_Replication_and_Detection_Carry_On_Information struct{ Value []byte }
+
+func (T_Packet_Replication_and_Detection_Carry_On_Information) TypeCode() IeTypeCode {
+	return Packet_Replication_and_Detection_Carry_On_Information
+}
+
+func (ie *T_Packet_Replication_and_Detection_Carry_On_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Packet_Replication_and_Detection_Carry_On_Information) Encode() []byte {
+	return encodeOctets(ie.Value)
+}
+
+// T_SMF_Set_ID is the typed value of the SMF Set ID IE
+type T_SMF_Set_ID struct{ Value []byte }
+
+func (T_SMF_Set_ID) TypeCode() IeTypeCode { return SMF_Set_ID }
+
+func (ie *T_SMF_Set_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_SMF_Set_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Quota_Validity_Time is the typed value of the Quota Validity Time IE
+type T_Quota_Validity_Time struct{ Value uint32 }
+
+func (T_Quota_Validity_Time) TypeCode() IeTypeCode { return Quota_Validity_Time }
+
+func (ie *T_Quota_Validity_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Quota_Validity_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Number_of_Reports is the typed value of the Number of Reports IE
+type T_Number_of_Reports struct{ Value uint16 }
+
+func (T_Number_of_Reports) TypeCode() IeTypeCode { return Number_of_Reports }
+
+func (ie *T_Number_of_Reports) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_Number_of_Reports) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_PfcpasrspFlags is the typed value of the PFCPASRsp-Flags IE
+type T_PfcpasrspFlags struct{ Flags }
+
+func (T_PfcpasrspFlags) TypeCode() IeTypeCode { return PfcpasrspFlags }
+
+// T_CP_PFCP_Entity_IP_Address is the typed value of the CP PFCP Entity IP Address IE
+type T_CP_PFCP_Entity_IP_Address struct{ Value []byte }
+
+func (T_CP_PFCP_Entity_IP_Address) TypeCode() IeTypeCode { return CP_PFCP_Entity_IP_Address }
+
+func (ie *T_CP_PFCP_Entity_IP_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_CP_PFCP_Entity_IP_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_PfcpsereqFlags is the typed value of the PFCPSEReq-Flags IE
+type T_PfcpsereqFlags struct{ Flags }
+
+func (T_PfcpsereqFlags) TypeCode() IeTypeCode { return PfcpsereqFlags }
+
+// T_IP_Multicast_Address is the typed value of the IP Multicast Address IE
+type T_IP_Multicast_Address struct{ Value []byte }
+
+func (T_IP_Multicast_Address) TypeCode() IeTypeCode { return IP_Multicast_Address }
+
+func (ie *T_IP_Multicast_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_IP_Multicast_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Source_IP_Address is the typed value of the Source IP Address IE
+type T_Source_IP_Address struct{ SourceIpAddress }
+
+func (T_Source_IP_Address) TypeCode() IeTypeCode { return Source_IP_Address }
+
+// T_Packet_Rate_Status is the typed value of the Packet Rate Status IE
+type T_Packet_Rate_Status struct{ Value []byte }
+
+func (T_Packet_Rate_Status) TypeCode() IeTypeCode { return Packet_Rate_Status }
+
+func (ie *T_Packet_Rate_Status) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Packet_Rate_Status) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Create_Bridge_Info_for_TSC is the typed value of the Create Bridge Info for TSC IE
+type T_Create_Bridge_Info_for_TSC struct{ Flags }
+
+func (T_Create_Bridge_Info_for_TSC) TypeCode() IeTypeCode { return Create_Bridge_Info_for_TSC }
+
+// T_DS_TT_Port_Number is the typed value of the DS-TT Port Number IE
+type T_DS_TT_Port_Number struct{ Value uint32 }
+
+func (T_DS_TT_Port_Number) TypeCode() IeTypeCode { return DS_TT_Port_Number }
+
+func (ie *T_DS_TT_Port_Number) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_DS_TT_Port_Number) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_NW_TT_Port_Number is the typed value of the NW-TT Port Number IE
+type T_NW_TT_Port_Number struct{ Value uint32 }
+
+func (T_NW_TT_Port_Number) TypeCode() IeTypeCode { return NW_TT_Port_Number }
+
+func (ie *T_NW_TT_Port_Number) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_NW_TT_Port_Number) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_TSN_Bridge_ID is the typed value of the TSN Bridge ID IE
+type T_TSN_Bridge_ID struct{ Value []byte }
+
+func (T_TSN_Bridge_ID) TypeCode() IeTypeCode { return TSN_Bridge_ID }
+
+func (ie *T_TSN_Bridge_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_TSN_Bridge_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Port_Management_Information_Container is the typed value of the Port Management Information Container IE
+type T_Port_Management_Information_Container struct{ Value []byte }
+
+func (T_Port_Management_Information_Container) TypeCode() IeTypeCode {
+	return Port_Management_Information_Container
+}
+
+func (ie *T_Port_Management_Information_Container) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Port_Management_Information_Container) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Requested_Clock_Drift_Information is the typed value of the Requested Clock Drift Information IE
+type T_Requested_Clock_Drift_Information struct{ Value []byte }
+
+func (T_Requested_Clock_Drift_Information) TypeCode() IeTypeCode {
+	return Requested_Clock_Drift_Information
+}
+
+func (ie *T_Requested_Clock_Drift_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Requested_Clock_Drift_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_TSN_Time_Domain_Number is the typed value of the TSN Time Domain Number IE
+type T_TSN_Time_Domain_Number struct{ Value []byte }
+
+func (T_TSN_Time_Domain_Number) TypeCode() IeTypeCode { return TSN_Time_Domain_Number }
+
+func (ie *T_TSN_Time_Domain_Number) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_TSN_Time_Domain_Number) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Time_Offset_Threshold is the typed value of the Time Offset Threshold IE
+type T_Time_Offset_Threshold struct{ Value []byte }
+
+func (T_Time_Offset_Threshold) TypeCode() IeTypeCode { return Time_Offset_Threshold }
+
+func (ie *T_Time_Offset_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Time_Offset_Threshold) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Cumulative_rateRatio_Threshold is the typed value of the Cumulative rateRatio Threshold IE
+type T_Cumulative_rateRatio_Threshold struct{ Value []byte }
+
+func (T_Cumulative_rateRatio_Threshold) TypeCode() IeTypeCode { return Cumulative_rateRatio_Threshold }
+
+func (ie *T_Cumulative_rateRatio_Threshold) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Cumulative_rateRatio_Threshold) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Time_Offset_Measurement is the typed value of the Time Offset Measurement IE
+type T_Time_Offset_Measurement struct{ Value []byte }
+
+func (T_Time_Offset_Measurement) TypeCode() IeTypeCode { return Time_Offset_Measurement }
+
+func (ie *T_Time_Offset_Measurement) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Time_Offset_Measurement) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Cumulative_rateRatio_Measurement is the typed value of the Cumulative rateRatio Measurement IE
+type T_Cumulative_rateRatio_Measurement struct{ Value []byte }
+
+func (T_Cumulative_rateRatio_Measurement) TypeCode() IeTypeCode {
+	return Cumulative_rateRatio_Measurement
+}
+
+func (ie *T_Cumulative_rateRatio_Measurement) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Cumulative_rateRatio_Measurement) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_SRR_ID is the typed value of the SRR ID IE
+type T_SRR_ID struct{ Value uint8 }
+
+func (T_SRR_ID) TypeCode() IeTypeCode { return SRR_ID }
+
+func (ie *T_SRR_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_SRR_ID) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_Requested_Access_Availability_Information is the typed value of the Requested Access Availability Information IE
+type T_Requested_Access_Availability_Information struct{ Value []byte }
+
+func (T_Requested_Access_Availability_Information) TypeCode() IeTypeCode {
+	return Requested_Access_Availability_Information
+}
+
+func (ie *T_Requested_Access_Availability_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Requested_Access_Availability_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Access_Availability_Information is the typed value of the Access Availability Information IE
+type T_Access_Availability_Information struct{ Value []byte }
+
+func (T_Access_Availability_Information) TypeCode() IeTypeCode {
+	return Access_Availability_Information
+}
+
+func (ie *T_Access_Availability_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Access_Availability_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MPTCP_Control_Information is the typed value of the MPTCP Control Information IE
+type T_MPTCP_Control_Information struct{ Value []byte }
+
+func (T_MPTCP_Control_Information) TypeCode() IeTypeCode { return MPTCP_Control_Information }
+
+func (ie *T_MPTCP_Control_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MPTCP_Control_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_ATSSS_LL_Control_Information is the typed value of the ATSSS-LL Control Information IE
+type T_ATSSS_LL_Control_Information struct{ Value []byte }
+
+func (T_ATSSS_LL_Control_Information) TypeCode() IeTypeCode { return ATSSS_LL_Control_Information }
+
+func (ie *T_ATSSS_LL_Control_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_ATSSS_LL_Control_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_PMF_Control_Information is the typed value of the PMF Control Information IE
+type T_PMF_Control_Information struct{ Value []byte }
+
+func (T_PMF_Control_Information) TypeCode() IeTypeCode { return PMF_Control_Information }
+
+func (ie *T_PMF_Control_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_PMF_Control_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MPTCP_Address_Information is the typed value of the MPTCP Address Information IE
+type T_MPTCP_Address_Information struct{ Value []byte }
+
+func (T_MPTCP_Address_Information) TypeCode() IeTypeCode { return MPTCP_Address_Information }
+
+func (ie *T_MPTCP_Address_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MPTCP_Address_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_UE_Link_Specific_IP_Address is the typed value of the UE Link-Specific IP Address IE
+type T_UE_Link_Specific_IP_Address struct{ Value []byte }
+
+func (T_UE_Link_Specific_IP_Address) TypeCode() IeTypeCode { return UE_Link_Specific_IP_Address }
+
+func (ie *T_UE_Link_Specific_IP_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_UE_Link_Specific_IP_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_PMF_Address_Information is the typed value of the PMF Address Information IE
+type T_PMF_Address_Information struct{ Value []byte }
+
+func (T_PMF_Address_Information) TypeCode() IeTypeCode { return PMF_Address_Information }
+
+func (ie *T_PMF_Address_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_PMF_Address_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_ATSSS_LL_Information is the typed value of the ATSSS-LL Information IE
+type T_ATSSS_LL_Information struct{ Value []byte }
+
+func (T_ATSSS_LL_Information) TypeCode() IeTypeCode { return ATSSS_LL_Information }
+
+func (ie *T_ATSSS_LL_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_ATSSS_LL_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Data_Network_Access_Identifier is the typed value of the Data Network Access Identifier IE
+type T_Data_Network_Access_Identifier struct{ Value []byte }
+
+func (T_Data_Network_Access_Identifier) TypeCode() IeTypeCode { return Data_Network_Access_Identifier }
+
+func (ie *T_Data_Network_Access_Identifier) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Data_Network_Access_Identifier) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Average_Packet_Delay is the typed value of the Average Packet Delay IE
+type T_Average_Packet_Delay struct{ Value uint32 }
+
+func (T_Average_Packet_Delay) TypeCode() IeTypeCode { return Average_Packet_Delay }
+
+func (ie *T_Average_Packet_Delay) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Average_Packet_Delay) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Minimum_Packet_Delay is the typed value of the Minimum Packet Delay IE
+type T_Minimum_Packet_Delay struct{ Value uint32 }
+
+func (T_Minimum_Packet_Delay) TypeCode() IeTypeCode { return Minimum_Packet_Delay }
+
+func (ie *T_Minimum_Packet_Delay) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Minimum_Packet_Delay) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Maximum_Packet_Delay is the typed value of the Maximum Packet Delay IE
+type T_Maximum_Packet_Delay struct{ Value uint32 }
+
+func (T_Maximum_Packet_Delay) TypeCode() IeTypeCode { return Maximum_Packet_Delay }
+
+func (ie *T_Maximum_Packet_Delay) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Maximum_Packet_Delay) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_QoS_Report_Trigger is the typed value of the QoS Report Trigger IE
+type T_QoS_Report_Trigger struct{ Flags }
+
+func (T_QoS_Report_Trigger) TypeCode() IeTypeCode { return QoS_Report_Trigger }
+
+// T_GTP_U_Path_Interface_Type is the typed value of the GTP-U Path Interface Type IE
+type T_GTP_U_Path_Interface_Type struct{ Flags }
+
+func (T_GTP_U_Path_Interface_Type) TypeCode() IeTypeCode { return GTP_U_Path_Interface_Type }
+
+// T_Requested_QoS_Monitoring is the typed value of the Requested QoS Monitoring IE
+type T_Requested_QoS_Monitoring struct{ Value []byte }
+
+func (T_Requested_QoS_Monitoring) TypeCode() IeTypeCode { return Requested_QoS_Monitoring }
+
+func (ie *T_Requested_QoS_Monitoring) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Requested_QoS_Monitoring) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Reporting_Frequency is the typed value of the Reporting Frequency IE
+type T_Reporting_Frequency struct{ Flags }
+
+func (T_Reporting_Frequency) TypeCode() IeTypeCode { return Reporting_Frequency }
+
+// T_Packet_Delay_Thresholds is the typed value of the Packet Delay Thresholds IE
+type T_Packet_Delay_Thresholds struct{ Value []byte }
+
+func (T_Packet_Delay_Thresholds) TypeCode() IeTypeCode { return Packet_Delay_Thresholds }
+
+func (ie *T_Packet_Delay_Thresholds) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Packet_Delay_Thresholds) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Minimum_Wait_Time is the typed value of the Minimum Wait Time IE
+type T_Minimum_Wait_Time struct{ Value uint32 }
+
+func (T_Minimum_Wait_Time) TypeCode() IeTypeCode { return Minimum_Wait_Time }
+
+func (ie *T_Minimum_Wait_Time) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Minimum_Wait_Time) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_QoS_Monitoring_Measurement is the typed value of the QoS Monitoring Measurement IE
+type T_QoS_Monitoring_Measurement struct{ Value []byte }
+
+func (T_QoS_Monitoring_Measurement) TypeCode() IeTypeCode { return QoS_Monitoring_Measurement }
+
+func (ie *T_QoS_Monitoring_Measurement) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_QoS_Monitoring_Measurement) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MT_EDT_Control_Information is the typed value of the MT-EDT Control Information IE
+type T_MT_EDT_Control_Information struct{ Value []byte }
+
+func (T_MT_EDT_Control_Information) TypeCode() IeTypeCode { return MT_EDT_Control_Information }
+
+func (ie *T_MT_EDT_Control_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MT_EDT_Control_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_DL_Data_Packets_Size is the typed value of the DL Data Packets Size IE
+type T_DL_Data_Packets_Size struct{ Value []byte }
+
+func (T_DL_Data_Packets_Size) TypeCode() IeTypeCode { return DL_Data_Packets_Size }
+
+func (ie *T_DL_Data_Packets_Size) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_DL_Data_Packets_Size) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_QER_Control_Indications is the typed value of the QER Control Indications IE
+type T_QER_Control_Indications struct{ Flags }
+
+func (T_QER_Control_Indications) TypeCode() IeTypeCode { return QER_Control_Indications }
+
+// T_NF_Instance_ID is the typed value of the NF Instance ID IE
+type T_NF_Instance_ID struct{ Value []byte }
+
+func (T_NF_Instance_ID) TypeCode() IeTypeCode { return NF_Instance_ID }
+
+func (ie *T_NF_Instance_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_NF_Instance_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_S_NSSAI is the typed value of the S-NSSAI IE
+type T_S_NSSAI struct{ SNssai }
+
+func (T_S_NSSAI) TypeCode() IeTypeCode { return S_NSSAI }
+
+// T_IP_version is the typed value of the IP version IE
+type T_IP_version struct{ Flags }
+
+func (T_IP_version) TypeCode() IeTypeCode { return IP_version }
+
+// T_PfcpasreqFlags is the typed value of the PFCPASReq-Flags IE
+type T_PfcpasreqFlags struct{ Flags }
+
+func (T_PfcpasreqFlags) TypeCode() IeTypeCode { return PfcpasreqFlags }
+
+// T_Data_Status is the typed value of the Data Status IE
+type T_Data_Status struct{ Flags }
+
+func (T_Data_Status) TypeCode() IeTypeCode { return Data_Status }
+
+// T_RDS_configuration_information is the typed value of the RDS configuration information IE
+type T_RDS_configuration_information struct{ Value []byte }
+
+func (T_RDS_configuration_information) TypeCode() IeTypeCode { return RDS_configuration_information }
+
+func (ie *T_RDS_configuration_information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_RDS_configuration_information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MPTCP_Applicable_Indication is the typed value of the MPTCP Applicable Indication IE
+type T_MPTCP_Applicable_Indication struct{ Flags }
+
+func (T_MPTCP_Applicable_Indication) TypeCode() IeTypeCode { return MPTCP_Applicable_Indication }
+
+// T_Bridge_Management_Information_Container is the typed value of the Bridge Management Information Container IE
+type T_Bridge_Management_Information_Container struct{ Value []byte }
+
+func (T_Bridge_Management_Information_Container) TypeCode() IeTypeCode {
+	return Bridge_Management_Information_Container
+}
+
+func (ie *T_Bridge_Management_Information_Container) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Bridge_Management_Information_Container) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Number_of_UE_IP_Addresses is the typed value of the Number of UE IP Addresses IE
+type T_Number_of_UE_IP_Addresses struct{ Value []byte }
+
+func (T_Number_of_UE_IP_Addresses) TypeCode() IeTypeCode { return Number_of_UE_IP_Addresses }
+
+func (ie *T_Number_of_UE_IP_Addresses) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Number_of_UE_IP_Addresses) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Validity_Timer is the typed value of the Validity Timer IE
+type T_Validity_Timer struct{ Value uint16 }
+
+func (T_Validity_Timer) TypeCode() IeTypeCode { return Validity_Timer }
+
+func (ie *T_Validity_Timer) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_Validity_Timer) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_Offending_IE_Information is the typed value of the Offending IE Information IE
+type T_Offending_IE_Information struct{ Value []byte }
+
+func (T_Offending_IE_Information) TypeCode() IeTypeCode { return Offending_IE_Information }
+
+func (ie *T_Offending_IE_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Offending_IE_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_RAT_Type is the typed value of the RAT Type IE
+type T_RAT_Type struct{ Value uint8 }
+
+func (T_RAT_Type) TypeCode() IeTypeCode { return RAT_Type }
+
+func (ie *T_RAT_Type) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint8(bytes)
+	return
+}
+
+func (ie T_RAT_Type) Encode() []byte { return Encode_Uint8(ie.Value) }
+
+// T_L2TP_User_Authentication is the typed value of the L2TP User Authentication IE
+type T_L2TP_User_Authentication struct{ Value []byte }
+
+func (T_L2TP_User_Authentication) TypeCode() IeTypeCode { return L2TP_User_Authentication }
+
+func (ie *T_L2TP_User_Authentication) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_L2TP_User_Authentication) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_LNS_Address is the typed value of the LNS Address IE
+type T_LNS_Address struct{ Value []byte }
+
+func (T_LNS_Address) TypeCode() IeTypeCode { return LNS_Address }
+
+func (ie *T_LNS_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_LNS_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Tunnel_Preference is the typed value of the Tunnel Preference IE
+type T_Tunnel_Preference struct{ Value uint32 }
+
+func (T_Tunnel_Preference) TypeCode() IeTypeCode { return Tunnel_Preference }
+
+func (ie *T_Tunnel_Preference) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint32(bytes)
+	return
+}
+
+func (ie T_Tunnel_Preference) Encode() []byte { return Encode_Uint32(ie.Value) }
+
+// T_Calling_Number is the typed value of the Calling Number IE
+type T_Calling_Number struct{ Value string }
+
+func (T_Calling_Number) TypeCode() IeTypeCode { return Calling_Number }
+
+func (ie *T_Calling_Number) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeString(bytes)
+	return
+}
+
+func (ie T_Calling_Number) Encode() []byte { return encodeString(ie.Value) }
+
+// T_Called_Number is the typed value of the Called Number IE
+type T_Called_Number struct{ Value string }
+
+func (T_Called_Number) TypeCode() IeTypeCode { return Called_Number }
+
+func (ie *T_Called_Number) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeString(bytes)
+	return
+}
+
+func (ie T_Called_Number) Encode() []byte { return encodeString(ie.Value) }
+
+// T_L2TP_Session_Indications is the typed value of the L2TP Session Indications IE
+type T_L2TP_Session_Indications struct{ Flags }
+
+func (T_L2TP_Session_Indications) TypeCode() IeTypeCode { return L2TP_Session_Indications }
+
+// T_DNS_Server_Address is the typed value of the DNS Server Address IE
+type T_DNS_Server_Address struct{ Value []byte }
+
+func (T_DNS_Server_Address) TypeCode() IeTypeCode { return DNS_Server_Address }
+
+func (ie *T_DNS_Server_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_DNS_Server_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_NBNS_Server_Address is the typed value of the NBNS Server Address IE
+type T_NBNS_Server_Address struct{ Value []byte }
+
+func (T_NBNS_Server_Address) TypeCode() IeTypeCode { return NBNS_Server_Address }
+
+func (ie *T_NBNS_Server_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_NBNS_Server_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Maximum_Receive_Unit is the typed value of the Maximum Receive Unit IE
+type T_Maximum_Receive_Unit struct{ Value uint16 }
+
+func (T_Maximum_Receive_Unit) TypeCode() IeTypeCode { return Maximum_Receive_Unit }
+
+func (ie *T_Maximum_Receive_Unit) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_Maximum_Receive_Unit) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_Thresholds is the typed value of the Thresholds IE
+type T_Thresholds struct{ Value []byte }
+
+func (T_Thresholds) TypeCode() IeTypeCode { return Thresholds }
+
+func (ie *T_Thresholds) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Thresholds) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Steering_Mode_Indicator is the typed value of the Steering Mode Indicator IE
+type T_Steering_Mode_Indicator struct{ Flags }
+
+func (T_Steering_Mode_Indicator) TypeCode() IeTypeCode { return Steering_Mode_Indicator }
+
+// T_Group_Id is the typed value of the Group Id IE
+type T_Group_Id struct{ Value []byte }
+
+func (T_Group_Id) TypeCode() IeTypeCode { return Group_Id }
+
+func (ie *T_Group_Id) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Group_Id) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_CP_IP_Address is the typed value of the CP IP Address IE
+type T_CP_IP_Address struct{ Value []byte }
+
+func (T_CP_IP_Address) TypeCode() IeTypeCode { return CP_IP_Address }
+
+func (ie *T_CP_IP_Address) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_CP_IP_Address) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_DNS_Query_Filter is the typed value of the DNS Query Filter IE
+type T_DNS_Query_Filter struct{ Value []byte }
+
+func (T_DNS_Query_Filter) TypeCode() IeTypeCode { return DNS_Query_Filter }
+
+func (ie *T_DNS_Query_Filter) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_DNS_Query_Filter) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Event_Notification_URI is the typed value of the Event Notification URI IE
+type T_Event_Notification_URI struct{ Value string }
+
+func (T_Event_Notification_URI) TypeCode() IeTypeCode { return Event_Notification_URI }
+
+func (ie *T_Event_Notification_URI) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeString(bytes)
+	return
+}
+
+func (ie T_Event_Notification_URI) Encode() []byte { return encodeString(ie.Value) }
+
+// T_Notification_Correlation_ID is the typed value of the Notification Correlation ID IE
+type T_Notification_Correlation_ID struct{ Value []byte }
+
+func (T_Notification_Correlation_ID) TypeCode() IeTypeCode { return Notification_Correlation_ID }
+
+func (ie *T_Notification_Correlation_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Notification_Correlation_ID) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Reporting_Flags is the typed value of the Reporting Flags IE
+type T_Reporting_Flags struct{ Flags }
+
+func (T_Reporting_Flags) TypeCode() IeTypeCode { return Reporting_Flags }
+
+// T_Predefined_Rules_Name is the typed value of the Predefined Rules Name IE
+type T_Predefined_Rules_Name struct{ Value string }
+
+func (T_Predefined_Rules_Name) TypeCode() IeTypeCode { return Predefined_Rules_Name }
+
+func (ie *T_Predefined_Rules_Name) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeString(bytes)
+	return
+}
+
+func (ie T_Predefined_Rules_Name) Encode() []byte { return encodeString(ie.Value) }
+
+// T_MBS_Session_Identifier is the typed value of the MBS Session Identifier IE
+type T_MBS_Session_Identifier struct{ Value []byte }
+
+func (T_MBS_Session_Identifier) TypeCode() IeTypeCode { return MBS_Session_Identifier }
+
+func (ie *T_MBS_Session_Identifier) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_MBS_Session_Identifier) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Multicast_Transport_Information is the typed value of the Multicast Transport Information IE
+type T_Multicast_Transport_Information struct{ Value []byte }
+
+func (T_Multicast_Transport_Information) TypeCode() IeTypeCode {
+	return Multicast_Transport_Information
+}
+
+func (ie *T_Multicast_Transport_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Multicast_Transport_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MBSN4mbReq_Flags is the typed value of the MBSN4mbReq Flags IE
+type T_MBSN4mbReq_Flags struct{ Flags }
+
+func (T_MBSN4mbReq_Flags) TypeCode() IeTypeCode { return MBSN4mbReq_Flags }
+
+// T_Local_Ingress_Tunnel is the typed value of the Local Ingress Tunnel IE
+type T_Local_Ingress_Tunnel struct{ Value []byte }
+
+func (T_Local_Ingress_Tunnel) TypeCode() IeTypeCode { return Local_Ingress_Tunnel }
+
+func (ie *T_Local_Ingress_Tunnel) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Local_Ingress_Tunnel) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_MBS_Unicast_Parameters_ID is the typed value of the MBS Unicast Parameters ID IE
+type T_MBS_Unicast_Parameters_ID struct{ Value uint16 }
+
+func (T_MBS_Unicast_Parameters_ID) TypeCode() IeTypeCode { return MBS_Unicast_Parameters_ID }
+
+func (ie *T_MBS_Unicast_Parameters_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_MBS_Unicast_Parameters_ID) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_MBSN4Resp_Flags is the typed value of the MBSN4Resp Flags IE
+type T_MBSN4Resp_Flags struct{ Flags }
+
+func (T_MBSN4Resp_Flags) TypeCode() IeTypeCode { return MBSN4Resp_Flags }
+
+// T_Tunnel_Password is the typed value of the Tunnel Password IE
+type T_Tunnel_Password struct{ Value []byte }
+
+func (T_Tunnel_Password) TypeCode() IeTypeCode { return Tunnel_Password }
+
+func (ie *T_Tunnel_Password) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Tunnel_Password) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Area_Session_ID is the typed value of the Area Session ID IE
+type T_Area_Session_ID struct{ Value uint16 }
+
+func (T_Area_Session_ID) TypeCode() IeTypeCode { return Area_Session_ID }
+
+func (ie *T_Area_Session_ID) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeUint16(bytes)
+	return
+}
+
+func (ie T_Area_Session_ID) Encode() []byte { return Encode_Uint16(ie.Value) }
+
+// T_DSCP_to_PPI_Mapping_Information is the typed value of the DSCP to PPI Mapping Information IE
+type T_DSCP_to_PPI_Mapping_Information struct{ Value []byte }
+
+func (T_DSCP_to_PPI_Mapping_Information) TypeCode() IeTypeCode {
+	return DSCP_to_PPI_Mapping_Information
+}
+
+func (ie *T_DSCP_to_PPI_Mapping_Information) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_DSCP_to_PPI_Mapping_Information) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_PfcpsdrspFlags is the typed value of the PFCPSDRsp-Flags IE
+type T_PfcpsdrspFlags struct{ Flags }
+
+func (T_PfcpsdrspFlags) TypeCode() IeTypeCode { return PfcpsdrspFlags }
+
+// T_QER_Indications is the typed value of the QER Indications IE
+type T_QER_Indications struct{ Flags }
+
+func (T_QER_Indications) TypeCode() IeTypeCode { return QER_Indications }
+
+// T_Vendor_Specific_Node_Report_Type is the typed value of the Vendor-Specific Node Report Type IE
+type T_Vendor_Specific_Node_Report_Type struct{ Value []byte }
+
+func (T_Vendor_Specific_Node_Report_Type) TypeCode() IeTypeCode {
+	return Vendor_Specific_Node_Report_Type
+}
+
+func (ie *T_Vendor_Specific_Node_Report_Type) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Vendor_Specific_Node_Report_Type) Encode() []byte { return encodeOctets(ie.Value) }
+
+// T_Configured_Time_Domain is the typed value of the Configured Time Domain IE
+type T_Configured_Time_Domain struct{ Value []byte }
+
+func (T_Configured_Time_Domain) TypeCode() IeTypeCode { return Configured_Time_Domain }
+
+func (ie *T_Configured_Time_Domain) Decode(bytes []byte) (err error) {
+	ie.Value, err = decodeOctets(bytes)
+	return
+}
+
+func (ie T_Configured_Time_Domain) Encode() []byte { return encodeOctets(ie.Value) }
+
+var groupIeAttributeSets = map[IeTypeCode]groupIeAttributeSet{
+	Create_PDR: {
+		PDR_ID:                    groupIeAttributes{required: true, isID: true},
+		PDI:                       groupIeAttributes{required: true},
+		Precedence:                groupIeAttributes{},
+		Outer_Header_Removal:      groupIeAttributes{},
+		FAR_ID:                    groupIeAttributes{},
+		URR_ID:                    groupIeAttributes{multiple: true},
+		QER_ID:                    groupIeAttributes{multiple: true},
+		Activate_Predefined_Rules: groupIeAttributes{multiple: true},
+		Activation_Time:           groupIeAttributes{},
+		Deactivation_Time:         groupIeAttributes{},
+		MAR_ID:                    groupIeAttributes{},
+		Packet_Replication_and_Detection_Carry_On_Information: groupIeAttributes{},
+		IP_Multicast_Addressing_Info:                          groupIeAttributes{multiple: true},
+		UE_IP_address_Pool_Identity:                           groupIeAttributes{multiple: true},
+		MPTCP_Applicable_Indication:                           groupIeAttributes{},
+		Transport_Delay_Reporting:                             groupIeAttributes{},
+		RAT_Type:                                              groupIeAttributes{},
+	},
+	PDI: {
+		Source_Interface:                  groupIeAttributes{required: true},
+		F_TEID:                            groupIeAttributes{},
+		Local_Ingress_Tunnel:              groupIeAttributes{},
+		Network_Instance:                  groupIeAttributes{},
+		Redundant_Transmission_Parameters: groupIeAttributes{},
+		UE_IP_Address:                     groupIeAttributes{multiple: true},
+		Traffic_Endpoint_ID:               groupIeAttributes{multiple: true},
+		SDF_Filter:                        groupIeAttributes{multiple: true},
+		Application_ID:                    groupIeAttributes{},
+		Ethernet_PDU_Session_Information:  groupIeAttributes{},
+		Ethernet_Packet_Filter:            groupIeAttributes{multiple: true},
+		QFI:                               groupIeAttributes{multiple: true},
+		Framed_Route:                      groupIeAttributes{multiple: true},
+		Framed_Routing:                    groupIeAttributes{},
+		Framed_IPv6_Route:                 groupIeAttributes{multiple: true},
+		TGPP_Interface_Type:               groupIeAttributes{},
+		IP_Multicast_Addressing_Info:      groupIeAttributes{multiple: true},
+		DNS_Query_Filter:                  groupIeAttributes{multiple: true},
+		MBS_Session_Identifier:            groupIeAttributes{},
+		Area_Session_ID:                   groupIeAttributes{},
+	},
+	Create_FAR: {
+		FAR_ID:                 groupIeAttributes{required: true, isID: true},
+		Apply_Action:           groupIeAttributes{},
+		Forwarding_Parameters:  groupIeAttributes{},
+		Duplicating_Parameters: groupIeAttributes{multiple: true},
+		BAR_ID:                 groupIeAttributes{},
+		Redundant_Transmission_Forwarding_Parameters: groupIeAttributes{},
+		MBS_Multicast_Parameters:                     groupIeAttributes{multiple: true},
+		Add_MBS_Unicast_Parameters:                   groupIeAttributes{multiple: true},
+	},
+	Forwarding_Parameters: {
+		Destination_Interface:                  groupIeAttributes{},
+		Network_Instance:                       groupIeAttributes{},
+		Redirect_Information:                   groupIeAttributes{},
+		Outer_Header_Creation:                  groupIeAttributes{},
+		Transport_Level_Marking:                groupIeAttributes{},
+		Forwarding_Policy:                      groupIeAttributes{},
+		Header_Enrichment:                      groupIeAttributes{},
+		Traffic_Endpoint_ID:                    groupIeAttributes{},
+		Proxying:                               groupIeAttributes{},
+		TGPP_Interface_Type:                    groupIeAttributes{},
+		Data_Network_Access_Identifier:         groupIeAttributes{},
+		IP_Address_and_Port_Number_Replacement: groupIeAttributes{},
+	},
+	Duplicating_Parameters: {
+		Destination_Interface:   groupIeAttributes{required: true},
+		Outer_Header_Creation:   groupIeAttributes{},
+		Transport_Level_Marking: groupIeAttributes{},
+		Forwarding_Policy:       groupIeAttributes{},
+	},
+	Create_URR: {
+		URR_ID:                       groupIeAttributes{required: true, isID: true},
+		Measurement_Method:           groupIeAttributes{},
+		Reporting_Triggers:           groupIeAttributes{},
+		Measurement_Period:           groupIeAttributes{},
+		Volume_Threshold:             groupIeAttributes{},
+		Volume_Quota:                 groupIeAttributes{},
+		Event_Threshold:              groupIeAttributes{},
+		Event_Quota:                  groupIeAttributes{},
+		Time_Threshold:               groupIeAttributes{},
+		Time_Quota:                   groupIeAttributes{},
+		Quota_Holding_Time:           groupIeAttributes{},
+		Dropped_DL_Traffic_Threshold: groupIeAttributes{},
+		Quota_Validity_Time:          groupIeAttributes{},
+		Monitoring_Time:              groupIeAttributes{},
+		Subsequent_Volume_Threshold:  groupIeAttributes{},
+		Subsequent_Time_Threshold:    groupIeAttributes{},
+		Subsequent_Volume_Quota:      groupIeAttributes{},
+		Subsequent_Time_Quota:        groupIeAttributes{},
+		Subsequent_Event_Threshold:   groupIeAttributes{},
+		Subsequent_Event_Quota:       groupIeAttributes{},
+		Inactivity_Detection_Time:    groupIeAttributes{},
+		Linked_URR_ID:                groupIeAttributes{multiple: true},
+		Measurement_Information:      groupIeAttributes{},
+		Time_Quota_Mechanism:         groupIeAttributes{},
+		Aggregated_URRs:              groupIeAttributes{multiple: true},
+		FAR_ID:                       groupIeAttributes{}, // FAR ID for Quota Action
+		Ethernet_Inactivity_Timer:    groupIeAttributes{},
+		Additional_Monitoring_Time:   groupIeAttributes{multiple: true},
+		Number_of_Reports:            groupIeAttributes{},
+		Application_ID:               groupIeAttributes{multiple: true}, // Exempted Application ID for Quota Action
+		SDF_Filter:                   groupIeAttributes{multiple: true}, // Exempted SDF Filter for Quota Action
+		User_Plane_Inactivity_Timer:  groupIeAttributes{},
+	},
+	Create_QER: {
+		QER_ID:                  groupIeAttributes{required: true, isID: true},
+		QER_Correlation_ID:      groupIeAttributes{},
+		Gate_Status:             groupIeAttributes{},
+		MBR:                     groupIeAttributes{},
+		GBR:                     groupIeAttributes{},
+		Packet_Rate_Status:      groupIeAttributes{},
+		DL_Flow_Level_Marking:   groupIeAttributes{},
+		QFI:                     groupIeAttributes{},
+		RQI:                     groupIeAttributes{},
+		Paging_Policy_Indicator: groupIeAttributes{},
+		Averaging_Window:        groupIeAttributes{},
+		QER_Control_Indications: groupIeAttributes{},
+		QER_Indications:         groupIeAttributes{},
+	},
+	Created_PDR: {
+		PDR_ID:               groupIeAttributes{required: true, isID: true},
+		Precedence:           groupIeAttributes{},
+		PDI:                  groupIeAttributes{},
+		Outer_Header_Removal: groupIeAttributes{},
+		FAR_ID:               groupIeAttributes{},
+		QER_ID:               groupIeAttributes{multiple: true},
+		F_TEID:               groupIeAttributes{multiple: true},
+		UE_IP_Address:        groupIeAttributes{multiple: true},
+	},
+	Update_PDR: {
+		PDR_ID:                       groupIeAttributes{required: true, isID: true},
+		Outer_Header_Removal:         groupIeAttributes{},
+		Precedence:                   groupIeAttributes{},
+		PDI:                          groupIeAttributes{},
+		FAR_ID:                       groupIeAttributes{},
+		URR_ID:                       groupIeAttributes{multiple: true},
+		QER_ID:                       groupIeAttributes{multiple: true},
+		Activate_Predefined_Rules:    groupIeAttributes{multiple: true},
+		Deactivate_Predefined_Rules:  groupIeAttributes{multiple: true},
+		Activation_Time:              groupIeAttributes{},
+		Deactivation_Time:            groupIeAttributes{},
+		IP_Multicast_Addressing_Info: groupIeAttributes{multiple: true},
+		Transport_Delay_Reporting:    groupIeAttributes{},
+		RAT_Type:                     groupIeAttributes{},
+	},
+	Update_FAR: {
+		FAR_ID:                        groupIeAttributes{required: true, isID: true},
+		Apply_Action:                  groupIeAttributes{},
+		Forwarding_Parameters:         groupIeAttributes{},
+		Update_Forwarding_Parameters:  groupIeAttributes{isUpdate: true, baseIe: Forwarding_Parameters},
+		Update_Duplicating_Parameters: groupIeAttributes{isUpdate: true, baseIe: Duplicating_Parameters}, // without an ID, it updates the first Duplicating Parameters
+		Redundant_Transmission_Forwarding_Parameters: groupIeAttributes{},
+		BAR_ID:                        groupIeAttributes{},
+		Add_MBS_Unicast_Parameters:    groupIeAttributes{multiple: true},
+		Remove_MBS_Unicast_Parameters: groupIeAttributes{multiple: true, isDelete: true, baseIe: Add_MBS_Unicast_Parameters},
+	},
+	Update_Forwarding_Parameters: {
+		Destination_Interface:                  groupIeAttributes{},
+		Network_Instance:                       groupIeAttributes{},
+		Redirect_Information:                   groupIeAttributes{},
+		Outer_Header_Creation:                  groupIeAttributes{},
+		Transport_Level_Marking:                groupIeAttributes{},
+		Forwarding_Policy:                      groupIeAttributes{},
+		Header_Enrichment:                      groupIeAttributes{},
+		PfcpsmreqFlags:                         groupIeAttributes{},
+		Traffic_Endpoint_ID:                    groupIeAttributes{},
+		TGPP_Interface_Type:                    groupIeAttributes{},
+		Data_Network_Access_Identifier:         groupIeAttributes{},
+		IP_Address_and_Port_Number_Replacement: groupIeAttributes{},
+	},
+	Update_BAR_SRRsp: {
+		BAR_ID:                              groupIeAttributes{required: true, isID: true},
+		Downlink_Data_Notification_Delay:    groupIeAttributes{},
+		DL_Buffering_Duration:               groupIeAttributes{},
+		DL_Buffering_Suggested_Packet_Count: groupIeAttributes{},
+		Suggested_Buffering_Packets_Count:   groupIeAttributes{},
+	},
+	Update_URR: {
+		URR_ID:                       groupIeAttributes{required: true, isID: true},
+		Measurement_Method:           groupIeAttributes{},
+		Reporting_Triggers:           groupIeAttributes{},
+		Measurement_Period:           groupIeAttributes{},
+		Volume_Threshold:             groupIeAttributes{},
+		Volume_Quota:                 groupIeAttributes{},
+		Event_Threshold:              groupIeAttributes{},
+		Event_Quota:                  groupIeAttributes{},
+		Time_Threshold:               groupIeAttributes{},
+		Time_Quota:                   groupIeAttributes{},
+		Quota_Holding_Time:           groupIeAttributes{},
+		Dropped_DL_Traffic_Threshold: groupIeAttributes{},
+		Quota_Validity_Time:          groupIeAttributes{},
+		Monitoring_Time:              groupIeAttributes{},
+		Subsequent_Volume_Threshold:  groupIeAttributes{},
+		Subsequent_Time_Threshold:    groupIeAttributes{},
+		Subsequent_Volume_Quota:      groupIeAttributes{},
+		Subsequent_Time_Quota:        groupIeAttributes{},
+		Subsequent_Event_Threshold:   groupIeAttributes{},
+		Subsequent_Event_Quota:       groupIeAttributes{},
+		Inactivity_Detection_Time:    groupIeAttributes{},
+		Linked_URR_ID:                groupIeAttributes{multiple: true},
+		Measurement_Information:      groupIeAttributes{},
+		Time_Quota_Mechanism:         groupIeAttributes{},
+		Aggregated_URRs:              groupIeAttributes{multiple: true},
+		FAR_ID:                       groupIeAttributes{}, // FAR ID for Quota Action
+		Ethernet_Inactivity_Timer:    groupIeAttributes{},
+		Additional_Monitoring_Time:   groupIeAttributes{multiple: true},
+		Number_of_Reports:            groupIeAttributes{},
+		Application_ID:               groupIeAttributes{multiple: true}, // Exempted Application ID for Quota Action
+		SDF_Filter:                   groupIeAttributes{multiple: true}, // Exempted SDF Filter for Quota Action
+		User_Plane_Inactivity_Timer:  groupIeAttributes{},
+	},
+	Update_QER: {
+		QER_ID:                  groupIeAttributes{required: true, isID: true},
+		QER_Correlation_ID:      groupIeAttributes{},
+		Gate_Status:             groupIeAttributes{},
+		MBR:                     groupIeAttributes{},
+		GBR:                     groupIeAttributes{},
+		Packet_Rate_Status:      groupIeAttributes{},
+		DL_Flow_Level_Marking:   groupIeAttributes{},
+		QFI:                     groupIeAttributes{},
+		RQI:                     groupIeAttributes{},
+		Paging_Policy_Indicator: groupIeAttributes{},
+		Averaging_Window:        groupIeAttributes{},
+		QER_Control_Indications: groupIeAttributes{},
+		QER_Indications:         groupIeAttributes{},
+	},
+	Remove_PDR: {
+		PDR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Remove_FAR: {
+		FAR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Remove_URR: {
+		URR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Remove_QER: {
+		QER_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Load_Control_Information: {
+		Sequence_Number: groupIeAttributes{required: true},
+		Metric:          groupIeAttributes{required: true},
+	},
+	Overload_Control_Information: {
+		Sequence_Number: groupIeAttributes{required: true},
+		Metric:          groupIeAttributes{required: true},
+		Timer:           groupIeAttributes{required: true},
+		OCI_Flags:       groupIeAttributes{},
+	},
+	Application_IDs_PFDs: {
+		Application_ID: groupIeAttributes{required: true},
+		PFD_context:    groupIeAttributes{multiple: true},
+	},
+	PFD_context: {
+		PFD_contents: groupIeAttributes{required: true, multiple: true},
+	},
+	Application_Detection_Information: {
+		Application_ID:          groupIeAttributes{required: true},
+		Application_Instance_ID: groupIeAttributes{},
+		Flow_Information:        groupIeAttributes{},
+		PDR_ID:                  groupIeAttributes{},
+	},
+	Query_URR: {
+		URR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Usage_Report_SMR: {
+		URR_ID:                         groupIeAttributes{required: true},
+		UR_SEQN:                        groupIeAttributes{required: true},
+		Usage_Report_Trigger:           groupIeAttributes{required: true},
+		Start_Time:                     groupIeAttributes{},
+		End_Time:                       groupIeAttributes{},
+		Volume_Measurement:             groupIeAttributes{},
+		Duration_Measurement:           groupIeAttributes{},
+		Time_of_First_Packet:           groupIeAttributes{},
+		Time_of_Last_Packet:            groupIeAttributes{},
+		Usage_Information:              groupIeAttributes{},
+		Query_URR_Reference:            groupIeAttributes{},
+		Time_Stamp:                     groupIeAttributes{multiple: true},
+		Ethernet_Traffic_Information:   groupIeAttributes{},
+		Join_IP_Multicast_Information:  groupIeAttributes{multiple: true},
+		Leave_IP_Multicast_Information: groupIeAttributes{multiple: true},
+		Predefined_Rules_Name:          groupIeAttributes{multiple: true},
+	},
+	Usage_Report_SDR: {
+		URR_ID:                       groupIeAttributes{required: true},
+		UR_SEQN:                      groupIeAttributes{required: true},
+		Usage_Report_Trigger:         groupIeAttributes{required: true},
+		Start_Time:                   groupIeAttributes{},
+		End_Time:                     groupIeAttributes{},
+		Volume_Measurement:           groupIeAttributes{},
+		Duration_Measurement:         groupIeAttributes{},
+		Time_of_First_Packet:         groupIeAttributes{},
+		Time_of_Last_Packet:          groupIeAttributes{},
+		Usage_Information:            groupIeAttributes{},
+		Time_Stamp:                   groupIeAttributes{multiple: true},
+		Ethernet_Traffic_Information: groupIeAttributes{},
+		Predefined_Rules_Name:        groupIeAttributes{multiple: true},
+	},
+	Usage_Report_SRR: {
+		URR_ID:                            groupIeAttributes{required: true},
+		UR_SEQN:                           groupIeAttributes{required: true},
+		Usage_Report_Trigger:              groupIeAttributes{required: true},
+		Start_Time:                        groupIeAttributes{},
+		End_Time:                          groupIeAttributes{},
+		Volume_Measurement:                groupIeAttributes{},
+		Duration_Measurement:              groupIeAttributes{},
+		Application_Detection_Information: groupIeAttributes{},
+		UE_IP_Address:                     groupIeAttributes{},
+		Network_Instance:                  groupIeAttributes{},
+		Time_of_First_Packet:              groupIeAttributes{},
+		Time_of_Last_Packet:               groupIeAttributes{},
+		Usage_Information:                 groupIeAttributes{},
+		Query_URR_Reference:               groupIeAttributes{},
+		Time_Stamp:                        groupIeAttributes{multiple: true},
+		Ethernet_Traffic_Information:      groupIeAttributes{},
+		Join_IP_Multicast_Information:     groupIeAttributes{multiple: true},
+		Leave_IP_Multicast_Information:    groupIeAttributes{multiple: true},
+		Predefined_Rules_Name:             groupIeAttributes{multiple: true},
+	},
+	Downlink_Data_Report: {
+		PDR_ID:                            groupIeAttributes{required: true, multiple: true},
+		Downlink_Data_Service_Information: groupIeAttributes{multiple: true},
+		DL_Data_Packets_Size:              groupIeAttributes{},
+		Data_Status:                       groupIeAttributes{},
+	},
+	Create_BAR: {
+		BAR_ID:                            groupIeAttributes{required: true, isID: true},
+		Downlink_Data_Notification_Delay:  groupIeAttributes{},
+		Suggested_Buffering_Packets_Count: groupIeAttributes{},
+		MT_EDT_Control_Information:        groupIeAttributes{},
+	},
+	Update_BAR: {
+		BAR_ID:                            groupIeAttributes{required: true, isID: true},
+		Downlink_Data_Notification_Delay:  groupIeAttributes{},
+		Suggested_Buffering_Packets_Count: groupIeAttributes{},
+		MT_EDT_Control_Information:        groupIeAttributes{},
+	},
+	Remove_BAR: {
+		BAR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Error_Indication_Report: {
+		F_TEID: groupIeAttributes{required: true, multiple: true},
+	},
+	User_Plane_Path_Failure_Report: {
+		Remote_GTP_U_Peer: groupIeAttributes{required: true, multiple: true},
+	},
+	Update_Duplicating_Parameters: {
+		Destination_Interface:   groupIeAttributes{},
+		Outer_Header_Creation:   groupIeAttributes{},
+		Transport_Level_Marking: groupIeAttributes{},
+		Forwarding_Policy:       groupIeAttributes{},
+	},
+	Aggregated_URRs: {
+		Aggregated_URR_ID: groupIeAttributes{required: true},
+		Multiplier:        groupIeAttributes{required: true},
+	},
+	Create_Traffic_Endpoint: {
+		Traffic_Endpoint_ID:               groupIeAttributes{required: true, isID: true},
+		F_TEID:                            groupIeAttributes{},
+		Network_Instance:                  groupIeAttributes{},
+		Redundant_Transmission_Parameters: groupIeAttributes{},
+		UE_IP_Address:                     groupIeAttributes{multiple: true},
+		Ethernet_PDU_Session_Information:  groupIeAttributes{},
+		Framed_Route:                      groupIeAttributes{multiple: true},
+		Framed_Routing:                    groupIeAttributes{},
+		Framed_IPv6_Route:                 groupIeAttributes{multiple: true},
+		QFI:                               groupIeAttributes{multiple: true},
+		TGPP_Interface_Type:               groupIeAttributes{},
+		Local_Ingress_Tunnel:              groupIeAttributes{},
+		MBS_Session_Identifier:            groupIeAttributes{},
+		Area_Session_ID:                   groupIeAttributes{},
+		RAT_Type:                          groupIeAttributes{},
+	},
+	Created_Traffic_Endpoint: {
+		Traffic_Endpoint_ID:  groupIeAttributes{required: true, isID: true},
+		F_TEID:               groupIeAttributes{multiple: true},
+		UE_IP_Address:        groupIeAttributes{multiple: true},
+		Local_Ingress_Tunnel: groupIeAttributes{},
+	},
+	Update_Traffic_Endpoint: {
+		Traffic_Endpoint_ID:               groupIeAttributes{required: true, isID: true},
+		F_TEID:                            groupIeAttributes{},
+		Network_Instance:                  groupIeAttributes{},
+		Redundant_Transmission_Parameters: groupIeAttributes{},
+		UE_IP_Address:                     groupIeAttributes{multiple: true},
+		Framed_Route:                      groupIeAttributes{multiple: true},
+		Framed_Routing:                    groupIeAttributes{},
+		Framed_IPv6_Route:                 groupIeAttributes{multiple: true},
+		QFI:                               groupIeAttributes{multiple: true},
+		TGPP_Interface_Type:               groupIeAttributes{},
+		Local_Ingress_Tunnel:              groupIeAttributes{},
+		RAT_Type:                          groupIeAttributes{},
+	},
+	Remove_Traffic_Endpoint: {
+		Traffic_Endpoint_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Ethernet_Packet_Filter: {
+		Ethernet_Filter_ID:         groupIeAttributes{},
+		Ethernet_Filter_Properties: groupIeAttributes{},
+		MAC_address:                groupIeAttributes{multiple: true},
+		Ethertype:                  groupIeAttributes{},
+		C_TAG:                      groupIeAttributes{},
+		S_TAG:                      groupIeAttributes{},
+		SDF_Filter:                 groupIeAttributes{multiple: true},
+	},
+	Ethernet_Traffic_Information: {
+		MAC_Addresses_Detected: groupIeAttributes{multiple: true},
+		MAC_Addresses_Removed:  groupIeAttributes{multiple: true},
+	},
+	Additional_Monitoring_Time: {
+		Monitoring_Time:             groupIeAttributes{required: true},
+		Subsequent_Volume_Threshold: groupIeAttributes{},
+		Subsequent_Time_Threshold:   groupIeAttributes{},
+		Subsequent_Volume_Quota:     groupIeAttributes{},
+		Subsequent_Time_Quota:       groupIeAttributes{},
+		Subsequent_Event_Threshold:  groupIeAttributes{},
+		Subsequent_Event_Quota:      groupIeAttributes{},
+	},
+	Create_MAR: {
+		MAR_ID:                 groupIeAttributes{required: true, isID: true},
+		Steering_Functionality: groupIeAttributes{required: true},
+		Steering_Mode:          groupIeAttributes{required: true},
+		TGPP_Access_Forwarding_Action_Information:     groupIeAttributes{},
+		Non_3GPP_Access_Forwarding_Action_Information: groupIeAttributes{},
+		Thresholds:              groupIeAttributes{},
+		Steering_Mode_Indicator: groupIeAttributes{},
+	},
+	TGPP_Access_Forwarding_Action_Information: {
+		FAR_ID:   groupIeAttributes{required: true},
+		Weight:   groupIeAttributes{},
+		Priority: groupIeAttributes{},
+		URR_ID:   groupIeAttributes{multiple: true},
+		RAT_Type: groupIeAttributes{},
+	},
+	Non_3GPP_Access_Forwarding_Action_Information: {
+		FAR_ID:   groupIeAttributes{required: true},
+		Weight:   groupIeAttributes{},
+		Priority: groupIeAttributes{},
+		URR_ID:   groupIeAttributes{multiple: true},
+		RAT_Type: groupIeAttributes{},
+	},
+	Remove_MAR: {
+		MAR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Update_MAR: {
+		MAR_ID:                 groupIeAttributes{required: true, isID: true},
+		Steering_Functionality: groupIeAttributes{},
+		Steering_Mode:          groupIeAttributes{},
+		Update_TGPP_Access_Forwarding_Action_Information:     groupIeAttributes{isUpdate: true, baseIe: TGPP_Access_Forwarding_Action_Information},
+		Update_Non_3GPP_Access_Forwarding_Action_Information: groupIeAttributes{isUpdate: true, baseIe: Non_3GPP_Access_Forwarding_Action_Information},
+		TGPP_Access_Forwarding_Action_Information:            groupIeAttributes{},
+		Non_3GPP_Access_Forwarding_Action_Information:        groupIeAttributes{},
+		Thresholds:              groupIeAttributes{},
+		Steering_Mode_Indicator: groupIeAttributes{},
+	},
+	Update_TGPP_Access_Forwarding_Action_Information: {
+		FAR_ID:   groupIeAttributes{},
+		Weight:   groupIeAttributes{},
+		Priority: groupIeAttributes{},
+		URR_ID:   groupIeAttributes{multiple: true},
+		RAT_Type: groupIeAttributes{},
+	},
+	Update_Non_3GPP_Access_Forwarding_Action_Information: {
+		FAR_ID:   groupIeAttributes{},
+		Weight:   groupIeAttributes{},
+		Priority: groupIeAttributes{},
+		URR_ID:   groupIeAttributes{multiple: true},
+		RAT_Type: groupIeAttributes{},
+	},
+	PFCP_Session_Retention_Information: {
+		CP_PFCP_Entity_IP_Address: groupIeAttributes{multiple: true},
+	},
+	User_Plane_Path_Recovery_Report: {
+		Remote_GTP_U_Peer: groupIeAttributes{required: true, multiple: true},
+	},
+	IP_Multicast_Addressing_Info: {
+		IP_Multicast_Address: groupIeAttributes{required: true},
+		Source_IP_Address:    groupIeAttributes{multiple: true},
+	},
+	Join_IP_Multicast_Information: {
+		IP_Multicast_Address: groupIeAttributes{required: true},
+		Source_IP_Address:    groupIeAttributes{multiple: true},
+	},
+	Leave_IP_Multicast_Information: {
+		IP_Multicast_Address: groupIeAttributes{required: true},
+		Source_IP_Address:    groupIeAttributes{multiple: true},
+	},
+	Created_Bridge_Info_for_TSC: {
+		DS_TT_Port_Number: groupIeAttributes{},
+		TSN_Bridge_ID:     groupIeAttributes{},
+		NW_TT_Port_Number: groupIeAttributes{},
+	},
+	TSC_Management_Information_SMReq: {
+		Port_Management_Information_Container:   groupIeAttributes{},
+		Bridge_Management_Information_Container: groupIeAttributes{},
+		NW_TT_Port_Number:                       groupIeAttributes{},
+	},
+	TSC_Management_Information_SMRsp: {
+		Port_Management_Information_Container:   groupIeAttributes{},
+		Bridge_Management_Information_Container: groupIeAttributes{},
+		NW_TT_Port_Number:                       groupIeAttributes{},
+	},
+	TSC_Management_Information_SRReq: {
+		Port_Management_Information_Container:   groupIeAttributes{},
+		Bridge_Management_Information_Container: groupIeAttributes{},
+		NW_TT_Port_Number:                       groupIeAttributes{},
+	},
+	Clock_Drift_Control_Information: {
+		Requested_Clock_Drift_Information: groupIeAttributes{required: true},
+		TSN_Time_Domain_Number:            groupIeAttributes{multiple: true},
+		Time_Offset_Threshold:             groupIeAttributes{},
+		Cumulative_rateRatio_Threshold:    groupIeAttributes{},
+	},
+	Clock_Drift_Report: {
+		TSN_Time_Domain_Number:           groupIeAttributes{required: true},
+		Time_Offset_Measurement:          groupIeAttributes{},
+		Cumulative_rateRatio_Measurement: groupIeAttributes{},
+		Time_Stamp:                       groupIeAttributes{},
+		Network_Instance:                 groupIeAttributes{},
+		APN_DNN:                          groupIeAttributes{},
+		S_NSSAI:                          groupIeAttributes{},
+	},
+	Remove_SRR: {
+		SRR_ID: groupIeAttributes{required: true, isID: true},
+	},
+	Create_SRR: {
+		SRR_ID:                                  groupIeAttributes{required: true, isID: true},
+		Access_Availability_Control_Information: groupIeAttributes{},
+		QoS_Monitoring_per_QoS_flow_Control_Information: groupIeAttributes{multiple: true},
+		Direct_Reporting_Information:                    groupIeAttributes{},
+	},
+	Update_SRR: {
+		SRR_ID:                                  groupIeAttributes{required: true, isID: true},
+		Access_Availability_Control_Information: groupIeAttributes{},
+		QoS_Monitoring_per_QoS_flow_Control_Information: groupIeAttributes{multiple: true},
+		Direct_Reporting_Information:                    groupIeAttributes{},
+	},
+	Session_Report: {
+		SRR_ID:                     groupIeAttributes{required: true},
+		Access_Availability_Report: groupIeAttributes{},
+		QoS_Monitoring_Report:      groupIeAttributes{multiple: true},
+	},
+	Access_Availability_Control_Information: {
+		Requested_Access_Availability_Information: groupIeAttributes{required: true},
+	},
+	Access_Availability_Report: {
+		Access_Availability_Information: groupIeAttributes{required: true},
+	},
+	Provide_ATSSS_Control_Information: {
+		MPTCP_Control_Information:    groupIeAttributes{},
+		ATSSS_LL_Control_Information: groupIeAttributes{},
+		PMF_Control_Information:      groupIeAttributes{},
+	},
+	ATSSS_Control_Parameters: {
+		MPTCP_Parameters:    groupIeAttributes{},
+		ATSSS_LL_Parameters: groupIeAttributes{},
+		PMF_Parameters:      groupIeAttributes{},
+	},
+	MPTCP_Parameters: {
+		MPTCP_Address_Information:   groupIeAttributes{required: true},
+		UE_Link_Specific_IP_Address: groupIeAttributes{required: true},
+	},
+	ATSSS_LL_Parameters: {
+		ATSSS_LL_Information: groupIeAttributes{required: true},
+	},
+	PMF_Parameters: {
+		PMF_Address_Information: groupIeAttributes{required: true},
+	},
+	UE_IP_address_Pool_Information: {
+		UE_IP_address_Pool_Identity: groupIeAttributes{required: true, multiple: true},
+		Network_Instance:            groupIeAttributes{},
+		S_NSSAI:                     groupIeAttributes{multiple: true},
+		IP_version:                  groupIeAttributes{},
+	},
+	GTP_U_Path_QoS_Control_Information: {
+		Remote_GTP_U_Peer:         groupIeAttributes{multiple: true},
+		GTP_U_Path_Interface_Type: groupIeAttributes{},
+		QoS_Report_Trigger:        groupIeAttributes{required: true},
+		Transport_Level_Marking:   groupIeAttributes{},
+		Measurement_Period:        groupIeAttributes{},
+		Average_Packet_Delay:      groupIeAttributes{},
+		Minimum_Packet_Delay:      groupIeAttributes{},
+		Maximum_Packet_Delay:      groupIeAttributes{},
+		Minimum_Wait_Time:         groupIeAttributes{},
+	},
+	GTP_U_Path_QoS_Report: {
+		Remote_GTP_U_Peer:                        groupIeAttributes{required: true},
+		GTP_U_Path_Interface_Type:                groupIeAttributes{},
+		QoS_Report_Trigger:                       groupIeAttributes{required: true},
+		Time_Stamp:                               groupIeAttributes{required: true},
+		Start_Time:                               groupIeAttributes{},
+		QoS_Information_In_GTP_U_Path_QoS_Report: groupIeAttributes{required: true, multiple: true},
+	},
+	QoS_Information_In_GTP_U_Path_QoS_Report: {
+		Average_Packet_Delay:    groupIeAttributes{required: true},
+		Minimum_Packet_Delay:    groupIeAttributes{},
+		Maximum_Packet_Delay:    groupIeAttributes{},
+		Transport_Level_Marking: groupIeAttributes{},
+	},
+	QoS_Monitoring_per_QoS_flow_Control_Information: {
+		QFI:                      groupIeAttributes{required: true, multiple: true},
+		Requested_QoS_Monitoring: groupIeAttributes{required: true},
+		Reporting_Frequency:      groupIeAttributes{required: true},
+		Packet_Delay_Thresholds:  groupIeAttributes{},
+		Minimum_Wait_Time:        groupIeAttributes{},
+		Measurement_Period:       groupIeAttributes{},
+	},
+	QoS_Monitoring_Report: {
+		QFI:                        groupIeAttributes{required: true},
+		QoS_Monitoring_Measurement: groupIeAttributes{required: true},
+		Time_Stamp:                 groupIeAttributes{required: true},
+		Start_Time:                 groupIeAttributes{},
+	},
+	Packet_Rate_Status_Report: {
+		QER_ID:             groupIeAttributes{required: true},
+		Packet_Rate_Status: groupIeAttributes{required: true},
+	},
+	Ethernet_Context_Information: {
+		MAC_Addresses_Detected: groupIeAttributes{required: true, multiple: true},
+	},
+	Redundant_Transmission_Parameters: {
+		F_TEID:           groupIeAttributes{required: true},
+		Network_Instance: groupIeAttributes{},
+	},
+	Updated_PDR: {
+		PDR_ID:        groupIeAttributes{required: true},
+		F_TEID:        groupIeAttributes{multiple: true},
+		UE_IP_Address: groupIeAttributes{multiple: true},
+	},
+	Provide_RDS_configuration_information: {
+		RDS_configuration_information: groupIeAttributes{},
+	},
+	Query_Packet_Rate_Status: {
+		QER_ID: groupIeAttributes{required: true},
+	},
+	Packet_Rate_Status_Report_SMRsp: {
+		QER_ID:             groupIeAttributes{required: true},
+		Packet_Rate_Status: groupIeAttributes{},
+	},
+	UE_IP_Address_Usage_Information: {
+		Sequence_Number:             groupIeAttributes{required: true},
+		Metric:                      groupIeAttributes{required: true},
+		Validity_Timer:              groupIeAttributes{required: true},
+		Number_of_UE_IP_Addresses:   groupIeAttributes{required: true},
+		Network_Instance:            groupIeAttributes{required: true},
+		UE_IP_address_Pool_Identity: groupIeAttributes{multiple: true},
+		S_NSSAI:                     groupIeAttributes{},
+	},
+	Redundant_Transmission_Forwarding_Parameters: {
+		Outer_Header_Creation: groupIeAttributes{required: true},
+		Network_Instance:      groupIeAttributes{},
+	},
+	Transport_Delay_Reporting: {
+		Remote_GTP_U_Peer:       groupIeAttributes{required: true},
+		Transport_Level_Marking: groupIeAttributes{},
+	},
+	Partial_Failure_Information_SERsp: {
+		Failed_Rule_ID:           groupIeAttributes{required: true},
+		Cause:                    groupIeAttributes{required: true},
+		Offending_IE_Information: groupIeAttributes{required: true},
+	},
+	Partial_Failure_Information_SMRsp: {
+		Failed_Rule_ID:           groupIeAttributes{required: true},
+		Cause:                    groupIeAttributes{required: true},
+		Offending_IE_Information: groupIeAttributes{required: true},
+	},
+	L2TP_Tunnel_Information: {
+		LNS_Address:       groupIeAttributes{required: true},
+		Tunnel_Password:   groupIeAttributes{},
+		Tunnel_Preference: groupIeAttributes{},
+	},
+	L2TP_Session_Information: {
+		Calling_Number:           groupIeAttributes{},
+		Called_Number:            groupIeAttributes{},
+		Maximum_Receive_Unit:     groupIeAttributes{},
+		L2TP_Session_Indications: groupIeAttributes{},
+		L2TP_User_Authentication: groupIeAttributes{},
+	},
+	Created_L2TP_Session: {
+		DNS_Server_Address:  groupIeAttributes{multiple: true},
+		NBNS_Server_Address: groupIeAttributes{multiple: true},
+		LNS_Address:         groupIeAttributes{},
+	},
+	PFCP_Session_Change_Info: {
+		FQ_CSID:                    groupIeAttributes{multiple: true},
+		Group_Id:                   groupIeAttributes{multiple: true},
+		CP_IP_Address:              groupIeAttributes{multiple: true},
+		Alternative_SMF_IP_Address: groupIeAttributes{required: true},
+	},
+	IP_Address_and_Port_Number_Replacement: {
+		Source_IP_Address: groupIeAttributes{multiple: true},
+	},
+	Direct_Reporting_Information: {
+		Event_Notification_URI:      groupIeAttributes{required: true},
+		Notification_Correlation_ID: groupIeAttributes{},
+		Reporting_Flags:             groupIeAttributes{},
+	},
+	MBS_Session_N4mb_Control_Information: {
+		MBS_Session_Identifier:          groupIeAttributes{required: true},
+		Area_Session_ID:                 groupIeAttributes{},
+		Multicast_Transport_Information: groupIeAttributes{},
+	},
+	MBS_Multicast_Parameters: {
+		Destination_Interface:   groupIeAttributes{required: true},
+		Network_Instance:        groupIeAttributes{},
+		Outer_Header_Creation:   groupIeAttributes{required: true},
+		Transport_Level_Marking: groupIeAttributes{},
+	},
+	Add_MBS_Unicast_Parameters: {
+		Destination_Interface:     groupIeAttributes{required: true},
+		MBS_Unicast_Parameters_ID: groupIeAttributes{required: true, isID: true},
+		Network_Instance:          groupIeAttributes{},
+		Outer_Header_Creation:     groupIeAttributes{required: true},
+		Transport_Level_Marking:   groupIeAttributes{},
+	},
+	MBS_Session_N4mb_Information: {
+		Multicast_Transport_Information: groupIeAttributes{required: true},
+	},
+	Remove_MBS_Unicast_Parameters: {
+		MBS_Unicast_Parameters_ID: groupIeAttributes{required: true, isID: true},
+	},
+	MBS_Session_N4_Control_Information: {
+		MBS_Session_Identifier:          groupIeAttributes{required: true},
+		Area_Session_ID:                 groupIeAttributes{},
+		Multicast_Transport_Information: groupIeAttributes{},
+	},
+	MBS_Session_N4_Information: {
+		MBS_Session_Identifier: groupIeAttributes{required: true},
+		Area_Session_ID:        groupIeAttributes{},
+		F_TEID:                 groupIeAttributes{},
+		MBSN4Resp_Flags:        groupIeAttributes{},
+	},
+	Peer_UP_Restart_Report: {
+		Remote_GTP_U_Peer: groupIeAttributes{required: true},
+	},
+	DSCP_to_PPI_Control_Information: {
+		DSCP_to_PPI_Mapping_Information: groupIeAttributes{required: true, multiple: true},
+		QFI:                             groupIeAttributes{multiple: true},
+	},
+}
+
+var MessageIeAttributeSets = map[MessageTypeCode]groupIeAttributeSet{
+	PFCP_Heartbeat_Request: {
+		Recovery_Time_Stamp: groupIeAttributes{required: true},
+		Source_IP_Address:   groupIeAttributes{},
+	},
+	PFCP_Heartbeat_Response: {
+		Recovery_Time_Stamp: groupIeAttributes{required: true},
+	},
+	PFCP_Session_Establishment_Request: {
+		Node_ID:                               groupIeAttributes{required: true},
+		F_SEID:                                groupIeAttributes{required: true},
+		Create_PDR:                            groupIeAttributes{required: true, multiple: true},
+		Create_FAR:                            groupIeAttributes{required: true, multiple: true},
+		Create_URR:                            groupIeAttributes{multiple: true},
+		Create_QER:                            groupIeAttributes{multiple: true},
+		Create_BAR:                            groupIeAttributes{multiple: true},
+		Create_Traffic_Endpoint:               groupIeAttributes{multiple: true},
+		PDN_Type:                              groupIeAttributes{},
+		FQ_CSID:                               groupIeAttributes{multiple: true}, // SGW-C, MME, PGW-C/SMF, ePDG and TWAN FQ-CSIDs
+		User_Plane_Inactivity_Timer:           groupIeAttributes{},
+		User_ID:                               groupIeAttributes{},
+		Trace_Information:                     groupIeAttributes{},
+		APN_DNN:                               groupIeAttributes{},
+		Create_MAR:                            groupIeAttributes{multiple: true},
+		PfcpsereqFlags:                        groupIeAttributes{},
+		Create_Bridge_Info_for_TSC:            groupIeAttributes{},
+		Create_SRR:                            groupIeAttributes{multiple: true},
+		Provide_ATSSS_Control_Information:     groupIeAttributes{},
+		Recovery_Time_Stamp:                   groupIeAttributes{},
+		S_NSSAI:                               groupIeAttributes{},
+		Provide_RDS_configuration_information: groupIeAttributes{},
+		RAT_Type:                              groupIeAttributes{},
+		L2TP_Tunnel_Information:               groupIeAttributes{},
+		L2TP_Session_Information:              groupIeAttributes{},
+		Group_Id:                              groupIeAttributes{},
+		MBS_Session_N4mb_Control_Information:  groupIeAttributes{},
+		MBS_Session_N4_Control_Information:    groupIeAttributes{multiple: true},
+		DSCP_to_PPI_Control_Information:       groupIeAttributes{multiple: true},
+		SDF_Filter:                            groupIeAttributes{},
+	},
+	PFCP_Session_Establishment_Response: {
+		Node_ID:                           groupIeAttributes{required: true},
+		Cause:                             groupIeAttributes{required: true},
+		Offending_IE:                      groupIeAttributes{},
+		F_SEID:                            groupIeAttributes{}, // required only if cause is success, see messageConditionalRules
+		Created_PDR:                       groupIeAttributes{multiple: true},
+		Load_Control_Information:          groupIeAttributes{},
+		Overload_Control_Information:      groupIeAttributes{},
+		FQ_CSID:                           groupIeAttributes{multiple: true}, // SGW-U and PGW-U/UPF FQ-CSIDs
+		Failed_Rule_ID:                    groupIeAttributes{},
+		Created_Traffic_Endpoint:          groupIeAttributes{multiple: true},
+		Created_Bridge_Info_for_TSC:       groupIeAttributes{},
+		ATSSS_Control_Parameters:          groupIeAttributes{},
+		RDS_configuration_information:     groupIeAttributes{},
+		Partial_Failure_Information_SERsp: groupIeAttributes{multiple: true},
+		Created_L2TP_Session:              groupIeAttributes{},
+		MBS_Session_N4mb_Information:      groupIeAttributes{},
+		MBS_Session_N4_Information:        groupIeAttributes{multiple: true},
+	},
+	PFCP_Session_Modification_Request: {
+		F_SEID:                             groupIeAttributes{},
+		Remove_PDR:                         groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_PDR},
+		Remove_FAR:                         groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_FAR},
+		Remove_URR:                         groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_URR},
+		Remove_QER:                         groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_QER},
+		Remove_BAR:                         groupIeAttributes{isDelete: true, baseIe: Create_BAR},
+		Remove_Traffic_Endpoint:            groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_Traffic_Endpoint},
+		Create_PDR:                         groupIeAttributes{multiple: true},
+		Create_FAR:                         groupIeAttributes{multiple: true},
+		Create_URR:                         groupIeAttributes{multiple: true},
+		Create_QER:                         groupIeAttributes{multiple: true},
+		Create_BAR:                         groupIeAttributes{},
+		Create_Traffic_Endpoint:            groupIeAttributes{multiple: true},
+		Update_PDR:                         groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_PDR},
+		Update_FAR:                         groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_FAR},
+		Update_URR:                         groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_URR},
+		Update_QER:                         groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_QER},
+		Update_BAR:                         groupIeAttributes{isUpdate: true, baseIe: Create_BAR},
+		Update_Traffic_Endpoint:            groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_Traffic_Endpoint},
+		PfcpsmreqFlags:                     groupIeAttributes{transient: true},
+		Query_URR:                          groupIeAttributes{multiple: true, transient: true},
+		FQ_CSID:                            groupIeAttributes{multiple: true}, // PGW-C/SMF, SGW-C, MME, ePDG and TWAN FQ-CSIDs
+		User_Plane_Inactivity_Timer:        groupIeAttributes{},
+		Query_URR_Reference:                groupIeAttributes{transient: true},
+		Trace_Information:                  groupIeAttributes{},
+		Remove_MAR:                         groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_MAR},
+		Update_MAR:                         groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_MAR},
+		Create_MAR:                         groupIeAttributes{multiple: true},
+		Node_ID:                            groupIeAttributes{},
+		TSC_Management_Information_SMReq:   groupIeAttributes{multiple: true, transient: true},
+		Remove_SRR:                         groupIeAttributes{multiple: true, isDelete: true, baseIe: Create_SRR},
+		Create_SRR:                         groupIeAttributes{multiple: true},
+		Update_SRR:                         groupIeAttributes{multiple: true, isUpdate: true, baseIe: Create_SRR},
+		Provide_ATSSS_Control_Information:  groupIeAttributes{},
+		Ethernet_Context_Information:       groupIeAttributes{},
+		Access_Availability_Information:    groupIeAttributes{},
+		Query_Packet_Rate_Status:           groupIeAttributes{multiple: true, transient: true},
+		S_NSSAI:                            groupIeAttributes{},
+		RAT_Type:                           groupIeAttributes{},
+		Group_Id:                           groupIeAttributes{},
+		MBS_Session_N4_Control_Information: groupIeAttributes{multiple: true},
+		DSCP_to_PPI_Control_Information:    groupIeAttributes{multiple: true},
+	},
+	PFCP_Session_Modification_Response: {
+		Cause:                                groupIeAttributes{required: true},
+		Offending_IE:                         groupIeAttributes{},
+		Created_PDR:                          groupIeAttributes{multiple: true},
+		Load_Control_Information:             groupIeAttributes{},
+		Overload_Control_Information:         groupIeAttributes{},
+		Usage_Report_SMR:                     groupIeAttributes{multiple: true},
+		Failed_Rule_ID:                       groupIeAttributes{},
+		Additional_Usage_Reports_Information: groupIeAttributes{},
+		Created_Traffic_Endpoint:             groupIeAttributes{multiple: true},
+		TSC_Management_Information_SMRsp:     groupIeAttributes{multiple: true},
+		ATSSS_Control_Parameters:             groupIeAttributes{},
+		Updated_PDR:                          groupIeAttributes{multiple: true},
+		Packet_Rate_Status_Report_SMRsp:      groupIeAttributes{multiple: true},
+		Partial_Failure_Information_SMRsp:    groupIeAttributes{multiple: true},
+		MBS_Session_N4_Information:           groupIeAttributes{multiple: true},
+	},
+	PFCP_Session_Deletion_Request: {},
+	PFCP_Session_Deletion_Response: {
+		Cause:                                groupIeAttributes{required: true},
+		Offending_IE:                         groupIeAttributes{},
+		Load_Control_Information:             groupIeAttributes{},
+		Overload_Control_Information:         groupIeAttributes{},
+		Usage_Report_SDR:                     groupIeAttributes{multiple: true},
+		Additional_Usage_Reports_Information: groupIeAttributes{},
+		Packet_Rate_Status_Report:            groupIeAttributes{multiple: true},
+		Session_Report:                       groupIeAttributes{multiple: true},
+		PfcpsdrspFlags:                       groupIeAttributes{},
+	},
+	PFCP_Association_Setup_Request: {
+		Node_ID:                            groupIeAttributes{required: true},
+		Recovery_Time_Stamp:                groupIeAttributes{required: true},
+		UP_Function_Features:               groupIeAttributes{},
+		CP_Function_Features:               groupIeAttributes{},
+		User_Plane_IP_Resource_Information: groupIeAttributes{multiple: true}, // an R15 only IE, removed by the R16 and later validation profiles
+		Alternative_SMF_IP_Address:         groupIeAttributes{multiple: true},
+		SMF_Set_ID:                         groupIeAttributes{},
+		PFCP_Session_Retention_Information: groupIeAttributes{},
+		UE_IP_address_Pool_Information:     groupIeAttributes{multiple: true},
+		GTP_U_Path_QoS_Control_Information: groupIeAttributes{multiple: true},
+		Clock_Drift_Control_Information:    groupIeAttributes{multiple: true},
+		NF_Instance_ID:                     groupIeAttributes{},
+		PfcpasreqFlags:                     groupIeAttributes{},
+	},
+	PFCP_Association_Setup_Response: {
+		Cause:                              groupIeAttributes{required: true},
+		Node_ID:                            groupIeAttributes{required: true},
+		Recovery_Time_Stamp:                groupIeAttributes{required: true},
+		CP_Function_Features:               groupIeAttributes{},
+		UP_Function_Features:               groupIeAttributes{},
+		User_Plane_IP_Resource_Information: groupIeAttributes{}, // an R15 only IE, removed by the R16 and later validation profiles
+		Alternative_SMF_IP_Address:         groupIeAttributes{multiple: true},
+		SMF_Set_ID:                         groupIeAttributes{},
+		PfcpasrspFlags:                     groupIeAttributes{},
+		Clock_Drift_Control_Information:    groupIeAttributes{multiple: true},
+		UE_IP_address_Pool_Information:     groupIeAttributes{multiple: true},
+		GTP_U_Path_QoS_Control_Information: groupIeAttributes{multiple: true},
+		NF_Instance_ID:                     groupIeAttributes{},
+	},
+	PFCP_Association_Update_Request: {
+		Node_ID:                            groupIeAttributes{required: true},
+		UP_Function_Features:               groupIeAttributes{},
+		CP_Function_Features:               groupIeAttributes{},
+		PfcparreqFlags:                     groupIeAttributes{},
+		Graceful_Release_Period:            groupIeAttributes{},
+		PfcpaureqFlags:                     groupIeAttributes{},
+		User_Plane_IP_Resource_Information: groupIeAttributes{multiple: true},
+		Alternative_SMF_IP_Address:         groupIeAttributes{multiple: true},
+		SMF_Set_ID:                         groupIeAttributes{},
+		Clock_Drift_Control_Information:    groupIeAttributes{multiple: true},
+		UE_IP_address_Pool_Information:     groupIeAttributes{multiple: true},
+		GTP_U_Path_QoS_Control_Information: groupIeAttributes{multiple: true},
+		UE_IP_Address_Usage_Information:    groupIeAttributes{multiple: true},
+	},
+	PFCP_Association_Update_Response: {
+		Node_ID:                         groupIeAttributes{required: true},
+		Cause:                           groupIeAttributes{required: true},
+		UP_Function_Features:            groupIeAttributes{},
+		CP_Function_Features:            groupIeAttributes{},
+		UE_IP_Address_Usage_Information: groupIeAttributes{multiple: true},
+	},
+	PFCP_Association_Release_Request: {
+		Node_ID: groupIeAttributes{required: true},
+	},
+	PFCP_Association_Release_Response: {
+		Node_ID: groupIeAttributes{required: true},
+		Cause:   groupIeAttributes{required: true},
+	},
+	PFCP_Version_Not_Supported_Response: {},
+	PFCP_PFD_Management_Request: {
+		Application_IDs_PFDs: groupIeAttributes{multiple: true},
+		Node_ID:              groupIeAttributes{},
+	},
+	PFCP_PFD_Management_Response: {
+		Cause:        groupIeAttributes{required: true},
+		Offending_IE: groupIeAttributes{},
+		Node_ID:      groupIeAttributes{},
+	},
+	PFCP_Node_Report_Request: {
+		Node_ID:                          groupIeAttributes{required: true},
+		Node_Report_Type:                 groupIeAttributes{required: true},
+		User_Plane_Path_Failure_Report:   groupIeAttributes{},
+		User_Plane_Path_Recovery_Report:  groupIeAttributes{},
+		Clock_Drift_Report:               groupIeAttributes{multiple: true},
+		GTP_U_Path_QoS_Report:            groupIeAttributes{multiple: true},
+		Peer_UP_Restart_Report:           groupIeAttributes{},
+		Vendor_Specific_Node_Report_Type: groupIeAttributes{},
+	},
+	PFCP_Node_Report_Response: {
+		Node_ID:      groupIeAttributes{required: true},
+		Cause:        groupIeAttributes{required: true},
+		Offending_IE: groupIeAttributes{},
+	},
+	PFCP_Session_Set_Deletion_Request: {
+		Node_ID: groupIeAttributes{required: true},
+		FQ_CSID: groupIeAttributes{multiple: true}, // SGW-C, PGW-C, SGW-U, PGW-U, TWAN, ePDG and MME FQ-CSIDs all share the IE type
+	},
+	PFCP_Session_Set_Deletion_Response: {
+		Node_ID:      groupIeAttributes{required: true},
+		Cause:        groupIeAttributes{required: true},
+		Offending_IE: groupIeAttributes{},
+	},
+	PFCP_Session_Set_Modification_Request: {
+		Alternative_SMF_IP_Address: groupIeAttributes{required: true},
+		FQ_CSID:                    groupIeAttributes{multiple: true},
+		Group_Id:                   groupIeAttributes{multiple: true},
+		CP_IP_Address:              groupIeAttributes{multiple: true},
+	},
+	PFCP_Session_Set_Modification_Response: {
+		Node_ID:      groupIeAttributes{required: true},
+		Cause:        groupIeAttributes{required: true},
+		Offending_IE: groupIeAttributes{},
+	},
+	PFCP_Session_Report_Request: {
+		Report_Type:                          groupIeAttributes{required: true},
+		Downlink_Data_Report:                 groupIeAttributes{},
+		Usage_Report_SRR:                     groupIeAttributes{multiple: true},
+		Error_Indication_Report:              groupIeAttributes{},
+		Load_Control_Information:             groupIeAttributes{},
+		Overload_Control_Information:         groupIeAttributes{},
+		Additional_Usage_Reports_Information: groupIeAttributes{},
+		PfcpsrreqFlags:                       groupIeAttributes{},
+		F_SEID:                               groupIeAttributes{}, // the old CP F-SEID
+		Packet_Rate_Status_Report:            groupIeAttributes{},
+		TSC_Management_Information_SRReq:     groupIeAttributes{multiple: true},
+		Session_Report:                       groupIeAttributes{multiple: true},
+		Cause:                                groupIeAttributes{},
+	},
+	PFCP_Session_Report_Response: {
+		Cause:                      groupIeAttributes{required: true},
+		Offending_IE:               groupIeAttributes{},
+		Update_BAR_SRRsp:           groupIeAttributes{},
+		PfcpsrrspFlags:             groupIeAttributes{},
+		F_SEID:                     groupIeAttributes{}, // the CP F-SEID
+		F_TEID:                     groupIeAttributes{}, // the N4-u F-TEID
+		Alternative_SMF_IP_Address: groupIeAttributes{},
+	},
+}
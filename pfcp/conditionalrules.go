@@ -0,0 +1,145 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import "fmt"
+
+// Conditional presence rules, which cannot be expressed by the required/multiple attributes of an IE set.
+// A rule is applied to the IE list of a message or group IE after the attribute set checks,
+// and returns nil when the list conforms, otherwise a violation with a path relative to the list.
+type conditionalRule func(ies []IeNode) *Violation
+
+func findIe(ies []IeNode, tc IeTypeCode) *IeNode {
+	for i := range ies {
+		if ies[i].IeTypeCode == tc {
+			return &ies[i]
+		}
+	}
+	return nil
+}
+
+// requiredWithCause requires the IE when the Cause IE holds any of the listed values.
+// When the Cause IE is absent or unreadable the rule does not apply, the attribute set reports that case.
+func requiredWithCause(tc IeTypeCode, causes ...uint8) conditionalRule {
+	return func(ies []IeNode) *Violation {
+		if causeIe := findIe(ies, Cause); causeIe == nil || len(causeIe.bytes) != 1 {
+			return nil
+		} else if findIe(ies, tc) != nil {
+			return nil
+		} else {
+			for _, cause := range causes {
+				if causeIe.bytes[0] == cause {
+					return &Violation{Kind: ViolationMissingConditionalIe, TypeCode: tc, Path: IePath{{TypeCode: tc}}, Detail: fmt.Sprintf("required with cause %d", cause)}
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// requiredWithFlag requires the IE when the flag bit is set in the flag IE
+func requiredWithFlag(tc IeTypeCode, flagIe IeTypeCode, bit FlagBit) conditionalRule {
+	return func(ies []IeNode) *Violation {
+		var flags Flags
+		if node := findIe(ies, flagIe); node == nil || flags.Decode(node.bytes) != nil {
+			return nil
+		} else if flags.Has(bit) && findIe(ies, tc) == nil {
+			return &Violation{Kind: ViolationMissingConditionalIe, TypeCode: tc, Path: IePath{{TypeCode: tc}}, Detail: fmt.Sprintf("required with flag %d of %s", bit, flagIe)}
+		} else {
+			return nil
+		}
+	}
+}
+
+// allowedWithFlag forbids the IE unless the flag bit is set in the flag IE
+func allowedWithFlag(tc IeTypeCode, flagIe IeTypeCode, bit FlagBit) conditionalRule {
+	return func(ies []IeNode) *Violation {
+		var flags Flags
+		if node := findIe(ies, tc); node == nil {
+			return nil
+		} else if flagNode := findIe(ies, flagIe); flagNode != nil && flags.Decode(flagNode.bytes) == nil && flags.Has(bit) {
+			return nil
+		} else {
+			return &Violation{Kind: ViolationUnallowedIe, TypeCode: tc, Path: IePath{node.pathElement()}, Detail: fmt.Sprintf("only with flag %d of %s", bit, flagIe)}
+		}
+	}
+}
+
+// mutuallyExclusive forbids both IEs in the same list
+func mutuallyExclusive(a, b IeTypeCode) conditionalRule {
+	return func(ies []IeNode) *Violation {
+		if second := findIe(ies, b); second != nil && findIe(ies, a) != nil {
+			return &Violation{Kind: ViolationExclusiveIes, TypeCode: b, Path: IePath{second.pathElement()}, Detail: fmt.Sprintf("%s and %s", a, b)}
+		} else {
+			return nil
+		}
+	}
+}
+
+// TS29.244 8.2.1, the causes for which Offending IE is sent
+var offendingIeCauses = []uint8{MandatoryIeMissing, ConditionalIeMissing, MandatoryIeIncorrect}
+
+// the SARR flag of the PFCP Association Release Request IE (type 111)
+const associationReleaseSarr FlagBit = 0
+
+var messageConditionalRules = map[MessageTypeCode][]conditionalRule{
+	PFCP_Session_Establishment_Response: {
+		requiredWithCause(F_SEID, CauseAccepted),
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Session_Modification_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Session_Deletion_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Session_Report_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Association_Setup_Request: {
+		mutuallyExclusive(CP_Function_Features, UP_Function_Features),
+	},
+	PFCP_Association_Setup_Response: {
+		mutuallyExclusive(CP_Function_Features, UP_Function_Features),
+	},
+	PFCP_Association_Update_Request: {
+		mutuallyExclusive(CP_Function_Features, UP_Function_Features),
+		// TS29.244 7.4.4.3, the period of a graceful release, an immediate release has none
+		allowedWithFlag(Graceful_Release_Period, PfcparreqFlags, associationReleaseSarr),
+	},
+	PFCP_Association_Update_Response: {
+		mutuallyExclusive(CP_Function_Features, UP_Function_Features),
+	},
+	PFCP_PFD_Management_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Node_Report_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Session_Set_Deletion_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+	PFCP_Session_Set_Modification_Response: {
+		requiredWithCause(Offending_IE, offendingIeCauses...),
+	},
+}
+
+var groupConditionalRules = map[IeTypeCode][]conditionalRule{
+	Create_FAR: {
+		requiredWithFlag(Forwarding_Parameters, Apply_Action, ApplyActionForw),
+	},
+	Create_URR: {
+		requiredWithFlag(Volume_Threshold, Reporting_Triggers, ReportingTriggerVolth),
+		requiredWithFlag(Measurement_Period, Reporting_Triggers, ReportingTriggerPerio),
+	},
+}
+
+func applyConditionalRules(ies []IeNode, rules []conditionalRule) (violations []Violation) {
+	for _, rule := range rules {
+		if violation := rule(ies); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	return
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestConditionalRules(t *testing.T) {
+	nodeId := IE_NodeIdFqdn("upf")
+	fseid := IE_FSeid(1, netip.MustParseAddr("192.0.2.1"))
+	sarr := IE_Typed(&T_PfcparreqFlags{NewFlags(associationReleaseSarr)})
+	for name, testCase := range map[string]struct {
+		msg     *PfcpMessage
+		failure string // empty when the message is valid
+	}{
+		"accepted with F-SEID": {NewSessionMessage(PFCP_Session_Establishment_Response, 1, nodeId, IE_Cause(CauseAccepted), fseid), ""},
+		"accepted, no F-SEID":  {NewSessionMessage(PFCP_Session_Establishment_Response, 1, nodeId, IE_Cause(CauseAccepted)), "F_SEID"},
+		"rejected, no F-SEID":  {NewSessionMessage(PFCP_Session_Establishment_Response, 1, nodeId, IE_Cause(CauseUnspecified)), ""},
+		"offending IE present": {NewNodeMessage(PFCP_Node_Report_Response, nodeId, IE_Cause(MandatoryIeMissing), IE_Typed(&T_Offending_IE{uint16(Node_ID)})), ""},
+		"offending IE missing": {NewNodeMessage(PFCP_Node_Report_Response, nodeId, IE_Cause(MandatoryIeMissing)), "Offending_IE"},
+		"SARR with period":     {NewNodeMessage(PFCP_Association_Update_Request, nodeId, sarr, IE_Typed(&T_Graceful_Release_Period{5})), ""},
+		"SARR without period":  {NewNodeMessage(PFCP_Association_Update_Request, nodeId, sarr), ""},
+		"period without SARR":  {NewNodeMessage(PFCP_Association_Update_Request, nodeId, IE_Typed(&T_Graceful_Release_Period{5})), "Graceful_Release_Period"},
+		"CP and UP features":   {NewNodeMessage(PFCP_Association_Setup_Request, nodeId, IE_RecoveryTimeStamp(1), *NewIeNode(CP_Function_Features, []byte{0}), *NewIeNode(UP_Function_Features, []byte{0, 0})), "mutually exclusive"},
+		"forwarding FAR":       {NewSessionMessage(PFCP_Session_Modification_Request, 1, IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumForw))), "Create_FAR[id=1]/Forwarding_Parameters"},
+		"buffering FAR":        {NewSessionMessage(PFCP_Session_Modification_Request, 1, IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumBuff))), ""},
+		"reference SER":        {ser2, ""},
+		"set 1 SER":            {SessionEstablishmentRequest, ""},
+		"set 1 SER response":   {SessionEstablishmentResponse, ""},
+		"set 1 SMR":            {SessionModificationRequest, ""},
+	} {
+		err := testCase.msg.Validate()
+		if testCase.failure == "" && err != nil {
+			t.Errorf("%s: unexpected failure %s", name, err.Error())
+		} else if testCase.failure != "" && err == nil {
+			t.Errorf("%s: not rejected", name)
+		} else if err != nil && !strings.Contains(err.Error(), testCase.failure) {
+			t.Errorf("%s: wrong failure %s", name, err.Error())
+		}
+	}
+}
@@ -11,24 +11,7 @@ func (node *IeNode) ReadCauseCode() (uint8, error) {
 	return root.GetByTc(Cause).DeserialiseU8()
 }
 
-func (thisIe IeNode) DeserialiseOuterHeader() OuterHeaderCreate {
-	return getOuterHeaderCreate(thisIe.bytes)
-}
-
-// temporary kludge,UE IP address is a more complex thing than this
-func (thisIe IeNode) DeserialiseUeIPAddress() IpV4 {
-	return getUeIpAddress(thisIe.bytes)
-}
-
-func (thisIe IeNode) DeserialiseFTeid() FTeid {
-	if fteid, err := getFteid(thisIe.bytes); err == nil {
-		return *fteid
-	} else {
-		panic("invalid format in FTEID")
-	}
-}
-
-// the below functions are mostly systematically derived from the unsafe versions above or elsewhere
+// the below functions are mostly systematically derived from the unsafe versions elsewhere
 
 func (get Get) ParseID() (IeID, error) {
 	if len(get.err) != 0 {
@@ -56,22 +39,54 @@ func (get Get) DeserialiseFTeid() (*FTeid, error) {
 	}
 }
 
-func (get Get) DeserialiseUeIPAddress() (IpV4, error) {
+func (get Get) DeserialiseUeIPAddress() (UeIpAddress, error) {
 	if len(get.err) == 0 {
-		return get.IeNode.DeserialiseUeIPAddress(), nil
+		if ueIpAddress, err := getUeIpAddress(get.IeNode.bytes); err != nil {
+			return UeIpAddress{}, err
+		} else {
+			return *ueIpAddress, nil
+		}
 	} else {
-		return *new(IpV4), (get.Error())
+		return UeIpAddress{}, (get.Error())
 	}
 }
 
 func (get Get) DeserialiseOuterHeader() (OuterHeaderCreate, error) {
 	if len(get.err) == 0 {
-		return get.IeNode.DeserialiseOuterHeader(), nil
+		if outerHeaderCreate, err := getOuterHeaderCreate(get.IeNode.bytes); err != nil {
+			return OuterHeaderCreate{}, err
+		} else {
+			return *outerHeaderCreate, nil
+		}
 	} else {
 		return *new(OuterHeaderCreate), (get.Error())
 	}
 }
 
+func (get Get) DeserialiseUpIpResInfo() (*UpIpResInfo, error) {
+	if len(get.err) == 0 {
+		return getUpIpResInfo(get.IeNode.bytes)
+	} else {
+		return new(UpIpResInfo), (get.Error())
+	}
+}
+
+func (get Get) DeserialiseSourceIpAddress() (*SourceIpAddress, error) {
+	if len(get.err) == 0 {
+		return getSourceIpAddress(get.IeNode.bytes)
+	} else {
+		return new(SourceIpAddress), (get.Error())
+	}
+}
+
+func (get Get) DeserialiseNodeId() (*NodeId, error) {
+	if len(get.err) == 0 {
+		return getNodeId(get.IeNode.bytes)
+	} else {
+		return new(NodeId), (get.Error())
+	}
+}
+
 func (get Get) DeserialiseNodeIdString() (string, error) {
 	if len(get.err) == 0 {
 		return showNodeIdString(get.IeNode.bytes)
@@ -81,18 +96,9 @@ func (get Get) DeserialiseNodeIdString() (string, error) {
 }
 
 func showNodeIdString(bytes []byte) (string, error) {
-	if len(bytes) == 0 {
-		return "", fmt.Errorf("empty Ie")
+	if nodeId, err := getNodeId(bytes); err != nil {
+		return "", err
 	} else {
-		switch bytes[0] {
-		case 0:
-			return ReadIpV4(bytes[1:5]).String(), nil
-		case 1:
-			return showBytes(bytes[1:]), nil
-		case 2:
-			return showFQNString(bytes[1:]), nil
-		default:
-			return "", fmt.Errorf("invalid Node-id format ID")
-		}
+		return nodeId.String(), nil
 	}
 }
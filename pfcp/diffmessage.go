@@ -0,0 +1,206 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Diff is the inverse of Merge: it returns the Session Modification Request which takes the session state old to new,
+// i.e. old.Merge(Diff(old, new)) has the IEs of new, though not necessarily in the same order.
+// old and new are session states, i.e. Session Establishment Requests, which have been validated so that their IE IDs are set.
+//
+// A changed IE with an ID is sent as its Update form with only the changed members,
+// or, where the Update form cannot express the change, as Remove and Create.
+// An error is returned for changes which a Session Modification Request cannot express, e.g. of the Node ID.
+// The SEID of the request is that of old, the caller sets the SEID of the peer.
+func Diff(old, new *PfcpMessage) (*PfcpMessage, error) {
+	var seid SEID
+	if old.MessageTypeCode != new.MessageTypeCode {
+		return nil, fmt.Errorf("Diff of %s and %s", old.MessageTypeCode, new.MessageTypeCode)
+	} else if old.SEID != nil {
+		seid = *old.SEID
+	}
+	if ies, err := diffIes(old.iEnodes, new.iEnodes, MessageIeAttributeSets[PFCP_Session_Modification_Request]); err != nil {
+		return nil, fmt.Errorf("Diff failed %s", err.Error())
+	} else {
+		return NewSessionMessage(PFCP_Session_Modification_Request, seid, ies...), nil
+	}
+}
+
+// diffIes returns the update IEs which MergeIes applies to old to give new
+func diffIes(old, new []IeNode, attributeSet groupIeAttributeSet) (ies []IeNode, err error) {
+	matched := make([]bool, len(old))
+	lists := map[vendorIeKey]struct{}{}
+
+	for i := range new {
+		node := &new[i]
+		attributes, found := attributeSet[node.IeTypeCode]
+		if found && attributes.multiple && !node.isGroup() || !found && node.IeTypeCode.IsVendor() {
+			// a list is sent as a whole, if any member changed, vendor IEs are lists for MergeIes too
+			if key := (vendorIeKey{node.enterpriseId, node.IeTypeCode}); !hasKey(lists, key) {
+				lists[key] = struct{}{}
+				if list, err := diffList(old, new, key, matched); err != nil {
+					return nil, err
+				} else {
+					ies = append(ies, list...)
+				}
+			}
+			continue
+		}
+
+		j := findDiffTarget(old, node)
+		if j >= 0 {
+			matched[j] = true
+		}
+		switch {
+		case !found && !node.IeTypeCode.IsVendor():
+			if j < 0 || !equalIe(&old[j], node) {
+				return nil, fmt.Errorf("%s cannot be modified", node.IeTypeCode)
+			}
+		case j < 0:
+			ies = append(ies, *node)
+		case equalIe(&old[j], node):
+		default:
+			if update, err := diffIe(&old[j], node, attributeSet); err != nil {
+				return nil, err
+			} else {
+				ies = append(ies, update...)
+			}
+		}
+	}
+
+	for j := range old {
+		if matched[j] {
+		} else if hasKey(lists, vendorIeKey{old[j].enterpriseId, old[j].IeTypeCode}) {
+		} else if attributes, found := attributeSet[old[j].IeTypeCode]; found && attributes.multiple && !old[j].isGroup() {
+			return nil, fmt.Errorf("the list of %s cannot be removed", old[j].IeTypeCode)
+		} else if old[j].IeTypeCode.IsVendor() {
+			// an empty IE deletes its target
+			ies = append(ies, *NewVendorIeNode(old[j].enterpriseId, old[j].IeTypeCode&^vendorTypeCodeFlag, []byte{}))
+		} else if remove, err := removeIe(&old[j], attributeSet); err != nil {
+			return nil, err
+		} else {
+			ies = append(ies, remove)
+		}
+	}
+	return ies, nil
+}
+
+// findDiffTarget is the index of the IE in old which MergeIes would match with node, or -1
+func findDiffTarget(old []IeNode, node *IeNode) int {
+	for j := range old {
+		if old[j].IeTypeCode != node.IeTypeCode || old[j].enterpriseId != node.enterpriseId {
+		} else if node.IeID == nil && old[j].IeID == nil {
+			return j
+		} else if node.IeID != nil && old[j].IeID != nil && *node.IeID == *old[j].IeID {
+			return j
+		}
+	}
+	return -1
+}
+
+func equalIe(a, b *IeNode) bool {
+	return bytes.Equal(a.appendSerialised(nil), b.appendSerialised(nil))
+}
+
+func hasKey(set map[vendorIeKey]struct{}, key vendorIeKey) bool {
+	_, found := set[key]
+	return found
+}
+
+// diffList returns the new list of the type code and enterprise of key, if it differs from the old list
+func diffList(old, new []IeNode, key vendorIeKey, matched []bool) ([]IeNode, error) {
+	var oldList, newList []IeNode
+	for j := range old {
+		if (vendorIeKey{old[j].enterpriseId, old[j].IeTypeCode}) == key {
+			oldList = append(oldList, old[j])
+			matched[j] = true
+		}
+	}
+	for i := range new {
+		if (vendorIeKey{new[i].enterpriseId, new[i].IeTypeCode}) == key {
+			newList = append(newList, new[i])
+		}
+	}
+	if bytes.Equal(appendSerialised(nil, oldList), appendSerialised(nil, newList)) {
+		return nil, nil
+	} else {
+		return newList, nil
+	}
+}
+
+// diffIe returns the update IEs for a changed IE: the Update form with the changed members, or Remove and Create,
+// or, for an IE which has neither, the IE itself, which replaces the old IE
+func diffIe(old, new *IeNode, attributeSet groupIeAttributeSet) ([]IeNode, error) {
+	updateTc, hasUpdate := attributeSet.updateFor(new.IeTypeCode)
+	if hasUpdate && old.isGroup() && new.isGroup() {
+		updateNode := NewGroupNode(updateTc)
+		updateSet, _ := updateNode.attributeSet()
+		if members, err := diffIes(old.ies, new.ies, updateSet); err == nil {
+			// the ID IE is required, also when unchanged
+			if idIe := new.idIe(); idIe != nil && findDiffTarget(members, idIe) < 0 {
+				members = append([]IeNode{*idIe}, members...)
+			}
+			updateNode.ies = members
+			updateNode.IeID = new.IeID
+			return []IeNode{*updateNode}, nil
+		}
+	}
+	if _, hasDelete := attributeSet.deleteFor(new.IeTypeCode); hasDelete && new.IeID != nil {
+		if remove, err := removeIe(old, attributeSet); err != nil {
+			return nil, err
+		} else {
+			return []IeNode{remove, *new}, nil
+		}
+	} else if attributes, found := attributeSet[new.IeTypeCode]; (found && !attributes.multiple) || new.IeTypeCode.IsVendor() {
+		return []IeNode{*new}, nil
+	} else {
+		return nil, fmt.Errorf("the change of %s cannot be expressed", new.IeTypeCode)
+	}
+}
+
+// removeIe returns the Remove form of an IE with an ID, which carries only the ID IE
+func removeIe(old *IeNode, attributeSet groupIeAttributeSet) (IeNode, error) {
+	if deleteTc, found := attributeSet.deleteFor(old.IeTypeCode); !found {
+		return IeNode{}, fmt.Errorf("%s cannot be removed", old.IeTypeCode)
+	} else if idIe := old.idIe(); idIe == nil {
+		return IeNode{}, fmt.Errorf("%s without an ID cannot be removed", old.IeTypeCode)
+	} else {
+		remove := NewGroupNode(deleteTc, *idIe)
+		remove.IeID = old.IeID
+		return *remove, nil
+	}
+}
+
+// idIe is the member IE which holds the ID of a group IE, or nil
+func (node *IeNode) idIe() *IeNode {
+	if attributeSet, isGroup := node.attributeSet(); isGroup {
+		for i := range node.ies {
+			if attributes, found := attributeSet[node.ies[i].IeTypeCode]; found && attributes.isID {
+				return &node.ies[i]
+			}
+		}
+	}
+	return nil
+}
+
+func (set groupIeAttributeSet) updateFor(base IeTypeCode) (IeTypeCode, bool) {
+	for tc, attributes := range set {
+		if attributes.isUpdate && attributes.baseIe == base {
+			return tc, true
+		}
+	}
+	return 0, false
+}
+
+func (set groupIeAttributeSet) deleteFor(base IeTypeCode) (IeTypeCode, bool) {
+	for tc, attributes := range set {
+		if attributes.isDelete && attributes.baseIe == base {
+			return tc, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"testing"
+)
+
+func diffTestSession(t *testing.T, ies ...IeNode) *PfcpMessage {
+	msg := NewSessionMessage(PFCP_Session_Establishment_Request, 1, append([]IeNode{IE_NodeIdFqdn("smf"), IE_FSeid(1, testV4)}, ies...)...)
+	if err := msg.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// checkDiff checks that the diff is a valid Session Modification Request which merged into old gives new
+func checkDiff(t *testing.T, old, new *PfcpMessage) *PfcpMessage {
+	t.Helper()
+	diff, err := Diff(old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update, err := ParseValidate(diff.Serialise()); err != nil {
+		t.Fatalf("%s\n%s", err.Error(), diff.Dumper())
+	} else if merged, err := old.Merge(update); err != nil {
+		t.Fatalf("%s\n%s", err.Error(), diff.Dumper())
+	} else if !Equal(merged.Node(), new.Node()) {
+		t.Errorf("merged diff differs\n%s\n%s", diff.Dumper(), merged.Dumper())
+	}
+	return diff
+}
+
+func TestDiffSessionModification(t *testing.T) {
+	ser, smr := mergeTestMessages(t)
+	new, err := ser.Merge(smr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff := checkDiff(t, ser, new)
+
+	// only the changes are sent
+	root := diff.Node().Getter()
+	if _, err := root.GetById(Update_PDR, 1).GetByTc(Precedence).Return(); err != nil {
+		t.Errorf("PDR update missing\n%s", diff.Dumper())
+	} else if _, err := root.GetById(Update_PDR, 1).GetByTc(FAR_ID).Return(); err == nil {
+		t.Errorf("unchanged FAR ID sent\n%s", diff.Dumper())
+	}
+	if _, err := root.GetById(Create_FAR, 1).Return(); err == nil {
+		t.Errorf("unchanged FAR sent\n%s", diff.Dumper())
+	}
+	if _, err := root.GetById(Remove_QER, 2).Return(); err != nil {
+		t.Errorf("QER removal missing\n%s", diff.Dumper())
+	}
+
+	if empty, err := Diff(ser, ser); err != nil {
+		t.Error(err)
+	} else if len(empty.iEnodes) != 0 {
+		t.Errorf("diff of unchanged session\n%s", empty.Dumper())
+	}
+}
+
+func TestDiffRemoveCreate(t *testing.T) {
+	// Update FAR cannot remove the Forwarding Parameters, so the FAR is removed and created again
+	old := diffTestSession(t,
+		IE_CreatePdr(IE_PdrId(1), IE_Precedence(10), IE_Pdi(IE_SourceInterface(EnumAccess)), IE_FarId(1)),
+		IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumForw), IE_ForwardingParameters(IE_DestinationInterface(EnumCore))),
+	)
+	new := diffTestSession(t,
+		IE_CreatePdr(IE_PdrId(1), IE_Precedence(10), IE_Pdi(IE_SourceInterface(EnumAccess)), IE_FarId(1)),
+		IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumDrop)),
+	)
+	diff := checkDiff(t, old, new)
+	if _, err := diff.Node().Getter().GetById(Remove_FAR, 1).Return(); err != nil {
+		t.Errorf("FAR not removed\n%s", diff.Dumper())
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	old, _ := mergeTestMessages(t)
+	new, _ := ParseValidate(old.Serialise())
+	new.iEnodes = append(new.iEnodes, *NewIeNode(PDN_Type, []byte{1}))
+	if _, err := Diff(old, new); err == nil {
+		t.Errorf("change of PDN type accepted")
+	}
+	if _, err := Diff(old, NewSessionMessage(PFCP_Session_Deletion_Request, 1)); err == nil {
+		t.Errorf("diff of different message types accepted")
+	}
+}
@@ -16,6 +16,10 @@ func IE_Cause(code uint8) IeNode {
 	return *NewIeNode(Cause, Encode_Uint8(code))
 }
 
+func IE_OffendingIe(tc IeTypeCode) IeNode {
+	return *NewIeNode(Offending_IE, Encode_Uint16(uint16(tc)))
+}
+
 func IE_RecoveryTimeStamp(u32 uint32) IeNode {
 	return *NewIeNode(Recovery_Time_Stamp, Encode_Uint32(u32))
 }
@@ -24,15 +28,14 @@ func IE_NodeIdFqdn(sx ...string) IeNode {
 	return *NewIeNode(Node_ID, Encode_NodeIdFqdn(sx...))
 }
 
-func IE_NodeIdIpV4(ip netip.Addr) IeNode {
-	return *NewIeNode(Node_ID, Encode_NodeIdIpV4(ip))
+func IE_NodeIdIp(ip netip.Addr) IeNode {
+	return *NewIeNode(Node_ID, Encode_NodeIdIp(ip))
 }
 
 // TS29.244: either or both ipv4 and ipv6 are required
 // in addition to the 64 bit SEID.
-// TDOD - for now only implement ipv4
-func IE_FSeid(seid uint64, ip netip.Addr) IeNode {
-	return *NewIeNode(F_SEID, Encode_FSeid(seid, ip))
+func IE_FSeid(seid uint64, addrs ...netip.Addr) IeNode {
+	return *NewIeNode(F_SEID, Encode_FSeid(seid, addrs...))
 }
 
 func IE_PdrId(u16 uint16) IeNode {
@@ -47,6 +50,14 @@ func IE_QerId(u32 uint32) IeNode {
 	return *NewIeNode(QER_ID, Encode_Uint32(u32))
 }
 
+func IE_UrrId(u32 uint32) IeNode {
+	return *NewIeNode(URR_ID, Encode_Uint32(u32))
+}
+
+func IE_BarId(u8 uint8) IeNode {
+	return *NewIeNode(BAR_ID, Encode_Uint8(u8))
+}
+
 func IE_Precedence(u32 uint32) IeNode {
 	return *NewIeNode(Precedence, Encode_Uint32(u32))
 }
@@ -59,8 +70,16 @@ func IE_FTeid_Choose_IpV4() IeNode {
 	return *NewIeNode(F_TEID, Encode_FTeid_Choose_IpV4())
 }
 
-func IE_FTeid_IpV4(teid TEID, ip netip.Addr) IeNode {
-	return *NewIeNode(F_TEID, Encode_FTeid_IpV4(teid, ip))
+func IE_FTeid_Choose_IpV6() IeNode {
+	return *NewIeNode(F_TEID, Encode_FTeid_Choose_IpV6())
+}
+
+func IE_FTeid_Ip(teid TEID, addrs ...netip.Addr) IeNode {
+	return *NewIeNode(F_TEID, Encode_FTeid_Ip(teid, addrs...))
+}
+
+func IE_FTeid(fteid FTeid) IeNode {
+	return *NewIeNode(F_TEID, Encode_FTeid(fteid))
 }
 
 func IE_NetworkInstance(s string) IeNode {
@@ -75,12 +94,17 @@ func IE_GateStatus(gs GateStatus) IeNode {
 	return *NewIeNode(Gate_Status, Encode_GateStatus(gs))
 }
 
-func IE_UeIpAddress(ip netip.Addr) IeNode {
-	return *NewIeNode(UE_IP_Address, Encode_UeIpAddress_IpV4_Dst(ip))
+// IE_UeIpAddress encodes a destination UE address, IPv4, IPv6 or dual-stack
+func IE_UeIpAddress(addrs ...netip.Addr) IeNode {
+	return *NewIeNode(UE_IP_Address, Encode_UeIpAddress(NewUeIpAddress(true, addrs...)))
 }
 
-func IE_UpIpRsrcInfo(ip netip.Addr) IeNode {
-	return *NewIeNode(User_Plane_IP_Resource_Information, Encode_UserPlaneIpResourceInformation(ip))
+func IE_UpIpRsrcInfo(addrs ...netip.Addr) IeNode {
+	return *NewIeNode(User_Plane_IP_Resource_Information, Encode_UserPlaneIpResourceInformation(addrs...))
+}
+
+func IE_SourceIpAddress(addrs ...netip.Addr) IeNode {
+	return *NewIeNode(Source_IP_Address, Encode_SourceIpAddress(NewSourceIpAddress(addrs...)))
 }
 
 func IE_ApplyAction(action EnumAction) IeNode {
@@ -91,8 +115,8 @@ func IE_DestinationInterface(t EnumInterface) IeNode {
 	return *NewIeNode(Destination_Interface, Encode_InterfaceType(t))
 }
 
-func IE_OuterHeaderCreation(teid TEID, ip netip.Addr) IeNode {
-	return *NewIeNode(Outer_Header_Creation, Encode_OuterHeaderCreation(teid, ip))
+func IE_OuterHeaderCreation(teid TEID, addrs ...netip.Addr) IeNode {
+	return *NewIeNode(Outer_Header_Creation, Encode_OuterHeaderCreation(teid, addrs...))
 }
 
 func IE_OuterHeaderRemoval() IeNode {
@@ -141,6 +165,38 @@ func IE_CreateQer(nodes ...IeNode) IeNode {
 	return *NewGroupNode(Create_QER, nodes...)
 }
 
+func IE_UpdateQer(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Update_QER, nodes...)
+}
+
+func IE_RemoveQer(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Remove_QER, nodes...)
+}
+
+func IE_CreateUrr(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Create_URR, nodes...)
+}
+
+func IE_UpdateUrr(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Update_URR, nodes...)
+}
+
+func IE_RemoveUrr(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Remove_URR, nodes...)
+}
+
+func IE_CreateBar(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Create_BAR, nodes...)
+}
+
+func IE_UpdateBar(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Update_BAR, nodes...)
+}
+
+func IE_RemoveBar(nodes ...IeNode) IeNode {
+	return *NewGroupNode(Remove_BAR, nodes...)
+}
+
 func IE_Pdi(nodes ...IeNode) IeNode {
 	return *NewGroupNode(PDI, nodes...)
 }
@@ -129,7 +129,7 @@ var (
 			IE_Precedence(0x40),
 			IE_Pdi(
 				IE_SourceInterface(EnumAccess),
-				IE_FTeid_IpV4(1234, netip.MustParseAddr("162.118.51.1")),
+				IE_FTeid_Ip(1234, netip.MustParseAddr("162.118.51.1")),
 
 				IE_NetworkInstance("sgi"),
 				IE_Qfi(5),
@@ -37,8 +37,20 @@ func Encode_Uint64(u64 uint64) []byte {
 
 func Encode_IpV4(ip netip.Addr) []byte {
 	bytes := make([]byte, 4)
-	if ip.Is4() {
-		addr := ip.As4()
+	if ip.Unmap().Is4() {
+		addr := ip.Unmap().As4()
+		copy(bytes[:], addr[:])
+	} else {
+		debug.PrintStack()
+		log.Warn("invalid IP address")
+	}
+	return bytes
+}
+
+func Encode_IpV6(ip netip.Addr) []byte {
+	bytes := make([]byte, 16)
+	if ip.Is6() && !ip.Is4In6() {
+		addr := ip.As16()
 		copy(bytes[:], addr[:])
 	} else {
 		debug.PrintStack()
@@ -58,12 +70,25 @@ func Encode_Qfi(qfi uint8) []byte {
 	return Encode_Uint8(qfi)
 }
 
-// // TS29.244 8.2.37
-func Encode_FSeid(seid uint64, ip netip.Addr) []byte {
-	bytes := make([]byte, 1+8, 1+8+4)
-	bytes[0] = 0b00000010 // hardcodes v4
+// TS29.244 8.2.37
+const (
+	fseid_flag_V6 uint8 = 0b00000001
+	fseid_flag_V4 uint8 = 0b00000010
+)
+
+// Encode_FSeid accepts an IPv4 address, an IPv6 address, or one of each (dual-stack)
+func Encode_FSeid(seid uint64, addrs ...netip.Addr) []byte {
+	v4, v6 := splitAddrs(addrs...)
+	bytes := make([]byte, 1+8, 1+8+4+16)
 	binary.BigEndian.PutUint64(bytes[1:9], seid)
-	bytes = append(bytes, Encode_IpV4(ip)...)
+	if v4.IsValid() {
+		bytes[0] |= fseid_flag_V4
+		bytes = append(bytes, Encode_IpV4(v4)...)
+	}
+	if v6.IsValid() {
+		bytes[0] |= fseid_flag_V6
+		bytes = append(bytes, Encode_IpV6(v6)...)
+	}
 	return bytes
 }
 
@@ -89,13 +114,25 @@ func Encode_FQDN(sx ...string) (bytes []byte) {
 }
 
 // TS29.244 8.2.56
-const outer_header_flag_GTPU_UDP_IPv4 = 0b00000001
+const (
+	outer_header_flag_GTPU_UDP_IPv4 = 0b00000001
+	outer_header_flag_GTPU_UDP_IPv6 = 0b00000010
+)
 
-func Encode_OuterHeaderCreation(teid TEID, ip netip.Addr) (bytes []byte) {
-	bytes = make([]byte, 2+4, 2+4+4)
-	bytes[0] = outer_header_flag_GTPU_UDP_IPv4
+// Encode_OuterHeaderCreation only encodes the GTP-U forms.
+// When both an IPv4 and an IPv6 address are given both description bits are set, as allowed for dual-stack peers.
+func Encode_OuterHeaderCreation(teid TEID, addrs ...netip.Addr) (bytes []byte) {
+	v4, v6 := splitAddrs(addrs...)
+	bytes = make([]byte, 2+4, 2+4+4+16)
 	binary.BigEndian.PutUint32(bytes[2:], uint32(teid))
-	bytes = append(bytes, Encode_IpV4(ip)...)
+	if v4.IsValid() {
+		bytes[0] |= outer_header_flag_GTPU_UDP_IPv4
+		bytes = append(bytes, Encode_IpV4(v4)...)
+	}
+	if v6.IsValid() {
+		bytes[0] |= outer_header_flag_GTPU_UDP_IPv6
+		bytes = append(bytes, Encode_IpV6(v6)...)
+	}
 	return
 }
 
@@ -106,37 +143,132 @@ func Encode_OuterHeaderRemoval() (bytes []byte) {
 }
 
 // TS29.244 8.2.38
+const (
+	node_id_type_IPv4 uint8 = 0
+	node_id_type_IPv6 uint8 = 1
+	node_id_type_FQDN uint8 = 2
+)
+
 func Encode_NodeIdFqdn(sx ...string) (bytes []byte) {
-	bytes = append(bytes, 2) // hardcodes FQDN
+	bytes = append(bytes, node_id_type_FQDN)
 	bytes = append(bytes, Encode_FQDN(sx...)...)
 	return
 }
 
-func Encode_NodeIdIpV4(ip netip.Addr) (bytes []byte) {
-	bytes = append(bytes, 0) // hardcodes v4
-	bytes = append(bytes, Encode_IpV4(ip)...)
+func Encode_NodeIdIp(ip netip.Addr) (bytes []byte) {
+	if ip.Unmap().Is4() {
+		bytes = append(bytes, node_id_type_IPv4)
+		bytes = append(bytes, Encode_IpV4(ip)...)
+	} else {
+		bytes = append(bytes, node_id_type_IPv6)
+		bytes = append(bytes, Encode_IpV6(ip)...)
+	}
 	return
 }
 
 // TS29.244 8.2.62
 const (
-	ueip_flag_SD uint8 = 0b00000100 // set indicates destination
-	ueip_flag_V4 uint8 = 0b00000010
-	ueip_flag_V6 uint8 = 0b00000001
+	ueip_flag_V6    uint8 = 0b00000001
+	ueip_flag_V4    uint8 = 0b00000010
+	ueip_flag_SD    uint8 = 0b00000100 // set indicates destination
+	ueip_flag_IPv6D uint8 = 0b00001000
+	ueip_flag_CHV4  uint8 = 0b00010000
+	ueip_flag_CHV6  uint8 = 0b00100000
+	ueip_flag_IP6PL uint8 = 0b01000000
 )
 
-func Encode_UeIpAddress_IpV4_Dst(ip netip.Addr) (bytes []byte) {
-	flags := ueip_flag_SD | ueip_flag_V4
+func Encode_UeIpAddress(ueIpAddress UeIpAddress) (bytes []byte) {
+	var flags uint8
+	if ueIpAddress.Destination {
+		flags |= ueip_flag_SD
+	}
+	if ueIpAddress.ChooseV4 {
+		flags |= ueip_flag_CHV4
+	}
+	if ueIpAddress.ChooseV6 {
+		flags |= ueip_flag_CHV6
+	}
 	bytes = append(bytes, flags)
-	bytes = append(bytes, Encode_IpV4(ip)...)
+	if ueIpAddress.IpV4.IsValid() {
+		bytes[0] |= ueip_flag_V4
+		bytes = append(bytes, Encode_IpV4(ueIpAddress.IpV4)...)
+	}
+	if ueIpAddress.IpV6.IsValid() {
+		bytes[0] |= ueip_flag_V6
+		bytes = append(bytes, Encode_IpV6(ueIpAddress.IpV6)...)
+	}
+	if ueIpAddress.PrefixDelegationBits != 0 {
+		bytes[0] |= ueip_flag_IPv6D
+		bytes = append(bytes, ueIpAddress.PrefixDelegationBits)
+	}
+	if ueIpAddress.PrefixLength != 0 {
+		bytes[0] |= ueip_flag_IP6PL
+		bytes = append(bytes, ueIpAddress.PrefixLength)
+	}
 	return
 }
 
 // TS29.244 - 8.2.82- User Plane IP Resource Information
 // Release 15 only
-func Encode_UserPlaneIpResourceInformation(ip netip.Addr) (bytes []byte) {
-	bytes = append(bytes, 1) // V4 flag is bit 1
-	bytes = append(bytes, Encode_IpV4(ip)...)
+const (
+	upiri_flag_V4     uint8 = 0b00000001
+	upiri_flag_V6     uint8 = 0b00000010
+	upiri_mask_TEIDRI uint8 = 0b00011100
+	upiri_flag_ASSONI uint8 = 0b00100000
+	upiri_flag_ASSOSI uint8 = 0b01000000
+)
+
+// only the address part of the IE is encoded, the TEID range and association fields are not used here
+func Encode_UserPlaneIpResourceInformation(addrs ...netip.Addr) (bytes []byte) {
+	v4, v6 := splitAddrs(addrs...)
+	return Encode_UpIpResInfo(UpIpResInfo{IpV4: v4, IpV6: v6})
+}
+
+func Encode_UpIpResInfo(upIpResInfo UpIpResInfo) (bytes []byte) {
+	bytes = append(bytes, (upIpResInfo.TeidRangeIndication<<2)&upiri_mask_TEIDRI)
+	if bytes[0] != 0 {
+		bytes = append(bytes, upIpResInfo.TeidRange)
+	}
+	if upIpResInfo.IpV4.IsValid() {
+		bytes[0] |= upiri_flag_V4
+		bytes = append(bytes, Encode_IpV4(upIpResInfo.IpV4)...)
+	}
+	if upIpResInfo.IpV6.IsValid() {
+		bytes[0] |= upiri_flag_V6
+		bytes = append(bytes, Encode_IpV6(upIpResInfo.IpV6)...)
+	}
+	if upIpResInfo.NetworkInstance != nil {
+		bytes[0] |= upiri_flag_ASSONI
+		bytes = append(bytes, upIpResInfo.NetworkInstance...)
+	}
+	if upIpResInfo.SourceInterface != nil {
+		bytes[0] |= upiri_flag_ASSOSI
+		bytes = append(bytes, Encode_InterfaceType(*upIpResInfo.SourceInterface)...)
+	}
+	return
+}
+
+// TS29.244 8.2.138
+const (
+	sip_flag_V6  uint8 = 0b00000001
+	sip_flag_V4  uint8 = 0b00000010
+	sip_flag_MPL uint8 = 0b00000100
+)
+
+func Encode_SourceIpAddress(sourceIpAddress SourceIpAddress) (bytes []byte) {
+	bytes = append(bytes, 0)
+	if sourceIpAddress.IpV4.IsValid() {
+		bytes[0] |= sip_flag_V4
+		bytes = append(bytes, Encode_IpV4(sourceIpAddress.IpV4)...)
+	}
+	if sourceIpAddress.IpV6.IsValid() {
+		bytes[0] |= sip_flag_V6
+		bytes = append(bytes, Encode_IpV6(sourceIpAddress.IpV6)...)
+	}
+	if sourceIpAddress.MaskPrefixLength != 0 {
+		bytes[0] |= sip_flag_MPL
+		bytes = append(bytes, sourceIpAddress.MaskPrefixLength)
+	}
 	return
 }
 
@@ -149,16 +281,44 @@ const (
 )
 
 func Encode_FTeid_Choose_IpV4() (bytes []byte) {
-	bytes = make([]byte, 1)
-	bytes[0] = fteid_flag_CH | fteid_flag_V4
-	return
+	return Encode_FTeid(FTeid{ChooseV4: true})
+}
+
+func Encode_FTeid_Choose_IpV6() (bytes []byte) {
+	return Encode_FTeid(FTeid{ChooseV6: true})
+}
+
+// Encode_FTeid_Ip accepts an IPv4 address, an IPv6 address, or one of each (dual-stack)
+func Encode_FTeid_Ip(teid TEID, addrs ...netip.Addr) (bytes []byte) {
+	return Encode_FTeid(*NewFTeid(teid, addrs...))
 }
 
-func Encode_FTeid_IpV4(teid TEID, ip netip.Addr) (bytes []byte) {
-	bytes = make([]byte, 1+4, 1+4+4)
-	bytes[0] = fteid_flag_V4
-	binary.BigEndian.PutUint32(bytes[1:], uint32(teid))
-	bytes = append(bytes, Encode_IpV4(ip)...)
+// Encode_FTeid uses the choose form when the TEID is nil, in which case the addresses are ignored
+func Encode_FTeid(fteid FTeid) (bytes []byte) {
+	if fteid.Teid == nil {
+		bytes = append(bytes, fteid_flag_CH)
+		if fteid.ChooseV4 {
+			bytes[0] |= fteid_flag_V4
+		}
+		if fteid.ChooseV6 {
+			bytes[0] |= fteid_flag_V6
+		}
+		if fteid.ChooseId != nil {
+			bytes[0] |= fteid_flag_CHID
+			bytes = append(bytes, *fteid.ChooseId)
+		}
+	} else {
+		bytes = make([]byte, 1+4, 1+4+4+16)
+		binary.BigEndian.PutUint32(bytes[1:], uint32(*fteid.Teid))
+		if fteid.IpV4.IsValid() {
+			bytes[0] |= fteid_flag_V4
+			bytes = append(bytes, Encode_IpV4(fteid.IpV4)...)
+		}
+		if fteid.IpV6.IsValid() {
+			bytes[0] |= fteid_flag_V6
+			bytes = append(bytes, Encode_IpV6(fteid.IpV6)...)
+		}
+	}
 	return
 }
 
@@ -192,13 +352,8 @@ const (
 )
 
 func Encode_GateStatus(gs GateStatus) []byte {
-	var flags byte
-	if gs.UlGate == EnumClosed {
-		flags |= gate_status_flag_ul
-	}
-	if gs.DlGate == EnumClosed {
-		flags |= gate_status_flag_dl
-	}
+	// the gate values are written as is, so that spare values survive a decode/encode cycle
+	flags := byte(gs.UlGate&0b11)*gate_status_flag_ul | byte(gs.DlGate&0b11)*gate_status_flag_dl
 	return Encode_Uint8(flags)
 }
 
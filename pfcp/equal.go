@@ -0,0 +1,100 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// Equal compares IE trees semantically: the order of sibling IEs is ignored,
+// multiple IEs with an ID are paired by their IeID, and other IEs are paired with any equal sibling of the same type.
+// Payloads are compared byte for byte.
+func Equal(a, b *IeNode) bool {
+	if a.IeTypeCode != b.IeTypeCode || a.enterpriseId != b.enterpriseId {
+		return false
+	} else if len(a.ies) == 0 && len(b.ies) == 0 {
+		return bytes.Equal(a.bytes, b.bytes)
+	} else {
+		return equalIes(a.ies, b.ies)
+	}
+}
+
+func equalIes(a, b []IeNode) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	paired := make([]bool, len(b))
+label:
+	for i := range a {
+		for j := range b {
+			if paired[j] || a[i].IeTypeCode != b[j].IeTypeCode || a[i].enterpriseId != b[j].enterpriseId {
+			} else if a[i].IeID != nil && b[j].IeID != nil && *a[i].IeID != *b[j].IeID {
+			} else if Equal(&a[i], &b[j]) {
+				paired[j] = true
+				continue label
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Equal compares the headers, and the IEs with Equal
+func (msg *PfcpMessage) Equal(other *PfcpMessage) bool {
+	return msg.MessageTypeCode == other.MessageTypeCode && msg.SequenceNumber == other.SequenceNumber &&
+		equalOptional(msg.SEID, other.SEID) && equalOptional(msg.Priority, other.Priority) &&
+		equalIes(msg.iEnodes, other.iEnodes)
+}
+
+func equalOptional[T comparable](a, b *T) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+// Canonicalise sorts the members of the IE, recursively, into a stable order:
+// by type code, vendor IEs after the others, then by IeID, then by payload.
+// Validated IEs which are Equal have the same wire format once both are canonicalised.
+func (node *IeNode) Canonicalise() {
+	canonicaliseIes(node.ies)
+}
+
+// Canonicalise sorts the IEs of the message as IeNode.Canonicalise does
+func (msg *PfcpMessage) Canonicalise() {
+	canonicaliseIes(msg.iEnodes)
+}
+
+func canonicaliseIes(ies []IeNode) {
+	for i := range ies {
+		canonicaliseIes(ies[i].ies)
+	}
+	sort.SliceStable(ies, func(i, j int) bool { return canonicalLess(&ies[i], &ies[j]) })
+}
+
+func canonicalLess(a, b *IeNode) bool {
+	if a.IeTypeCode != b.IeTypeCode {
+		return a.IeTypeCode < b.IeTypeCode
+	} else if a.enterpriseId != b.enterpriseId {
+		return a.enterpriseId < b.enterpriseId
+	} else if a.IeID != nil && b.IeID != nil && *a.IeID != *b.IeID {
+		return *a.IeID < *b.IeID
+	} else {
+		return bytes.Compare(a.appendSerialised(nil), b.appendSerialised(nil)) < 0
+	}
+}
+
+// EquivalenceCheck is ReserialiseCheck for messages whose IEs may be in another order, e.g. those of other vendors,
+// or which were changed, e.g. by Merge: raw is parsed, with DefaultProfile, and compared with Equal
+func EquivalenceCheck(msg *PfcpMessage, raw []byte) error {
+	if parsed, err := ParseValidate(raw); err != nil {
+		return err
+	} else if !msg.Equal(parsed) {
+		a, b := msg.Clone(), parsed
+		a.Canonicalise()
+		b.Canonicalise()
+		compare(a.Serialise(), b.Serialise())
+		return fmt.Errorf("message IS NOT equivalent to original packet,\nmessage length %d, original packet length %d", msg.SerialisedLength(), len(raw))
+	}
+	return nil
+}
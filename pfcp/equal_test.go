@@ -0,0 +1,75 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+// shuffled returns a copy of the message with the IEs of every level in random order
+func shuffled(msg *PfcpMessage, random *rand.Rand) *PfcpMessage {
+	var shuffle func([]IeNode)
+	shuffle = func(ies []IeNode) {
+		random.Shuffle(len(ies), func(i, j int) { ies[i], ies[j] = ies[j], ies[i] })
+		for i := range ies {
+			shuffle(ies[i].ies)
+		}
+	}
+	clone := msg.Clone()
+	shuffle(clone.iEnodes)
+	return clone
+}
+
+func TestEqualReordered(t *testing.T) {
+	random := rand.New(rand.NewSource(29244))
+	ser, _ := mergeTestMessages(t)
+	for _, msg := range []*PfcpMessage{ser, ser2} {
+		canonical := msg.Clone()
+		canonical.Canonicalise()
+		for i := 0; i < 20; i++ {
+			reordered := shuffled(msg, random)
+			if !msg.Equal(reordered) {
+				t.Errorf("reordered message not equal\n%s", reordered.Dumper())
+			} else if err := EquivalenceCheck(msg, reordered.Serialise()); err != nil {
+				t.Error(err)
+			}
+			reordered.Canonicalise()
+			if !bytes.Equal(reordered.Serialise(), canonical.Serialise()) {
+				t.Errorf("canonical forms differ\n%s\n%s", reordered.Dumper(), canonical.Dumper())
+			}
+		}
+	}
+}
+
+func TestEqualDifferences(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	changes := map[string]func(*PfcpMessage){
+		"payload":    func(msg *PfcpMessage) { msg.iEnodes[0] = IE_NodeIdFqdn("other") },
+		"missing IE": func(msg *PfcpMessage) { msg.iEnodes = msg.iEnodes[1:] },
+		"added IE":   func(msg *PfcpMessage) { msg.iEnodes = append(msg.iEnodes, IE_CreateUrr(IE_UrrId(3))) },
+		"nested IE": func(msg *PfcpMessage) {
+			msg.Node().Getter().GetById(Create_QER, 2).Inner().ies[1] = IE_GateStatus(GateStatus{UlGate: EnumClosed})
+		},
+		"IE ID":        func(msg *PfcpMessage) { *msg.Node().Getter().GetById(Create_URR, 2).Inner().IeID = 3 },
+		"SEID":         func(msg *PfcpMessage) { *msg.SEID = 2 },
+		"sequence":     func(msg *PfcpMessage) { msg.SequenceNumber = 1 },
+		"message type": func(msg *PfcpMessage) { msg.MessageTypeCode = PFCP_Session_Modification_Request },
+	}
+	for name, change := range changes {
+		changed := ser.Clone()
+		change(changed)
+		if ser.Equal(changed) || changed.Equal(ser) {
+			t.Errorf("%s: change not detected", name)
+		}
+	}
+
+	// a list of equal IEs is a multiset
+	a := IE_CreatePdr(IE_PdrId(1), IE_QerId(1), IE_QerId(1), IE_QerId(2))
+	b := IE_CreatePdr(IE_PdrId(1), IE_QerId(2), IE_QerId(1), IE_QerId(2))
+	if Equal(&a, &b) {
+		t.Errorf("lists with different counts are equal")
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+// Gets is the result of a getter for multiple IEs, e.g. every PDR: the IEs found, possibly none, or the failure of the Get it was applied to.
+// Each of the IEs is a Get, so that getters can be chained from it, with the same error propagation.
+type Gets struct {
+	Get
+	gets []Get
+}
+
+// Return returns the IEs found, or the prior failure
+func (gets Gets) Return() ([]*IeNode, error) {
+	if len(gets.err) != 0 {
+		return nil, gets.Error()
+	}
+	nodes := make([]*IeNode, len(gets.gets))
+	for i := range gets.gets {
+		nodes[i] = gets.gets[i].IeNode
+	}
+	return nodes, nil
+}
+
+// Each returns a Get for each IE found, for ranging over.
+// After a prior failure it returns the failed Get alone, so that the failure propagates to the getters applied to it.
+func (gets Gets) Each() []Get {
+	if len(gets.err) != 0 {
+		return []Get{gets.Get}
+	} else {
+		return gets.gets
+	}
+}
+
+// Len is the number of IEs found, zero after a prior failure
+func (gets Gets) Len() int {
+	return len(gets.gets)
+}
+
+// First is a Get of the first IE found, which fails if there is none
+func (gets Gets) First() Get {
+	if len(gets.err) != 0 {
+		return gets.Get
+	} else if len(gets.gets) == 0 {
+		return gets.Get.fail("First, none found")
+	} else {
+		return gets.gets[0]
+	}
+}
+
+// GetAll gets every IE of type tc, including group IEs with an ID, unlike GetByTc
+func (get Get) GetAll(tc IeTypeCode) Gets {
+	return get.GetAllByNamedPredicate(tc, NamedPredicate{Predicate: func(*Get) bool { return true }})
+}
+
+// GetAllByPredicate gets every IE of type tc for which the predicate is true
+func (get Get) GetAllByPredicate(tc IeTypeCode, predicate Predicate) Gets {
+	return get.GetAllByNamedPredicate(tc, NamedPredicate{Predicate: predicate})
+}
+
+func (get Get) GetAllByNamedPredicate(tc IeTypeCode, predicate NamedPredicate) Gets {
+	if len(get.err) != 0 {
+		// prior fail
+		get.contexts = append(get.contexts, get.IeNode.IeTypeCode)
+		return Gets{Get: get}
+	}
+	gets := Gets{Get: get}
+	for i := range get.IeNode.ies {
+		if tc == get.IeNode.ies[i].IeTypeCode {
+			proxyGet := &Get{IeNode: &get.IeNode.ies[i]}
+			if predicate.Predicate(proxyGet) {
+				gets.gets = append(gets.gets, get.found(proxyGet.IeNode, predicate.step(proxyGet.IeNode)))
+			}
+		}
+	}
+	return gets
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"strings"
+	"testing"
+)
+
+func getAllTestRoot(t *testing.T) Get {
+	msg, err := ParseValidate(SessionEstablishmentRequest.Serialise())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg.Node().Getter()
+}
+
+func TestGetAll(t *testing.T) {
+	root := getAllTestRoot(t)
+
+	if pdrs, err := root.GetAll(Create_PDR).Return(); err != nil || len(pdrs) != 2 {
+		t.Errorf("GetAll found %d PDRs, %v", len(pdrs), err)
+	}
+	var farIds []IeID
+	for _, pdr := range root.GetAll(Create_PDR).Each() {
+		if id, err := pdr.GetByTc(FAR_ID).ParseID(); err != nil {
+			t.Error(err)
+		} else {
+			farIds = append(farIds, id)
+		}
+	}
+	if len(farIds) != 2 || farIds[0] != 0 || farIds[1] != 1073741824 {
+		t.Errorf("FAR IDs of all PDRs %v", farIds)
+	}
+	if gets := root.GetAll(Create_URR); gets.Len() != 0 {
+		t.Errorf("GetAll found %d URRs", gets.Len())
+	} else if _, err := gets.First().Return(); err == nil {
+		t.Errorf("First of no IEs succeeded")
+	}
+
+	isCore := NewNamedPredicate("core", func(get *Get) bool {
+		return get.GetByTc(PDI).GetByTc(Source_Interface).Inner().DeserialiseEnumInterface() == EnumCore
+	})
+	if pdrs, err := root.GetAllByNamedPredicate(Create_PDR, isCore).Return(); err != nil || len(pdrs) != 1 {
+		t.Errorf("GetAllByNamedPredicate found %d PDRs, %v", len(pdrs), err)
+	}
+
+	// errors propagate, through ranging too
+	failed := root.GetByTc(Create_URR)
+	if _, err := failed.GetAll(URR_ID).Return(); err == nil {
+		t.Errorf("prior failure lost")
+	} else if each := failed.GetAll(URR_ID).Each(); len(each) != 1 {
+		t.Errorf("prior failure not returned for ranging")
+	} else if _, err := each[0].GetByTc(Measurement_Period).Return(); err == nil {
+		t.Errorf("prior failure lost when ranging")
+	}
+}
+
+func TestNamedPredicateContext(t *testing.T) {
+	root := getAllTestRoot(t)
+	isCore := NewNamedPredicate("core", func(get *Get) bool {
+		return get.GetByTc(PDI).GetByTc(Source_Interface).Inner().DeserialiseEnumInterface() == EnumCore
+	})
+	never := NewNamedPredicate("never", func(*Get) bool { return false })
+
+	if _, err := root.GetByNamedPredicate(Create_PDR, never).Return(); err == nil || !strings.Contains(err.Error(), "Create_PDR[never]") {
+		t.Errorf("predicate name missing from %v", err)
+	}
+	if _, err := root.GetByPredicate(Create_PDR, never.Predicate).Return(); err == nil {
+		t.Errorf("GetByPredicate matching nothing succeeded")
+	}
+	if _, err := root.GetByNamedPredicate(Create_PDR, isCore).GetByTc(PDI).GetByTc(F_TEID).Return(); err == nil || !strings.Contains(err.Error(), "in Create_PDR[core]/PDI") {
+		t.Errorf("path context missing from %v", err)
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	root := getAllTestRoot(t)
+	for path, expected := range map[string]int{
+		"Create_PDR":        2,
+		"Create_PDR/FAR_ID": 2,
+		"Create_PDR[PDI/Source_Interface=Access]":                              1,
+		"Create_PDR[PDI/Source_Interface=Access]/PDI/F_TEID":                   1,
+		"Create_PDR[PDI/UE_IP_Address]":                                        1,
+		"Create_PDR[id=32768][PDI/Source_Interface=Core]":                      1,
+		"Create_PDR[id=32768][PDI/Source_Interface=Access]":                    0,
+		"Create_FAR[Apply_Action=BUFF]":                                        1,
+		"Create_FAR[Forwarding_Parameters[Destination_Interface=Core]]":        1,
+		"Create_QER[QFI=5]":                                                    2,
+		"Create_PDR[Precedence=32]":                                            1,
+		"Create_PDR[Precedence=33]":                                            0,
+		"Create_PDR[PDI/Source_Interface=Access]/PDI/Source_Interface":         1,
+		"Create_PDR[PDI/Source_Interface=Access][PDI/Source_Interface=Access]": 1,
+	} {
+		if nodes, err := root.GetAllPath(path).Return(); err != nil {
+			t.Errorf("%s: %s", path, err.Error())
+		} else if len(nodes) != expected {
+			t.Errorf("%s: %d IEs found, expected %d", path, len(nodes), expected)
+		}
+	}
+
+	if id, err := root.GetPath("Create_PDR[PDI/Source_Interface=Core]/FAR_ID").ParseID(); err != nil || id != 1073741824 {
+		t.Errorf("GetPath FAR ID %d, %v", id, err)
+	}
+	for _, path := range []string{"Create_PDR/FAR_ID", "Create_URR", "No_Such_IE", "Create_PDR[PDI", "Create_PDR]", "Create_PDR[id=x]", "Create_PDR[id=1]x"} {
+		if _, err := root.GetPath(path).Return(); err == nil {
+			t.Errorf("%s: no error", path)
+		}
+	}
+	if _, err := root.GetByTc(Create_URR).GetPath("URR_ID").Return(); err == nil || !strings.Contains(err.Error(), "Create URR") {
+		t.Errorf("prior failure lost: %v", err)
+	}
+	if _, err := root.GetPath("Create_PDR[PDI/Source_Interface=Core]/PDI/F_TEID").Return(); err == nil || !strings.Contains(err.Error(), "PDI/Source_Interface=Core") {
+		t.Errorf("path missing from %v", err)
+	}
+
+	vendor := vendorTestMessage(*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1}))
+	if _, err := vendor.Node().Getter().GetPath(IePathElement{TypeCode: testVendorPlain | vendorTypeCodeFlag, EnterpriseId: testEnterprise}.String()).Return(); err != nil {
+		t.Error(err)
+	} else if _, err := vendor.Node().Getter().GetPath(IePathElement{TypeCode: testVendorPlain | vendorTypeCodeFlag, EnterpriseId: testEnterprise + 1}.String()).Return(); err == nil {
+		t.Errorf("vendor IE of another enterprise found")
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Path getters select IEs with a path of IE identifiers, separated by '/', e.g.
+//
+//	Create_PDR[PDI/Source_Interface=Access]/FAR_ID
+//
+// A step may have filters in brackets, all of which must match:
+//   - [id=3] matches the group IE with the IeID 3, as in the paths of validation errors
+//   - [path=value] matches if an IE at the path below the step has the value, written as in the message dump
+//   - [path] matches if there is an IE at the path below the step
+//
+// Steps are IE identifiers, the IE(n) and vendor(e/t) forms of validation error paths, or vendor IE names.
+// The filters of a step are named predicates, so a step which matches nothing appears in the error, e.g. 'Create_PDR[id=3]'.
+
+// GetPath gets the IE at the path, which fails unless there is exactly one
+func (get Get) GetPath(path string) Get {
+	gets := get.GetAllPath(path)
+	if len(gets.err) != 0 {
+		return gets.Get
+	} else if len(gets.gets) == 0 {
+		return get.fail("GetPath, not found, %s", path)
+	} else if len(gets.gets) > 1 {
+		return get.fail("GetPath, %d IEs found, %s", len(gets.gets), path)
+	} else {
+		return gets.gets[0]
+	}
+}
+
+// GetAllPath gets every IE at the path, possibly none
+func (get Get) GetAllPath(path string) Gets {
+	steps, err := parseGetPath(path)
+	if len(get.err) != 0 {
+		// prior fail
+		get.contexts = append(get.contexts, get.IeNode.IeTypeCode)
+		return Gets{Get: get}
+	} else if err != nil {
+		// local fail
+		return Gets{Get: get.fail("GetPath, %s", err.Error())}
+	}
+	return get.getAllSteps(steps)
+}
+
+func (get Get) getAllSteps(steps []getPathStep) Gets {
+	gets := []Get{get}
+	for _, step := range steps {
+		var next []Get
+		for _, parent := range gets {
+			next = append(next, parent.GetAllByNamedPredicate(step.tc, step.predicate).gets...)
+		}
+		gets = next
+	}
+	return Gets{Get: get, gets: gets}
+}
+
+type getPathStep struct {
+	tc        IeTypeCode
+	predicate NamedPredicate
+}
+
+// splitGetPath splits at the separator where it is outside brackets, or the parentheses of e.g. vendor(e/t)
+func splitGetPath(path string, separator byte) (parts []string, err error) {
+	depth, start := 0, 0
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			if depth--; depth < 0 {
+				return nil, fmt.Errorf("unbalanced '%c' in '%s'", path[i], path)
+			}
+		case separator:
+			if depth == 0 {
+				parts = append(parts, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in '%s'", path)
+	}
+	return append(parts, path[start:]), nil
+}
+
+func parseGetPath(path string) ([]getPathStep, error) {
+	parts, err := splitGetPath(path, '/')
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]getPathStep, len(parts))
+	for i, part := range parts {
+		if steps[i], err = parseGetPathStep(part); err != nil {
+			return nil, err
+		}
+	}
+	return steps, nil
+}
+
+func parseGetPathStep(text string) (step getPathStep, err error) {
+	name, filterText, _ := strings.Cut(text, "[")
+	node, err := getPathIeByName(strings.TrimSpace(name))
+	if err != nil {
+		return step, err
+	}
+	step.tc = node.IeTypeCode
+
+	var filters []Predicate
+	if node.IeTypeCode.IsVendor() {
+		// the enterprise ID is part of the match for vendor IEs
+		filters = append(filters, func(get *Get) bool { return get.IeNode.enterpriseId == node.enterpriseId })
+	}
+	for filterText != "" {
+		if filter, rest, found := cutFilter(filterText); !found {
+			return step, fmt.Errorf("unbalanced '[' in '%s'", text)
+		} else if rest != "" && !strings.HasPrefix(rest, "[") {
+			return step, fmt.Errorf("invalid text after filter in '%s'", text)
+		} else if predicate, err := parseGetPathFilter(filter); err != nil {
+			return step, err
+		} else {
+			filters = append(filters, predicate)
+			filterText = strings.TrimPrefix(rest, "[")
+		}
+	}
+
+	// the filters are shown as written, e.g. Create_PDR[id=1]
+	step.predicate = NamedPredicate{
+		Name: strings.TrimSuffix(strings.TrimPrefix(text[len(name):], "["), "]"),
+		Predicate: func(get *Get) bool {
+			for _, filter := range filters {
+				if !filter(get) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+	return step, nil
+}
+
+// cutFilter cuts the text of a filter, the opening bracket already removed, at its closing bracket
+func cutFilter(text string) (filter, rest string, found bool) {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		if text[i] == '[' {
+			depth++
+		} else if text[i] == ']' && depth > 0 {
+			depth--
+		} else if text[i] == ']' {
+			return text[:i], text[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+func parseGetPathFilter(filter string) (Predicate, error) {
+	parts, err := splitGetPath(filter, '=')
+	if err != nil {
+		return nil, err
+	}
+	left, value, hasValue := parts[0], strings.Join(parts[1:], "="), len(parts) > 1
+	if idText, isId := strings.CutPrefix(filter, "id="); isId {
+		if id, err := strconv.ParseUint(idText, 10, 32); err != nil {
+			return nil, fmt.Errorf("invalid ID '%s'", idText)
+		} else {
+			return func(get *Get) bool { return get.IeNode.IeID != nil && *get.IeNode.IeID == IeID(id) }, nil
+		}
+	} else if steps, err := parseGetPath(strings.TrimSpace(left)); err != nil {
+		return nil, err
+	} else if !hasValue {
+		return func(get *Get) bool { return get.getAllSteps(steps).Len() > 0 }, nil
+	} else {
+		value = strings.TrimSpace(value)
+		return func(get *Get) bool {
+			for _, leaf := range get.getAllSteps(steps).gets {
+				if getPathValueMatches(leaf.IeNode, value) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+}
+
+// getPathValueMatches compares with the value as shown in the message dump, or as read by ParseText
+func getPathValueMatches(node *IeNode, value string) bool {
+	if node.show() == value {
+		return true
+	} else if payload, err := parseTextValue(node, value); err != nil {
+		return false
+	} else {
+		return bytes.Equal(payload, node.bytes)
+	}
+}
+
+var getPathVendorRegexp = regexp.MustCompile(`^vendor\((\d+)/(\d+)\)$`)
+
+func getPathIeByName(name string) (*IeNode, error) {
+	if matches := getPathVendorRegexp.FindStringSubmatch(name); matches != nil {
+		// the form of IePathElement
+		return textIeByName(fmt.Sprintf("vendor IE(%s/%s)", matches[1], matches[2]))
+	} else {
+		return textIeByName(name)
+	}
+}
@@ -12,6 +12,28 @@ type Get struct {
 	*IeNode         // although a pointer,it is not allowed that it be invalid, code need not check it explicitly (panic allowed)
 	err      string // Perhaps, this should change to a slice of strings, since it is copied at each parse step.  But,only when the parse failed is it not empty.
 	contexts []IeTypeCode
+	path     []string // the steps which found the IE, for the context of a later failure
+}
+
+// found moves the get to the IE found by a step, named for the path, e.g. 'Create_PDR[access]'
+func (get Get) found(ie *IeNode, step string) Get {
+	get.IeNode = ie
+	// the path may be shared with other gets from the same parent, so it is copied rather than extended in place
+	get.path = append(get.path[:len(get.path):len(get.path)], step)
+	return get
+}
+
+// fail records a local failure, with the path of the IE at which it failed
+func (get Get) fail(format string, a ...any) Get {
+	get.err = fmt.Sprintf(format, a...)
+	if len(get.path) > 0 {
+		get.err += " in " + strings.Join(get.path, "/")
+	}
+	return get
+}
+
+func stepName(tc IeTypeCode) string {
+	return IePathElement{TypeCode: tc}.String()
 }
 
 func (get Get) Return() (*IeNode, error) {
@@ -70,10 +92,10 @@ func (get Get) GetByTc(tc IeTypeCode) Get {
 	// need a wrapper to simplify this test...?
 	if len(get.err) == 0 {
 		if ie := get.IeNode.getByTc(tc); ie != nil {
-			get.IeNode = ie
+			get = get.found(ie, stepName(tc))
 		} else {
 			// local fail
-			get.err = fmt.Sprintf("GetByTc, not found, %s", tc)
+			get = get.fail("GetByTc, not found, %s", tc)
 		}
 	} else {
 		// prior fail
@@ -95,10 +117,10 @@ func (get Get) GetById(tc IeTypeCode, id IeID) Get {
 	// need a wrapper to simplify this test...?
 	if len(get.err) == 0 {
 		if ie := get.IeNode.getById(tc, id); ie != nil {
-			get.IeNode = ie
+			get = get.found(ie, fmt.Sprintf("%s[id=%d]", stepName(tc), id))
 		} else {
 			// local fail
-			get.err = fmt.Sprintf("GetById, not found, %s %d", tc, id)
+			get = get.fail("GetById, not found, %s %d", tc, id)
 		}
 	} else {
 		// prior fail
@@ -107,25 +129,49 @@ func (get Get) GetById(tc IeTypeCode, id IeID) Get {
 	return get
 }
 
-type Predicate = func(*Get) bool // TODO extend predicate so that it has a name which can be used for context logs
+type Predicate = func(*Get) bool
+
+// NamedPredicate is a Predicate with a name, e.g. 'access', which is used in the path and error context of the get
+type NamedPredicate struct {
+	Name string
+	Predicate
+}
+
+func NewNamedPredicate(name string, predicate Predicate) NamedPredicate {
+	return NamedPredicate{Name: name, Predicate: predicate}
+}
+
+// step names the IE found by the predicate in the path of the get
+func (predicate NamedPredicate) step(node *IeNode) string {
+	name := IePathElement{TypeCode: node.IeTypeCode, EnterpriseId: node.enterpriseId}.String()
+	if predicate.Name == "" {
+		return name
+	} else {
+		return fmt.Sprintf("%s[%s]", name, predicate.Name)
+	}
+}
 
+// GetByPredicate gets the first IE of type tc for which the predicate is true
 func (get Get) GetByPredicate(tc IeTypeCode, predicate Predicate) Get {
+	return get.GetByNamedPredicate(tc, NamedPredicate{Predicate: predicate})
+}
+
+func (get Get) GetByNamedPredicate(tc IeTypeCode, predicate NamedPredicate) Get {
 	if len(get.err) != 0 {
 		// prior fail
-		// not clear how this should be handled....
-		// but obviously it fails safe
-		// get.contexts = append(get.contexts, get.ieNode.IeTypeCode)
+		get.contexts = append(get.contexts, get.IeNode.IeTypeCode)
 	} else {
 		proxyGet := &Get{IeNode: nil, err: "", contexts: nil}
 		for i := range get.IeNode.ies {
 			if tc == get.IeNode.ies[i].IeTypeCode {
 				proxyGet.IeNode = &get.IeNode.ies[i]
-				if predicate(proxyGet) {
-					get.IeNode = proxyGet.IeNode
-					break
+				if predicate.Predicate(proxyGet) {
+					return get.found(proxyGet.IeNode, predicate.step(proxyGet.IeNode))
 				}
 			}
 		}
+		// local fail
+		get = get.fail("GetByPredicate, not found, %s", predicate.step(&IeNode{IeTypeCode: tc}))
 	}
 	return get
 }
@@ -3,16 +3,51 @@
 // You should have received a copy of the GNU Affero General Public License along with this program.
 package pfcp
 
-import (
-	"bytes"
-	"encoding/gob"
-	"hash/fnv"
+// Hash64 is a content hash of the IE tree which, like Equal, ignores the order of sibling IEs:
+// IEs which are Equal have the same hash, e.g. for deduplicating session states and detecting changes in caches.
+// Payloads are hashed with FNV-1a, the hashes of members are mixed and summed, so that their order does not matter.
+func (node *IeNode) Hash64() uint64 {
+	h := fnvOffset64
+	h = fnvAdd(h, byte(node.IeTypeCode>>8), byte(node.IeTypeCode))
+	h = fnvAdd(h, byte(node.enterpriseId>>8), byte(node.enterpriseId))
+	if len(node.ies) == 0 {
+		return fnvAdd(h, node.bytes...)
+	}
+	var members uint64
+	for i := range node.ies {
+		members += mix64(node.ies[i].Hash64())
+	}
+	for shift := 0; shift < 64; shift += 8 {
+		h = fnvAdd(h, byte(members>>shift))
+	}
+	return h
+}
+
+// Hash64 hashes the IEs of the message, the header is not included,
+// so that e.g. session states which differ only in their sequence numbers have the same hash
+func (msg *PfcpMessage) Hash64() uint64 {
+	return msg.Node().Hash64()
+}
+
+const (
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
 )
 
-func Hash64[K any](s K) uint64 {
-	var b bytes.Buffer
-	gob.NewEncoder(&b).Encode(s)
-	fnv64 := fnv.New64a()
-	fnv64.Write(b.Bytes())
-	return fnv64.Sum64()
+func fnvAdd(h uint64, b ...byte) uint64 {
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= fnvPrime64
+	}
+	return h
+}
+
+// mix64 is the splitmix64 finaliser, it spreads the member hashes before they are summed
+func mix64(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
 }
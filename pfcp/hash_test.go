@@ -0,0 +1,55 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHashReordered(t *testing.T) {
+	random := rand.New(rand.NewSource(29244))
+	ser, _ := mergeTestMessages(t)
+	for _, msg := range []*PfcpMessage{ser, ser2, SessionEstablishmentRequest} {
+		hash := msg.Hash64()
+		for i := 0; i < 20; i++ {
+			if reordered := shuffled(msg, random); reordered.Hash64() != hash {
+				t.Errorf("hash of reordered message differs\n%s", reordered.Dumper())
+			}
+		}
+		if parsed, err := ParseValidate(msg.Serialise()); err != nil {
+			t.Error(err)
+		} else if parsed.Hash64() != hash {
+			t.Errorf("hash of parsed message differs")
+		}
+	}
+}
+
+func TestHashDifferences(t *testing.T) {
+	ser, smr := mergeTestMessages(t)
+	hashes := map[uint64]string{ser.Hash64(): "ser"}
+	merged, _ := ser.Merge(smr)
+	variants := map[string]*IeNode{
+		"merged":  merged.Node(),
+		"smr":     smr.Node(),
+		"swapped": NewGroupNode(0, IE_CreateQer(IE_QerId(1), IE_GateStatus(GateOpen)), IE_CreateQer(IE_QerId(2))),
+		"moved":   NewGroupNode(0, IE_CreateQer(IE_QerId(2), IE_GateStatus(GateOpen)), IE_CreateQer(IE_QerId(1))),
+		"empty":   NewGroupNode(0),
+		"list":    NewGroupNode(0, IE_QerId(1), IE_QerId(1)),
+		"single":  NewGroupNode(0, IE_QerId(1)),
+	}
+	for name, node := range variants {
+		if other, found := hashes[node.Hash64()]; found {
+			t.Errorf("%s has the hash of %s", name, other)
+		}
+		hashes[node.Hash64()] = name
+	}
+}
+
+func BenchmarkHash64(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SessionEstablishmentRequest.Hash64()
+	}
+}
@@ -4,7 +4,6 @@
 package pfcp
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
 )
@@ -17,9 +16,10 @@ type IeTypeCode uint16
 // The issue may be that it may be type unsafe if all of the static maps have keys which are unspecific - it is better to know that the index is one or the other...
 type IeNode struct {
 	IeTypeCode
-	bytes []byte   // only present if the node IS NOT group
-	*IeID          // only present if the node IS group
-	ies   []IeNode // only present if the node IS group
+	enterpriseId EnterpriseId // only present if the type code is a vendor type code
+	bytes        []byte       // only present if the node IS NOT group
+	*IeID                     // only present if the node IS group
+	ies          []IeNode     // only present if the node IS group
 }
 
 func (IeNode *IeNode) Ies() *[]IeNode {
@@ -34,6 +34,31 @@ func NewIeNode(tc IeTypeCode, bytes []byte) *IeNode {
 	return &IeNode{IeTypeCode: tc, bytes: bytes}
 }
 
+// Clone returns a deep copy of the IE, which shares no payload, ID or member storage with the original
+func (node *IeNode) Clone() *IeNode {
+	clone := &IeNode{IeTypeCode: node.IeTypeCode, enterpriseId: node.enterpriseId}
+	if node.bytes != nil {
+		clone.bytes = append([]byte{}, node.bytes...)
+	}
+	if node.IeID != nil {
+		id := *node.IeID
+		clone.IeID = &id
+	}
+	clone.ies = cloneIes(node.ies)
+	return clone
+}
+
+func cloneIes(ies []IeNode) []IeNode {
+	if ies == nil {
+		return nil
+	}
+	clones := make([]IeNode, len(ies))
+	for i := range ies {
+		clones[i] = *ies[i].Clone()
+	}
+	return clones
+}
+
 func (typeCode IeTypeCode) typeName() string { return typeCode.String() }
 
 type pfcpTypeCode interface {
@@ -63,19 +88,37 @@ type pfcpTypeCode interface {
 		  TDOD - verify this optimisation is not material
 */
 
+// IeLengthError is a parse failure of an IE from its length, IeTypeCode is zero when the buffer is too short for the IE header
+type IeLengthError struct {
+	IeTypeCode
+	Detail string
+}
+
+func (err *IeLengthError) Error() string {
+	return fmt.Sprintf("%s (%s)", err.Detail, err.IeTypeCode)
+}
+
 func readIe(current *[]byte) (*IeNode, error) {
 	if current == nil || *current == nil {
 		return nil, fmt.Errorf("nil slice")
 	} else if len(*current) < 4 {
-		return nil, fmt.Errorf("short byte slice")
-	} else if typeCode := binary.BigEndian.Uint16((*current)[0:2]); typeCode > 0x7fff {
-		return nil, fmt.Errorf("vendor type code not supported")
-	} else if length := binary.BigEndian.Uint16((*current)[2:4]); int(length+4) > len(*current) {
-		return nil, fmt.Errorf("IE length overflows buffer")
-	} else {
+		return nil, &IeLengthError{0, "buffer too short for IE header"}
+	} else if length := int(binary.BigEndian.Uint16((*current)[2:4])); length+4 > len(*current) {
+		return nil, &IeLengthError{IeTypeCode(binary.BigEndian.Uint16((*current)[0:2])), "IE length overflows buffer"}
+	} else if typeCode := IeTypeCode(binary.BigEndian.Uint16((*current)[0:2])); !typeCode.IsVendor() {
 		rval := &IeNode{
 			bytes:      (*current)[4 : 4+length],
-			IeTypeCode: IeTypeCode(typeCode),
+			IeTypeCode: typeCode,
+		}
+		(*current) = (*current)[4+length:]
+		return rval, nil
+	} else if length < 2 {
+		return nil, &IeLengthError{typeCode, "vendor IE too short for enterprise ID"}
+	} else {
+		rval := &IeNode{
+			enterpriseId: EnterpriseId(binary.BigEndian.Uint16((*current)[4:6])),
+			bytes:        (*current)[6 : 4+length],
+			IeTypeCode:   typeCode,
 		}
 		(*current) = (*current)[4+length:]
 		return rval, nil
@@ -90,7 +133,7 @@ func readIes(start []byte) ([]IeNode, error) {
 		if err != nil {
 			return nil, err
 		} else {
-			if nextIe.IeTypeCode.isGroupIe() {
+			if nextIe.isGroup() {
 				if nextIes, err := readIes(nextIe.bytes); err != nil {
 					return nil, err
 				} else {
@@ -158,12 +201,14 @@ func (thisIe IeNode) deserialiseUint() uint {
 
 // serialisation operations
 
-func writeBEUint16(b *bytes.Buffer, u16 uint16) {
-	b.WriteByte(uint8(u16 >> 8))
-	b.WriteByte(uint8(u16))
+// Serialisation appends to a single slice. The length of a group IE is only known once its members are written,
+// so a zero length is written first and back-patched afterwards, no intermediate buffers are needed.
+
+func appendBEUint16(b []byte, u16 uint16) []byte {
+	return append(b, uint8(u16>>8), uint8(u16))
 }
 
-func (thisIe IeNode) serialise(b *bytes.Buffer) {
+func (thisIe *IeNode) appendSerialised(b []byte) []byte {
 	switch {
 	// the code cannot cope with the internally invalid case that both ie child list and direct payload are none nil
 	// possibly, panic is still a bad idea though, rather than just choose one.
@@ -172,31 +217,64 @@ func (thisIe IeNode) serialise(b *bytes.Buffer) {
 		panic("assertion fail")
 
 	case thisIe.ies != nil:
-		// // NB assigning another buffer can be avoided by inserting a zero length TLV for this IE and saving the location of the length field,
-		// // then, when the lower layer has filled the buffer, rewriting the length field to account for the added bytes.
-		// // Once this simple scheme works the optimisation can be investigated.
-
-		var nb bytes.Buffer
-		serialise(&nb, thisIe.ies)
-		ie := IeNode{
-			IeTypeCode: thisIe.IeTypeCode,
-			bytes:      nb.Bytes(),
+		b = appendBEUint16(b, uint16(thisIe.IeTypeCode))
+		lengthOffset := len(b)
+		b = appendBEUint16(b, 0)
+		if thisIe.IeTypeCode.IsVendor() {
+			b = appendBEUint16(b, uint16(thisIe.enterpriseId))
+		}
+		b = appendSerialised(b, thisIe.ies)
+		if length := len(b) - lengthOffset - 2; length > 0xffff {
+			panic("IE exceeds 16bit length limit")
+		} else {
+			binary.BigEndian.PutUint16(b[lengthOffset:], uint16(length))
+		}
+		return b
+
+	case thisIe.IeTypeCode.IsVendor():
+		// the enterprise ID is counted in the IE length
+		if len(thisIe.bytes) > 0xffff-2 {
+			panic("IE exceeds 16bit length limit")
 		}
-		ie.serialise(b)
+		b = appendBEUint16(b, uint16(thisIe.IeTypeCode))
+		b = appendBEUint16(b, uint16(len(thisIe.bytes)+2))
+		b = appendBEUint16(b, uint16(thisIe.enterpriseId))
+		return append(b, thisIe.bytes...)
 
 	default: // includes option of a zero payload basic IE
 		if len(thisIe.bytes) > 0xffff {
 			panic("IE exceeds 16bit length limit")
 		}
-		writeBEUint16(b, uint16(thisIe.IeTypeCode))
-		writeBEUint16(b, uint16(len(thisIe.bytes)))
-		b.Write(thisIe.bytes)
+		b = appendBEUint16(b, uint16(thisIe.IeTypeCode))
+		b = appendBEUint16(b, uint16(len(thisIe.bytes)))
+		return append(b, thisIe.bytes...)
+	}
+}
+
+// serialisedLength is the length of the IE on the wire, including the TLV header
+func (thisIe *IeNode) serialisedLength() int {
+	length := 4
+	if thisIe.IeTypeCode.IsVendor() {
+		length += 2
+	}
+	if thisIe.ies != nil {
+		return length + serialisedLength(thisIe.ies)
+	} else {
+		return length + len(thisIe.bytes)
+	}
+}
+
+// these functions are equally valid for IEs in both a grouped IE and in a full PFCP message
+func appendSerialised(b []byte, ies []IeNode) []byte {
+	for i := range ies {
+		b = ies[i].appendSerialised(b)
 	}
+	return b
 }
 
-// this function is equally valid for serialising IEs in both a grouped IE and in a full PFCP message
-func serialise(b *bytes.Buffer, ies []IeNode) {
-	for _, ie := range ies {
-		ie.serialise(b)
+func serialisedLength(ies []IeNode) (length int) {
+	for i := range ies {
+		length += ies[i].serialisedLength()
 	}
+	return
 }
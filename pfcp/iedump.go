@@ -19,11 +19,11 @@ func pad(sb *strings.Builder, level int) {
 func (node IeNode) dump(sb *strings.Builder, level int) {
 	pad(sb, level)
 	if node.IeID != nil {
-		fmt.Fprintf(sb, "%s (%d)\n", node.IeTypeCode, *node.IeID)
-	} else if node.IeTypeCode.isGroupIe() {
-		fmt.Fprintf(sb, "%s\n", node.IeTypeCode)
+		fmt.Fprintf(sb, "%s (%d)\n", node.name(), *node.IeID)
+	} else if node.isGroup() {
+		fmt.Fprintf(sb, "%s\n", node.name())
 	} else {
-		fmt.Fprintf(sb, "%s: %s\n", node.IeTypeCode, node.show())
+		fmt.Fprintf(sb, "%s: %s\n", node.name(), node.show())
 	}
 
 	for i := range node.ies {
@@ -0,0 +1,225 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JSON and YAML forms of messages and IEs, for fixtures and inspection of captures.
+// An IE is written with its identifier and type code, and either its members, the fields of its typed value,
+// or, for vendor and unknown IEs and payloads which the typed value cannot reproduce, the hex payload.
+// Reading the form back gives the same wire bytes.
+//
+// When reading, either of the type code and the identifier may be omitted.
+// In YAML, a hex payload which consists only of digits must be quoted.
+// YAML is the JSON form, so that field names and number handling are the same in both.
+
+type jsonIe struct {
+	Name         string          `json:"ie,omitempty"`
+	TypeCode     IeTypeCode      `json:"type,omitempty"`
+	EnterpriseId EnterpriseId    `json:"enterprise,omitempty"`
+	Value        json.RawMessage `json:"value,omitempty"`
+	Hex          string          `json:"hex,omitempty"`
+	Ies          *[]IeNode       `json:"ies,omitempty"`
+}
+
+type jsonMessage struct {
+	Name           string          `json:"message,omitempty"`
+	TypeCode       MessageTypeCode `json:"type,omitempty"`
+	SEID           *SEID           `json:"seid,omitempty"`
+	SequenceNumber uint32          `json:"sequence"`
+	Priority       *uint8          `json:"priority,omitempty"`
+	Ies            []IeNode        `json:"ies"`
+}
+
+// typedJson is the JSON form of the typed value of the IE, if reading it back gives the same payload,
+// which is not the case e.g. for a string IE which is not UTF-8
+func (node *IeNode) typedJson() json.RawMessage {
+	reread := NewTypedIe(node.IeTypeCode)
+	if typed, err := node.Typed(); err != nil {
+		return nil
+	} else if value, err := json.Marshal(typed); err != nil {
+		return nil
+	} else if json.Unmarshal(value, reread) != nil || !bytes.Equal(reread.Encode(), node.bytes) {
+		return nil
+	} else {
+		return value
+	}
+}
+
+func (node IeNode) MarshalJSON() ([]byte, error) {
+	form := jsonIe{TypeCode: node.IeTypeCode}
+	if node.IeTypeCode.IsVendor() {
+		form.Name = node.name()
+		form.EnterpriseId = node.enterpriseId
+	} else {
+		form.Name = IePathElement{TypeCode: node.IeTypeCode}.String()
+	}
+
+	if node.ies != nil || node.isGroup() {
+		ies := node.ies
+		if ies == nil {
+			ies = []IeNode{}
+		}
+		form.Ies = &ies
+	} else if value := node.typedJson(); value != nil {
+		form.Value = value
+	} else {
+		form.Hex = hex.EncodeToString(node.bytes)
+	}
+	return json.Marshal(form)
+}
+
+func (node *IeNode) UnmarshalJSON(data []byte) error {
+	var form jsonIe
+	if err := json.Unmarshal(data, &form); err != nil {
+		return err
+	}
+
+	tc := form.TypeCode
+	if tc != 0 {
+	} else if identified, found := ieTypeCodeByIdentifier(form.Name); found {
+		tc = identified
+	} else {
+		return fmt.Errorf("unknown IE '%s'", form.Name)
+	}
+
+	*node = IeNode{IeTypeCode: tc, enterpriseId: form.EnterpriseId}
+	if tc.IsVendor() && form.EnterpriseId == 0 {
+		return fmt.Errorf("vendor IE %d without enterprise ID", tc)
+	} else if form.Ies != nil {
+		node.ies = *form.Ies
+	} else if form.Hex != "" {
+		if payload, err := hex.DecodeString(form.Hex); err != nil {
+			return fmt.Errorf("%s: %s", tc, err.Error())
+		} else {
+			node.bytes = payload
+		}
+	} else if form.Value != nil {
+		if typed := NewTypedIe(tc); typed == nil || tc.IsVendor() {
+			return fmt.Errorf("no typed value for %s", tc)
+		} else if err := json.Unmarshal(form.Value, typed); err != nil {
+			return fmt.Errorf("%s: %s", tc, err.Error())
+		} else {
+			node.bytes = typed.Encode()
+		}
+	} else {
+		node.bytes = []byte{}
+	}
+	return nil
+}
+
+func ieTypeCodeByIdentifier(identifier string) (IeTypeCode, bool) {
+	for tc, name := range ieIdentifiers {
+		if name == identifier {
+			return tc, true
+		}
+	}
+	return 0, false
+}
+
+func (msg *PfcpMessage) MarshalJSON() ([]byte, error) {
+	ies := msg.iEnodes
+	if ies == nil {
+		ies = []IeNode{}
+	}
+	return json.Marshal(jsonMessage{
+		Name:           msg.typeName(),
+		TypeCode:       msg.MessageTypeCode,
+		SEID:           msg.SEID,
+		SequenceNumber: msg.SequenceNumber,
+		Priority:       msg.Priority,
+		Ies:            ies,
+	})
+}
+
+func (msg *PfcpMessage) UnmarshalJSON(data []byte) error {
+	var form jsonMessage
+	if err := json.Unmarshal(data, &form); err != nil {
+		return err
+	}
+
+	tc := form.TypeCode
+	if tc != 0 {
+	} else if named, found := messageTypeCodeByName(form.Name); found {
+		tc = named
+	} else {
+		return fmt.Errorf("unknown message '%s'", form.Name)
+	}
+
+	*msg = PfcpMessage{
+		rawPfcpMessageHeader: rawPfcpMessageHeader{MessageTypeCode: tc, SEID: form.SEID, SequenceNumber: form.SequenceNumber, Priority: form.Priority},
+		iEnodes:              form.Ies,
+	}
+	return nil
+}
+
+func messageTypeCodeByName(name string) (MessageTypeCode, bool) {
+	for tc, messageName := range messageNames {
+		if messageName == name {
+			return tc, true
+		}
+	}
+	return 0, false
+}
+
+// yamlForm is the JSON form as plain maps and lists, with exact 64 bit numbers
+func yamlForm(v json.Marshaler) (any, error) {
+	var form any
+	if data, err := v.MarshalJSON(); err != nil {
+		return nil, err
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(&form)
+		return yamlNumbers(form), err
+	}
+}
+
+// yamlNumbers replaces the json.Numbers, which YAML would write as strings
+func yamlNumbers(form any) any {
+	switch value := form.(type) {
+	case map[string]any:
+		for key := range value {
+			value[key] = yamlNumbers(value[key])
+		}
+	case []any:
+		for i := range value {
+			value[i] = yamlNumbers(value[i])
+		}
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		} else if u, err := strconv.ParseUint(string(value), 10, 64); err == nil {
+			return u
+		} else if f, err := value.Float64(); err == nil {
+			return f
+		}
+	}
+	return form
+}
+
+func fromYamlForm(value *yaml.Node, v json.Unmarshaler) error {
+	var form any
+	if err := value.Decode(&form); err != nil {
+		return err
+	} else if data, err := json.Marshal(form); err != nil {
+		return err
+	} else {
+		return v.UnmarshalJSON(data)
+	}
+}
+
+func (node IeNode) MarshalYAML() (any, error)             { return yamlForm(node) }
+func (node *IeNode) UnmarshalYAML(value *yaml.Node) error { return fromYamlForm(value, node) }
+
+func (msg *PfcpMessage) MarshalYAML() (any, error)            { return yamlForm(msg) }
+func (msg *PfcpMessage) UnmarshalYAML(value *yaml.Node) error { return fromYamlForm(value, msg) }
@@ -0,0 +1,113 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func jsonTestMessages() []*PfcpMessage {
+	heartbeat := NewNodeMessage(PFCP_Heartbeat_Request, IE_RecoveryTimeStamp(1), *NewIeNode(IeTypeCode(4000), []byte{1, 2, 3}), *NewIeNode(Apply_Action, []byte{0x02, 0x00, 0x00}))
+	heartbeat.SequenceNumber = 0xabcdef
+	return []*PfcpMessage{
+		ser2,
+		vendorTestMessage(*NewVendorGroupNode(testEnterprise, testVendorGroup, *NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1, 2, 3}))),
+		NewSessionMessage(PFCP_Session_Deletion_Request, 0xfedcba9876543210),
+		heartbeat,
+	}
+}
+
+func TestJsonRoundTrip(t *testing.T) {
+	for _, msg := range jsonTestMessages() {
+		raw := msg.Serialise()
+		var decoded PfcpMessage
+		if data, err := json.Marshal(msg); err != nil {
+			t.Errorf("%s: %s", msg.TypeCode(), err.Error())
+		} else if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Errorf("%s: %s in %s", msg.TypeCode(), err.Error(), data)
+		} else if !bytes.Equal(decoded.Serialise(), raw) {
+			t.Errorf("%s: JSON round trip differs\n%s", msg.TypeCode(), data)
+		}
+	}
+}
+
+func TestYamlRoundTrip(t *testing.T) {
+	for _, msg := range jsonTestMessages() {
+		raw := msg.Serialise()
+		var decoded PfcpMessage
+		if data, err := yaml.Marshal(msg); err != nil {
+			t.Errorf("%s: %s", msg.TypeCode(), err.Error())
+		} else if err := yaml.Unmarshal(data, &decoded); err != nil {
+			t.Errorf("%s: %s in %s", msg.TypeCode(), err.Error(), data)
+		} else if !bytes.Equal(decoded.Serialise(), raw) {
+			t.Errorf("%s: YAML round trip differs\n%s", msg.TypeCode(), data)
+		}
+	}
+}
+
+func TestJsonForm(t *testing.T) {
+	data, err := json.Marshal(IE_FTeid_Ip(1234, testV4, testV6))
+	if err != nil {
+		t.Fatal(err)
+	} else if !strings.Contains(string(data), `"ie":"F_TEID"`) || !strings.Contains(string(data), `"Teid":1234`) {
+		t.Errorf("F-TEID written as %s", data)
+	}
+
+	// a payload which does not decode is written in hex
+	if data, err := json.Marshal(*NewIeNode(Recovery_Time_Stamp, []byte{1, 2, 3, 4, 5})); err != nil {
+		t.Error(err)
+	} else if !strings.Contains(string(data), `"hex":"0102030405"`) {
+		t.Errorf("invalid Recovery Time Stamp written as %s", data)
+	}
+
+	fixture := `
+message: Heartbeat Request
+sequence: 7
+ies:
+  - ie: Recovery_Time_Stamp
+    value: {Value: 1}
+  - type: 4000
+    hex: "0102"
+`
+	var msg PfcpMessage
+	if err := yaml.Unmarshal([]byte(fixture), &msg); err != nil {
+		t.Fatal(err)
+	}
+	expected := NewNodeMessage(PFCP_Heartbeat_Request, IE_RecoveryTimeStamp(1), *NewIeNode(IeTypeCode(4000), []byte{1, 2}))
+	expected.SequenceNumber = 7
+	if !bytes.Equal(msg.Serialise(), expected.Serialise()) {
+		t.Errorf("fixture read as %s", msg.Dumper())
+	}
+
+	for _, invalid := range []string{`{"message":"Unknown Request"}`, `{"type":1,"ies":[{"ie":"No_Such_IE"}]}`, `{"type":1,"ies":[{"type":21,"hex":"zz"}]}`} {
+		if err := json.Unmarshal([]byte(invalid), &msg); err == nil {
+			t.Errorf("%s accepted", invalid)
+		}
+	}
+}
+
+// every payload of a typed IE, whether written as value or hex, is read back unchanged
+func TestJsonTypedIes(t *testing.T) {
+	random := rand.New(rand.NewSource(29244))
+	for tc := range typedIes {
+		for i := 0; i < 200; i++ {
+			payload := make([]byte, random.Intn(24))
+			random.Read(payload)
+			var decoded IeNode
+			if data, err := json.Marshal(*NewIeNode(tc, payload)); err != nil {
+				t.Errorf("%s: %s", tc, err.Error())
+			} else if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Errorf("%s: %s in %s", tc, err.Error(), data)
+			} else if !bytes.Equal(decoded.bytes, payload) {
+				t.Errorf("%s: % x read back as % x from %s", tc, payload, decoded.bytes, data)
+			}
+		}
+	}
+}
@@ -6,6 +6,8 @@ package pfcp
 import (
 	"encoding/binary"
 	"fmt"
+	"net/netip"
+	"strings"
 )
 
 type ieType uint8
@@ -30,60 +32,9 @@ const (
 	ieTfseid             ieType = iota
 	ieTnodeid            ieType = iota
 	ieTUPIpResInfo       ieType = iota
+	ieTsourceIpAddress   ieType = iota
 )
 
-var ieTypes = map[IeTypeCode]ieType{
-	Create_PDR:                   ieTgroup,
-	PDI:                          ieTgroup,
-	Create_FAR:                   ieTgroup,
-	Forwarding_Parameters:        ieTgroup,
-	Create_URR:                   ieTgroup,
-	Create_QER:                   ieTgroup,
-	Created_PDR:                  ieTgroup,
-	Update_FAR:                   ieTgroup,
-	Update_Forwarding_Parameters: ieTgroup,
-	// all IEs below 19 are group Ies (and a few above)
-	Cause:            ieTenum,
-	Source_Interface: ieTenumInterface, // only 4 bits used
-	F_TEID:           ieTfteid,         // IPV4/6+TEID, TEID 32 bits mandatory
-	Network_Instance: ieTapn,
-	SDF_Filter:       ieTbytes,
-	Gate_Status:      ieTbits,     // only LSB 1,3of 8 used
-	MBR:              ieTbitRates, // two 32 bit numbers
-	GBR:              ieTbitRates, // two 32 bit numbers
-	Precedence:       ieTintegral, // 32 bits
-	Volume_Threshold: ieTspecial,  // up to 3 64 bit numbers
-	// Monitoring_Time:                    "Monitoring Time",
-	// Reporting_Triggers:                 "Reporting Triggers",
-	// Report_Type:                        "Report Type",
-	Destination_Interface: ieTenumInterface, // only 4 bits used - see Source_Interface
-	// UP_Function_Features:               "UP Function Features",
-	Apply_Action: ieTApplyAction, // 11bits used
-	PDR_ID:       ieTid,          // 16 bits
-	F_SEID:       ieTfseid,       // IPV4/6+SEID, SEID 64 bits mandatory
-	Node_ID:      ieTnodeid,      // one of string or IPv4/6, IPs not strings...
-	// Measurement_Method:                 "Measurement Method",
-	// Measurement_Period:                 "Measurement Period",
-	// Usage_Report_SDR:                   "Usage Report (Session Deletion Response)",
-	// Usage_Report_SRR:                   "Usage Report (Session Report Response)",
-	URR_ID:                ieTid,                // 32 bits
-	Outer_Header_Creation: ieTOuterHeaderCreate, // many forms, GTPu TEID is our main interest, 32 bits
-	Create_BAR:            ieTgroup,
-	BAR_ID:                ieTid, // 8 bits
-	// CP_Function_Features:               "CP Function Features",
-	Recovery_Time_Stamp:                ieTintegral,    // 32 bits, seconds since 01/01/1900 00:00:00
-	UE_IP_Address:                      ieTueIpAddress, // can be ipv4 or 6, or empty, requesting them...
-	Outer_Header_Removal:               ieTenum,        // strictly not an enum, because another bit can be set...
-	FAR_ID:                             ieTid,          // 32 bits
-	QER_ID:                             ieTid,          // 32 bits
-	PDN_Type:                           ieTenum,        // 3 bits, ip4 ip6 ip4/6 eth other
-	User_Plane_IP_Resource_Information: ieTUPIpResInfo, // "User Plane IP Resource Information (rel 15 only)",
-	// QFI:                                "QFI",
-	// User_ID:                            "User ID",
-	APN_DNN:           ieTapn,
-	Source_IP_Address: ieTspecial, // can be ipv4 or 6,optionally with a mask
-}
-
 func (node IeNode) deserialiseIntegral() uint64 {
 	switch ieTypes[node.IeTypeCode] {
 	case ieTid, ieTintegral, ieTenumInterface, ieTenum:
@@ -104,6 +55,9 @@ func (node IeNode) DeserialiseEnumInterface() EnumInterface {
 }
 
 func (node IeNode) show() (s string) {
+	if node.IeTypeCode.IsVendor() {
+		return node.showVendor()
+	}
 	switch ieTypes[node.IeTypeCode] {
 	case ieTgroup,
 		ieTstring,
@@ -135,12 +89,17 @@ func (node IeNode) show() (s string) {
 		return showApplyAction(node.bytes)
 	case ieTUPIpResInfo:
 		return showUpIpResInfo(node.bytes)
+	case ieTsourceIpAddress:
+		return showSourceIpAddress(node.bytes)
 	}
 	return ""
 }
 
+// an empty payload is shown as empty, rather than as zero, so that the dump can be read back by ParseText
 func showIntegral(bytes []byte) (s string) {
-	if len(bytes) > 8 {
+	if len(bytes) == 0 {
+		return ""
+	} else if len(bytes) > 8 {
 		return showBytes(bytes)
 	} else {
 		u64 := readIntegral(bytes)
@@ -169,6 +128,9 @@ func readIntegral(bytes []byte) uint64 {
 }
 
 func showEnum(bytes []byte) string {
+	if len(bytes) == 0 {
+		return ""
+	}
 	var acc uint64
 	for i := range bytes {
 		acc = acc<<8 + uint64(bytes[i])
@@ -177,31 +139,66 @@ func showEnum(bytes []byte) string {
 }
 
 func showNodeId(bytes []byte) string {
+	if nodeId, err := getNodeId(bytes); err != nil {
+		return showBytesAsError(bytes, err.Error())
+	} else {
+		return nodeId.String()
+	}
+}
+
+// NodeId holds exactly one of an IP address (IPv4 or IPv6) or an FQDN
+type NodeId struct {
+	Addr netip.Addr
+	Fqdn string
+}
+
+func (nodeId NodeId) String() string {
+	if nodeId.Addr.IsValid() {
+		return nodeId.Addr.String()
+	} else {
+		return nodeId.Fqdn
+	}
+}
+
+func getNodeId(bytes []byte) (*NodeId, error) {
 	if len(bytes) == 0 {
-		return "invalid Node-id <nil>"
+		return nil, fmt.Errorf("invalid Node-id <nil>")
 	} else {
-		switch bytes[0] {
-		case 0:
-			return ReadIpV4(bytes[1:5]).String()
-		case 1:
-			return showBytes(bytes[1:])
-		case 2:
-			return showFQNString(bytes[1:])
+		switch bytes[0] & 0x0f {
+		case node_id_type_IPv4:
+			if len(bytes) != 1+4 {
+				return nil, fmt.Errorf("invalid Node-id IPv4 length")
+			}
+			return &NodeId{Addr: ReadIpV4(bytes[1:5])}, nil
+		case node_id_type_IPv6:
+			if len(bytes) != 1+16 {
+				return nil, fmt.Errorf("invalid Node-id IPv6 length")
+			}
+			return &NodeId{Addr: ReadIpV6(bytes[1:17])}, nil
+		case node_id_type_FQDN:
+			if len(bytes) < 2 {
+				return nil, fmt.Errorf("invalid Node-id empty FQDN")
+			}
+			return &NodeId{Fqdn: readFQDN(bytes[1:])}, nil
 		default:
-			return showBytesAsError(bytes[1:], "invalid Node-id format ID")
+			return nil, fmt.Errorf("invalid Node-id format ID")
 		}
 	}
 }
 
-func showFQNString(bytes []byte) string {
-	if int(bytes[0]) == len(bytes)-1 {
-		return string(bytes[1:])
-	} else if int(bytes[0]) < len(bytes)-1 {
-		s := string(bytes[1 : len(bytes)-1])
-		return s + string(bytes[len(bytes)-1:])
-	} else {
-		return fmt.Sprintf("apn bad name %0X", bytes)
+// readFQDN converts RFC 1035 labels to the dotted form,
+// falling back to the raw string for peers which send the FQDN unencoded
+func readFQDN(bytes []byte) string {
+	var labels []string
+	for offset := 0; offset < len(bytes); {
+		length := int(bytes[offset])
+		if offset+1+length > len(bytes) {
+			return string(bytes)
+		}
+		labels = append(labels, string(bytes[offset+1:offset+1+length]))
+		offset += 1 + length
 	}
+	return strings.Join(labels, ".")
 }
 
 func showAPNString(bytes []byte) string {
@@ -226,96 +223,361 @@ func showBitRates(bytes []byte) string {
 }
 
 func showUeIpAddress(bytes []byte) string {
-	if len(bytes) == 5 && testBit2(bytes[0]) {
-		direction := "src"
-		if testBit3(bytes[0]) {
-			direction = "dst"
-		}
-		return fmt.Sprintf("%s %s", direction, ReadIpV4(bytes[1:5]))
+	if ueIpAddress, err := getUeIpAddress(bytes); err != nil {
+		return showBytesAsError(bytes, err.Error())
 	} else {
-		return showBytesAsError(bytes, "only IPv4 is decoded")
+		return ueIpAddress.String()
 	}
 }
 
-func getUeIpAddress(bytes []byte) IpV4 {
-	if len(bytes) == 5 && testBit2(bytes[0]) && testBit3(bytes[0]) {
-		return ReadIpV4(bytes[1:5])
-	} else {
-		panic("unsupported UE IP form")
+// UeIpAddress - TS29.244 8.2.62
+// The optional prefix octets are zero when absent, zero is not a meaningful value for either of them.
+type UeIpAddress struct {
+	Destination          bool
+	IpV4, IpV6           netip.Addr
+	ChooseV4, ChooseV6   bool
+	PrefixDelegationBits uint8
+	PrefixLength         uint8
+}
+
+func NewUeIpAddress(destination bool, addrs ...netip.Addr) UeIpAddress {
+	v4, v6 := splitAddrs(addrs...)
+	return UeIpAddress{Destination: destination, IpV4: v4, IpV6: v6}
+}
+
+// Addr returns the IPv4 address if present, otherwise the IPv6 address
+func (ueIpAddress UeIpAddress) Addr() netip.Addr {
+	return preferredAddr(ueIpAddress.IpV4, ueIpAddress.IpV6)
+}
+
+func (ueIpAddress UeIpAddress) Addrs() []netip.Addr {
+	return joinAddrs(ueIpAddress.IpV4, ueIpAddress.IpV6)
+}
+
+func (ueIpAddress UeIpAddress) String() string {
+	direction := "src"
+	if ueIpAddress.Destination {
+		direction = "dst"
+	}
+	s := direction
+	if ueIpAddress.ChooseV4 {
+		s += " choose(v4)"
+	}
+	if ueIpAddress.ChooseV6 {
+		s += " choose(v6)"
+	}
+	if ueIpAddress.IpV4.IsValid() || ueIpAddress.IpV6.IsValid() {
+		s += " " + showAddrs(ueIpAddress.IpV4, ueIpAddress.IpV6)
+	}
+	if ueIpAddress.PrefixDelegationBits != 0 {
+		s += fmt.Sprintf(" delegation bits:%d", ueIpAddress.PrefixDelegationBits)
+	}
+	if ueIpAddress.PrefixLength != 0 {
+		s += fmt.Sprintf(" prefix length:%d", ueIpAddress.PrefixLength)
+	}
+	return s
+}
+
+func getUeIpAddress(bytes []byte) (*UeIpAddress, error) {
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("empty IE")
+	}
+	flags := bytes[0]
+	expected := 1
+	if flags&ueip_flag_V4 != 0 {
+		expected += 4
+	}
+	if flags&ueip_flag_V6 != 0 {
+		expected += 16
+	}
+	if flags&ueip_flag_IPv6D != 0 {
+		expected += 1
+	}
+	if flags&ueip_flag_IP6PL != 0 {
+		expected += 1
+	}
+	if len(bytes) != expected {
+		return nil, fmt.Errorf("invalid IE length")
+	}
+
+	ueIpAddress := &UeIpAddress{
+		Destination: flags&ueip_flag_SD != 0,
+		ChooseV4:    flags&ueip_flag_CHV4 != 0,
+		ChooseV6:    flags&ueip_flag_CHV6 != 0,
+	}
+	offset := 1
+	if flags&ueip_flag_V4 != 0 {
+		ueIpAddress.IpV4 = ReadIpV4(bytes[offset : offset+4])
+		offset += 4
 	}
+	if flags&ueip_flag_V6 != 0 {
+		ueIpAddress.IpV6 = ReadIpV6(bytes[offset : offset+16])
+		offset += 16
+	}
+	if flags&ueip_flag_IPv6D != 0 {
+		ueIpAddress.PrefixDelegationBits = bytes[offset]
+		offset += 1
+	}
+	if flags&ueip_flag_IP6PL != 0 {
+		ueIpAddress.PrefixLength = bytes[offset]
+	}
+	return ueIpAddress, nil
 }
 
 func showUpIpResInfo(bytes []byte) string {
-	return getUpIpResInfo(bytes).String()
+	if upIpResInfo, err := getUpIpResInfo(bytes); err != nil {
+		return showBytesAsError(bytes, err.Error())
+	} else {
+		return upIpResInfo.String()
+	}
+}
+
+// UpIpResInfo - TS29.244 8.2.82 (Release 15 only)
+type UpIpResInfo struct {
+	TeidRangeIndication uint8 // number of significant bits in TeidRange, zero when absent
+	TeidRange           uint8
+	IpV4, IpV6          netip.Addr
+	NetworkInstance     []byte
+	SourceInterface     *EnumInterface
 }
 
-// this is only a subset of the defined User Plane IP Resource Information formats,
-// but since it is used for a limited test case it is ok for now.
-func getUpIpResInfo(bytes []byte) IpV4 {
-	if len(bytes) == 5 && bytes[0] == 1 {
-		return ReadIpV4(bytes[1:5])
+func (upIpResInfo UpIpResInfo) Addrs() []netip.Addr {
+	return joinAddrs(upIpResInfo.IpV4, upIpResInfo.IpV6)
+}
+
+func (upIpResInfo UpIpResInfo) String() string {
+	s := showAddrs(upIpResInfo.IpV4, upIpResInfo.IpV6)
+	if upIpResInfo.TeidRangeIndication != 0 {
+		s += fmt.Sprintf(" teid range:%d/%d", upIpResInfo.TeidRange, upIpResInfo.TeidRangeIndication)
+	}
+	if upIpResInfo.NetworkInstance != nil {
+		s += " " + showAPNString(upIpResInfo.NetworkInstance)
+	}
+	if upIpResInfo.SourceInterface != nil {
+		s += " " + upIpResInfo.SourceInterface.String()
+	}
+	return s
+}
+
+func getUpIpResInfo(bytes []byte) (*UpIpResInfo, error) {
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("empty IE")
+	}
+	flags := bytes[0]
+	upIpResInfo := &UpIpResInfo{TeidRangeIndication: (flags & upiri_mask_TEIDRI) >> 2}
+	expected := 1
+	if upIpResInfo.TeidRangeIndication != 0 {
+		expected += 1
+	}
+	if flags&upiri_flag_V4 != 0 {
+		expected += 4
+	}
+	if flags&upiri_flag_V6 != 0 {
+		expected += 16
+	}
+	if flags&upiri_flag_ASSOSI != 0 {
+		expected += 1
+	}
+	// the network instance has no length field, it takes all of the remaining octets
+	if len(bytes) < expected || (flags&upiri_flag_ASSONI == 0 && len(bytes) != expected) {
+		return nil, fmt.Errorf("invalid IE length")
+	}
+
+	offset := 1
+	if upIpResInfo.TeidRangeIndication != 0 {
+		upIpResInfo.TeidRange = bytes[offset]
+		offset += 1
+	}
+	if flags&upiri_flag_V4 != 0 {
+		upIpResInfo.IpV4 = ReadIpV4(bytes[offset : offset+4])
+		offset += 4
+	}
+	if flags&upiri_flag_V6 != 0 {
+		upIpResInfo.IpV6 = ReadIpV6(bytes[offset : offset+16])
+		offset += 16
+	}
+	end := len(bytes)
+	if flags&upiri_flag_ASSOSI != 0 {
+		end -= 1
+		if sourceInterface, err := readEnumInterface(bytes[end:]); err != nil {
+			return nil, err
+		} else {
+			upIpResInfo.SourceInterface = &sourceInterface
+		}
+	}
+	if flags&upiri_flag_ASSONI != 0 {
+		upIpResInfo.NetworkInstance = bytes[offset:end]
+	}
+	return upIpResInfo, nil
+}
+
+func showSourceIpAddress(bytes []byte) string {
+	if sourceIpAddress, err := getSourceIpAddress(bytes); err != nil {
+		return showBytesAsError(bytes, err.Error())
 	} else {
-		panic("unsupported UP IP Res Info format")
+		return sourceIpAddress.String()
 	}
 }
 
+// SourceIpAddress - TS29.244 8.2.138
+// MaskPrefixLength is zero when absent
+type SourceIpAddress struct {
+	IpV4, IpV6       netip.Addr
+	MaskPrefixLength uint8
+}
+
+func NewSourceIpAddress(addrs ...netip.Addr) SourceIpAddress {
+	v4, v6 := splitAddrs(addrs...)
+	return SourceIpAddress{IpV4: v4, IpV6: v6}
+}
+
+func (sourceIpAddress SourceIpAddress) String() string {
+	if sourceIpAddress.MaskPrefixLength == 0 {
+		return showAddrs(sourceIpAddress.IpV4, sourceIpAddress.IpV6)
+	} else {
+		return fmt.Sprintf("%s/%d", showAddrs(sourceIpAddress.IpV4, sourceIpAddress.IpV6), sourceIpAddress.MaskPrefixLength)
+	}
+}
+
+func getSourceIpAddress(bytes []byte) (*SourceIpAddress, error) {
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("empty IE")
+	}
+	flags := bytes[0]
+	expected := 1
+	if flags&sip_flag_V4 != 0 {
+		expected += 4
+	}
+	if flags&sip_flag_V6 != 0 {
+		expected += 16
+	}
+	if flags&sip_flag_MPL != 0 {
+		expected += 1
+	}
+	if len(bytes) != expected {
+		return nil, fmt.Errorf("invalid IE length")
+	}
+	sourceIpAddress := &SourceIpAddress{}
+	offset := 1
+	if flags&sip_flag_V4 != 0 {
+		sourceIpAddress.IpV4 = ReadIpV4(bytes[offset : offset+4])
+		offset += 4
+	}
+	if flags&sip_flag_V6 != 0 {
+		sourceIpAddress.IpV6 = ReadIpV6(bytes[offset : offset+16])
+		offset += 16
+	}
+	if flags&sip_flag_MPL != 0 {
+		sourceIpAddress.MaskPrefixLength = bytes[offset]
+	}
+	return sourceIpAddress, nil
+}
+
 func showOuterHeaderCreate(bytes []byte) string {
-	// tdod - use the deserialiser after resolving error handling issues
-	if len(bytes) == 10 && bytes[0] == 1 && bytes[1] == 0 {
-		teid := readIntegral(bytes[2:6])
-		ipv4 := ReadIpV4(bytes[6:10])
-		return fmt.Sprintf("teid:%05x ipv4:%s", teid, ipv4)
+	if outerHeaderCreate, err := getOuterHeaderCreate(bytes); err != nil {
+		return showBytesAsError(bytes, err.Error())
+	} else {
+		return outerHeaderCreate.String()
 	}
-	return showBytesAsError(bytes, "only GTPu/UDP/IPV4 format decoded")
 }
 
+// OuterHeaderCreate covers only the GTP-U forms of TS29.244 8.2.56, with IPv4, IPv6 or both
 type OuterHeaderCreate struct {
-	Teid TEID
-	IpV4
+	Teid       TEID
+	IpV4, IpV6 netip.Addr
+}
+
+func (outerHeaderCreate OuterHeaderCreate) String() string {
+	s := fmt.Sprintf("teid:%05x", uint32(outerHeaderCreate.Teid))
+	if outerHeaderCreate.IpV4.IsValid() {
+		s += fmt.Sprintf(" ipv4:%s", outerHeaderCreate.IpV4)
+	}
+	if outerHeaderCreate.IpV6.IsValid() {
+		s += fmt.Sprintf(" ipv6:%s", outerHeaderCreate.IpV6)
+	}
+	return s
+}
+
+// Addr returns the IPv4 address if present, otherwise the IPv6 address
+func (outerHeaderCreate OuterHeaderCreate) Addr() netip.Addr {
+	return preferredAddr(outerHeaderCreate.IpV4, outerHeaderCreate.IpV6)
+}
+
+func (outerHeaderCreate OuterHeaderCreate) Addrs() []netip.Addr {
+	return joinAddrs(outerHeaderCreate.IpV4, outerHeaderCreate.IpV6)
 }
 
 func (OuterHeaderCreate OuterHeaderCreate) FTeid() FTeid {
 	return FTeid{
 		Teid: &OuterHeaderCreate.Teid,
-		IpV4: &OuterHeaderCreate.IpV4,
+		IpV4: OuterHeaderCreate.IpV4,
+		IpV6: OuterHeaderCreate.IpV6,
 	}
 }
 
 func (FTeid FTeid) OuterHeaderCreate() (OuterHeaderCreate, error) {
-	if FTeid.IpV4 == nil {
-		return OuterHeaderCreate{}, fmt.Errorf("missing IpV4 in FTeid -> OuterHeaderCreate")
+	if !FTeid.IpV4.IsValid() && !FTeid.IpV6.IsValid() {
+		return OuterHeaderCreate{}, fmt.Errorf("missing IP address in FTeid -> OuterHeaderCreate")
 	} else if FTeid.Teid == nil {
 		return OuterHeaderCreate{}, fmt.Errorf("missing Teid in FTeid -> OuterHeaderCreate")
 	} else {
 		return OuterHeaderCreate{
 			Teid: *FTeid.Teid,
-			IpV4: *FTeid.IpV4,
+			IpV4: FTeid.IpV4,
+			IpV6: FTeid.IpV6,
 		}, nil
 	}
 }
 
-func getOuterHeaderCreate(bytes []byte) (t OuterHeaderCreate) {
-	if len(bytes) == 10 && bytes[0] == 1 && bytes[1] == 0 {
-		t.Teid = TEID(binary.BigEndian.Uint32(bytes[2:6]))
-		t.IpV4 = ReadIpV4(bytes[6:10])
+func getOuterHeaderCreate(bytes []byte) (*OuterHeaderCreate, error) {
+	const gtpuForms = outer_header_flag_GTPU_UDP_IPv4 | outer_header_flag_GTPU_UDP_IPv6
+	if len(bytes) < 2 {
+		return nil, fmt.Errorf("IE too short")
+	} else if description := bytes[0]; description&gtpuForms == 0 || description&^gtpuForms != 0 {
+		return nil, fmt.Errorf("only GTPu/UDP/IPv4 and GTPu/UDP/IPv6 formats decoded")
+	} else if bytes[1] != 0 {
+		// the second octet of the description holds the N19 and N6 indications
+		return nil, fmt.Errorf("N19 and N6 indications not decoded")
 	} else {
-		panic("invalid IE format")
+		expected := 2 + 4
+		if description&outer_header_flag_GTPU_UDP_IPv4 != 0 {
+			expected += 4
+		}
+		if description&outer_header_flag_GTPU_UDP_IPv6 != 0 {
+			expected += 16
+		}
+		if len(bytes) != expected {
+			return nil, fmt.Errorf("invalid IE length")
+		}
+		outerHeaderCreate := &OuterHeaderCreate{Teid: TEID(binary.BigEndian.Uint32(bytes[2:6]))}
+		offset := 6
+		if description&outer_header_flag_GTPU_UDP_IPv4 != 0 {
+			outerHeaderCreate.IpV4 = ReadIpV4(bytes[offset : offset+4])
+			offset += 4
+		}
+		if description&outer_header_flag_GTPU_UDP_IPv6 != 0 {
+			outerHeaderCreate.IpV6 = ReadIpV6(bytes[offset : offset+16])
+		}
+		return outerHeaderCreate, nil
 	}
-	return
 }
 
-// for now FTEID is not capable of expressing 'choose IPv6'
+// FTeid - TS29.244 8.2.3
+// When Teid is nil the FTEID is the 'choose' form, and only the Choose fields are meaningful,
+// otherwise one or both of the addresses is valid.
 type FTeid struct {
-	Teid *TEID
-	IpV4 *IpV4
+	Teid               *TEID
+	IpV4, IpV6         netip.Addr
+	ChooseV4, ChooseV6 bool
+	ChooseId           *uint8
 }
 
 func (a FTeid) Eq(b FTeid) bool {
 	teidEq := (a.Teid == nil && b.Teid == nil) || ((a.Teid != nil && b.Teid != nil) && *a.Teid == *b.Teid)
 
-	ipv4Eq := (a.IpV4 == nil && b.IpV4 == nil) || ((a.IpV4 != nil && b.IpV4 != nil) && *a.IpV4 == *b.IpV4)
+	chooseIdEq := (a.ChooseId == nil && b.ChooseId == nil) || ((a.ChooseId != nil && b.ChooseId != nil) && *a.ChooseId == *b.ChooseId)
 
-	return teidEq && ipv4Eq
+	return teidEq && chooseIdEq && a.IpV4 == b.IpV4 && a.IpV6 == b.IpV6 && a.ChooseV4 == b.ChooseV4 && a.ChooseV6 == b.ChooseV6
 }
 
 func (a *FTeid) NextTeid() {
@@ -326,24 +588,46 @@ func (a *FTeid) NextTeid() {
 	}
 }
 
-func NewFTeid(Teid TEID, IpV4 IpV4) *FTeid {
+// NewFTeid accepts an IPv4 address, an IPv6 address, or one of each (dual-stack)
+func NewFTeid(Teid TEID, addrs ...netip.Addr) *FTeid {
+	v4, v6 := splitAddrs(addrs...)
 	return &FTeid{
 		Teid: &Teid,
-		IpV4: &IpV4,
+		IpV4: v4,
+		IpV6: v6,
 	}
 }
 
+// Addr returns the IPv4 address if present, otherwise the IPv6 address
+func (FTeid FTeid) Addr() netip.Addr {
+	return preferredAddr(FTeid.IpV4, FTeid.IpV6)
+}
+
+func (FTeid FTeid) Addrs() []netip.Addr {
+	return joinAddrs(FTeid.IpV4, FTeid.IpV6)
+}
+
 func (FTeid FTeid) String() string {
-	// TDOD probably needs changing since some options are not valid....
+	hasAddr := FTeid.IpV4.IsValid() || FTeid.IpV6.IsValid()
 	switch {
-	case FTeid.IpV4 == nil && FTeid.Teid == nil:
-		return ("choose")
+	case FTeid.Teid == nil && !hasAddr:
+		s := "choose"
+		if FTeid.ChooseV4 {
+			s += "(v4)"
+		}
+		if FTeid.ChooseV6 {
+			s += "(v6)"
+		}
+		if FTeid.ChooseId != nil {
+			s += fmt.Sprintf(" id:%d", *FTeid.ChooseId)
+		}
+		return s
 	case FTeid.Teid == nil:
-		return FTeid.IpV4.String()
-	case FTeid.IpV4 == nil:
+		return showAddrs(FTeid.IpV4, FTeid.IpV6)
+	case !hasAddr:
 		return fmt.Sprintf("%d", *FTeid.Teid)
 	default:
-		return fmt.Sprintf("%s:%d", FTeid.IpV4, *FTeid.Teid)
+		return fmt.Sprintf("%s:%d", showAddrs(FTeid.IpV4, FTeid.IpV6), *FTeid.Teid)
 	}
 }
 
@@ -357,31 +641,73 @@ func showFTeid(bytes []byte) string {
 
 func getFteid(bytes []byte) (t *FTeid, err error) {
 	if len(bytes) == 0 {
-		err = fmt.Errorf("empty IE")
-	} else if len(bytes) == 1 && bytes[0] == fteid_flag_V4|fteid_flag_CH {
-		return &FTeid{Teid: nil, IpV4: nil}, nil // for now FTEID is not capable of expressing 'choose IPv6'
-	} else if len(bytes) == 2 && bytes[0] == fteid_flag_CHID|fteid_flag_V4|fteid_flag_CH {
-		return &FTeid{Teid: nil, IpV4: nil}, nil
-	} else if len(bytes) == 9 && bytes[0] == fteid_flag_V4 {
-		return NewFTeid(TEID(binary.BigEndian.Uint32(bytes[1:5])), ReadIpV4(bytes[5:9])), nil
-	} else {
-		err = fmt.Errorf("invalid IE format")
+		return nil, fmt.Errorf("empty IE")
 	}
-	return
+
+	flags := bytes[0]
+	if flags&fteid_flag_CH != 0 {
+		t = &FTeid{
+			ChooseV4: flags&fteid_flag_V4 != 0,
+			ChooseV6: flags&fteid_flag_V6 != 0,
+		}
+		if flags&fteid_flag_CHID == 0 && len(bytes) == 1 {
+			return t, nil
+		} else if flags&fteid_flag_CHID != 0 && len(bytes) == 2 {
+			chooseId := bytes[1]
+			t.ChooseId = &chooseId
+			return t, nil
+		} else {
+			return nil, fmt.Errorf("invalid IE format")
+		}
+	} else if flags&(fteid_flag_V4|fteid_flag_V6) == 0 || flags&fteid_flag_CHID != 0 {
+		return nil, fmt.Errorf("invalid IE format")
+	}
+
+	expected := 1 + 4
+	if flags&fteid_flag_V4 != 0 {
+		expected += 4
+	}
+	if flags&fteid_flag_V6 != 0 {
+		expected += 16
+	}
+	if len(bytes) != expected {
+		return nil, fmt.Errorf("invalid IE length")
+	}
+
+	teid := TEID(binary.BigEndian.Uint32(bytes[1:5]))
+	t = &FTeid{Teid: &teid}
+	offset := 5
+	if flags&fteid_flag_V4 != 0 {
+		t.IpV4 = ReadIpV4(bytes[offset : offset+4])
+		offset += 4
+	}
+	if flags&fteid_flag_V6 != 0 {
+		t.IpV6 = ReadIpV6(bytes[offset : offset+16])
+	}
+	return t, nil
 }
 
 // ******************************************************
 
 type FSeid struct {
-	Seid SEID
-	IpV4 *IpV4
+	Seid       SEID
+	IpV4, IpV6 netip.Addr
+}
+
+// Addr returns the IPv4 address if present, otherwise the IPv6 address
+func (FSeid FSeid) Addr() netip.Addr {
+	return preferredAddr(FSeid.IpV4, FSeid.IpV6)
+}
+
+func (FSeid FSeid) Addrs() []netip.Addr {
+	return joinAddrs(FSeid.IpV4, FSeid.IpV6)
 }
 
 func (FSeid FSeid) String() string {
-	if FSeid.IpV4 == nil {
+	if !FSeid.IpV4.IsValid() && !FSeid.IpV6.IsValid() {
 		return fmt.Sprintf("%010d", FSeid.Seid)
 	} else {
-		return fmt.Sprintf("%010d : %s", FSeid.Seid, FSeid.IpV4)
+		return fmt.Sprintf("%010d : %s", FSeid.Seid, showAddrs(FSeid.IpV4, FSeid.IpV6))
 	}
 }
 
@@ -397,22 +723,28 @@ func getFSeid(bytes []byte) (*FSeid, error) {
 	if len(bytes) < 9 {
 		return nil, fmt.Errorf("FSEID too short")
 	} else {
-		seid := SEID(binary.BigEndian.Uint64(bytes[1:9]))
-
-		if bytes[0] == 0b00000000 {
-			return &FSeid{
-				Seid: seid,
-				IpV4: nil,
-			}, nil
-		} else if len(bytes) == 13 && bytes[0] == 0b00000010 {
-			ipv4 := ReadIpV4(bytes[9:13])
-			return &FSeid{
-				Seid: seid,
-				IpV4: &ipv4,
-			}, nil
-		} else {
+		flags := bytes[0]
+		expected := 1 + 8
+		if flags&fseid_flag_V4 != 0 {
+			expected += 4
+		}
+		if flags&fseid_flag_V6 != 0 {
+			expected += 16
+		}
+		if len(bytes) != expected {
 			return nil, fmt.Errorf("invalid IE format")
 		}
+
+		fseid := &FSeid{Seid: SEID(binary.BigEndian.Uint64(bytes[1:9]))}
+		offset := 9
+		if flags&fseid_flag_V4 != 0 {
+			fseid.IpV4 = ReadIpV4(bytes[offset : offset+4])
+			offset += 4
+		}
+		if flags&fseid_flag_V6 != 0 {
+			fseid.IpV6 = ReadIpV6(bytes[offset : offset+16])
+		}
+		return fseid, nil
 	}
 }
 
@@ -0,0 +1,76 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"net/netip"
+)
+
+// All address bearing IEs use netip.Addr in their decoded form.
+// An absent address is the zero value of netip.Addr, so callers test presence with IsValid().
+
+func ReadIpV4(bytes []byte) netip.Addr {
+	if len(bytes) != 4 {
+		panic("IpV4 must have length 4")
+	} else {
+		return netip.AddrFrom4([4]byte(bytes))
+	}
+}
+
+func ReadIpV6(bytes []byte) netip.Addr {
+	if len(bytes) != 16 {
+		panic("IpV6 must have length 16")
+	} else {
+		return netip.AddrFrom16([16]byte(bytes))
+	}
+}
+
+// splitAddrs sorts a list of addresses into (at most) one IPv4 and one IPv6 address.
+// It is the common way for the encoders to accept any of IPv4, IPv6 or dual-stack parameters.
+// IPv4-mapped IPv6 addresses are treated as IPv4.
+func splitAddrs(addrs ...netip.Addr) (v4, v6 netip.Addr) {
+	for _, addr := range addrs {
+		switch {
+		case !addr.IsValid():
+		case addr.Unmap().Is4():
+			v4 = addr.Unmap()
+		default:
+			v6 = addr
+		}
+	}
+	return
+}
+
+// joinAddrs is the inverse of splitAddrs, it returns only the valid addresses, IPv4 first.
+func joinAddrs(v4, v6 netip.Addr) (addrs []netip.Addr) {
+	if v4.IsValid() {
+		addrs = append(addrs, v4)
+	}
+	if v6.IsValid() {
+		addrs = append(addrs, v6)
+	}
+	return
+}
+
+// preferredAddr returns the IPv4 address when present, otherwise the IPv6 address, which may also be invalid.
+func preferredAddr(v4, v6 netip.Addr) netip.Addr {
+	if v4.IsValid() {
+		return v4
+	} else {
+		return v6
+	}
+}
+
+func showAddrs(v4, v6 netip.Addr) string {
+	switch {
+	case v4.IsValid() && v6.IsValid():
+		return v4.String() + "," + v6.String()
+	case v4.IsValid():
+		return v4.String()
+	case v6.IsValid():
+		return v6.String()
+	default:
+		return "<no address>"
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"net/netip"
+	"testing"
+)
+
+var (
+	testV4 = netip.MustParseAddr("192.0.2.1")
+	testV6 = netip.MustParseAddr("2001:db8::1")
+
+	addressForms = map[string][]netip.Addr{
+		"ipv4":       {testV4},
+		"ipv6":       {testV6},
+		"dual-stack": {testV4, testV6},
+	}
+)
+
+func wantAddrs(t *testing.T, form string, v4, v6 netip.Addr, addrs []netip.Addr) {
+	wantV4, wantV6 := splitAddrs(addrs...)
+	if v4 != wantV4 || v6 != wantV6 {
+		t.Errorf("%s: got %s %s, want %s %s", form, v4, v6, wantV4, wantV6)
+	}
+}
+
+func TestFTeidAddressForms(t *testing.T) {
+	for form, addrs := range addressForms {
+		if fteid, err := getFteid(Encode_FTeid_Ip(1234, addrs...)); err != nil {
+			t.Errorf("%s: %s", form, err.Error())
+		} else if *fteid.Teid != 1234 {
+			t.Errorf("%s: wrong TEID %d", form, *fteid.Teid)
+		} else {
+			wantAddrs(t, form, fteid.IpV4, fteid.IpV6, addrs)
+		}
+	}
+
+	chooseId := uint8(7)
+	for _, choose := range []FTeid{{ChooseV4: true}, {ChooseV6: true}, {ChooseV4: true, ChooseV6: true, ChooseId: &chooseId}} {
+		if fteid, err := getFteid(Encode_FTeid(choose)); err != nil {
+			t.Errorf("choose %s: %s", choose, err.Error())
+		} else if !fteid.Eq(choose) {
+			t.Errorf("choose: got %s, want %s", fteid, choose)
+		}
+	}
+
+	if _, err := getFteid([]byte{fteid_flag_V6, 0, 0, 0, 1, 192, 0, 2, 1}); err == nil {
+		t.Errorf("IPv6 FTEID with IPv4 length accepted")
+	}
+}
+
+func TestFSeidAddressForms(t *testing.T) {
+	for form, addrs := range addressForms {
+		if fseid, err := getFSeid(Encode_FSeid(42, addrs...)); err != nil {
+			t.Errorf("%s: %s", form, err.Error())
+		} else if fseid.Seid != 42 {
+			t.Errorf("%s: wrong SEID %d", form, fseid.Seid)
+		} else {
+			wantAddrs(t, form, fseid.IpV4, fseid.IpV6, addrs)
+		}
+	}
+}
+
+func TestOuterHeaderCreationAddressForms(t *testing.T) {
+	for form, addrs := range addressForms {
+		if ohc, err := getOuterHeaderCreate(Encode_OuterHeaderCreation(99, addrs...)); err != nil {
+			t.Errorf("%s: %s", form, err.Error())
+		} else if ohc.Teid != 99 {
+			t.Errorf("%s: wrong TEID %d", form, ohc.Teid)
+		} else if fteid, err := ohc.FTeid().OuterHeaderCreate(); err != nil || fteid != *ohc {
+			t.Errorf("%s: FTeid conversion is not reversible", form)
+		} else {
+			wantAddrs(t, form, ohc.IpV4, ohc.IpV6, addrs)
+		}
+	}
+	n19 := Encode_OuterHeaderCreation(99, netip.MustParseAddr("192.0.2.1"))
+	n19[1] = 0x01
+	if _, err := getOuterHeaderCreate(n19); err == nil {
+		t.Errorf("N19 indication accepted")
+	}
+	if _, err := NewIeNode(Outer_Header_Creation, []byte{0x01}).Getter().DeserialiseOuterHeader(); err == nil {
+		t.Errorf("short Outer Header Creation accepted")
+	}
+	if _, err := NewIeNode(UE_IP_Address, nil).Getter().DeserialiseUeIPAddress(); err == nil {
+		t.Errorf("empty UE IP Address accepted")
+	}
+}
+
+func TestUeIpAddressForms(t *testing.T) {
+	for form, addrs := range addressForms {
+		if ueIpAddress, err := getUeIpAddress(Encode_UeIpAddress(NewUeIpAddress(true, addrs...))); err != nil {
+			t.Errorf("%s: %s", form, err.Error())
+		} else if !ueIpAddress.Destination {
+			t.Errorf("%s: lost destination flag", form)
+		} else {
+			wantAddrs(t, form, ueIpAddress.IpV4, ueIpAddress.IpV6, addrs)
+		}
+	}
+
+	delegated := UeIpAddress{IpV6: testV6, PrefixDelegationBits: 8, PrefixLength: 56}
+	if ueIpAddress, err := getUeIpAddress(Encode_UeIpAddress(delegated)); err != nil {
+		t.Error(err)
+	} else if *ueIpAddress != delegated {
+		t.Errorf("got %s, want %s", ueIpAddress, delegated)
+	}
+}
+
+func TestNodeIdAddressForms(t *testing.T) {
+	for _, addr := range []netip.Addr{testV4, testV6} {
+		if nodeId, err := getNodeId(Encode_NodeIdIp(addr)); err != nil {
+			t.Error(err)
+		} else if nodeId.Addr != addr {
+			t.Errorf("got %s, want %s", nodeId.Addr, addr)
+		}
+	}
+}
+
+func TestUpIpResInfoAndSourceIpAddressForms(t *testing.T) {
+	for form, addrs := range addressForms {
+		if upIpResInfo, err := getUpIpResInfo(Encode_UserPlaneIpResourceInformation(addrs...)); err != nil {
+			t.Errorf("%s: %s", form, err.Error())
+		} else {
+			wantAddrs(t, form, upIpResInfo.IpV4, upIpResInfo.IpV6, addrs)
+		}
+		if sourceIpAddress, err := getSourceIpAddress(Encode_SourceIpAddress(NewSourceIpAddress(addrs...))); err != nil {
+			t.Errorf("%s: %s", form, err.Error())
+		} else {
+			wantAddrs(t, form, sourceIpAddress.IpV4, sourceIpAddress.IpV6, addrs)
+		}
+	}
+}
+
+func TestDualStackSessionEstablishment(t *testing.T) {
+	ser := NewSessionMessage(
+		PFCP_Session_Establishment_Request,
+		1234,
+		IE_NodeIdIp(testV6),
+		IE_FSeid(77, testV4, testV6),
+		IE_CreatePdr(
+			IE_PdrId(1),
+			IE_Pdi(
+				IE_SourceInterface(EnumAccess),
+				IE_FTeid_Ip(1000, testV4, testV6),
+				IE_UeIpAddress(testV6),
+			),
+			IE_FarId(1),
+		),
+		IE_CreateFar(
+			IE_FarId(1),
+			IE_ApplyAction(EnumForw),
+			IE_ForwardingParameters(
+				IE_DestinationInterface(EnumCore),
+				IE_OuterHeaderCreation(2000, testV6),
+			),
+		),
+	)
+	bytes := ser.Serialise()
+	if msg, err := ParseValidate(bytes); err != nil {
+		t.Fatal(err)
+	} else if err := ReserialiseCheck(msg, bytes); err != nil {
+		t.Fatal(err)
+	} else if fteid, err := msg.Node().Getter().GetById(Create_PDR, 1).GetByTc(PDI).GetByTc(F_TEID).DeserialiseFTeid(); err != nil {
+		t.Fatal(err)
+	} else if fteid.IpV6 != testV6 || fteid.IpV4 != testV4 {
+		t.Errorf("dual-stack FTEID decoded as %s", fteid)
+	} else if nodeId, err := msg.Node().Getter().GetByTc(Node_ID).DeserialiseNodeIdString(); err != nil || nodeId != testV6.String() {
+		t.Errorf("IPv6 node ID decoded as %s", nodeId)
+	}
+}
@@ -9,8 +9,6 @@ import (
 
 type actionType uint8
 
-var nullIe IeNode = IeNode{}
-
 const (
 	invalidAction actionType = iota
 	insertAction  actionType = iota
@@ -27,28 +25,31 @@ var actionNames = map[actionType]string{
 
 func (action actionType) String() string { return actionNames[action] }
 
+// Merge returns the session state target with the update, e.g. a Session Modification Request, applied.
+// The result is a new message with the header of target, neither target nor update is changed,
+// so that target remains available e.g. to roll back a modification which is refused later.
 func (target *PfcpMessage) Merge(update *PfcpMessage) (*PfcpMessage, error) {
 	// for now assume that the Update message is Session Update (not, e.g., Association Update)
 
 	sessionModificationAttributeSet := MessageIeAttributeSets[PFCP_Session_Modification_Request]
 
-	if err := MergeIes(&target.iEnodes, &update.iEnodes, sessionModificationAttributeSet); err != nil {
+	// MergeIes changes both IE sets in place
+	merged := target.Clone()
+	updateIes := cloneIes(update.iEnodes)
+	if err := MergeIes(&merged.iEnodes, &updateIes, sessionModificationAttributeSet); err != nil {
 		return nil, fmt.Errorf("Merge failed %s", err.Error())
 	}
-	seid := SEID(42)
-
-	return &PfcpMessage{
-		rawPfcpMessageHeader: rawPfcpMessageHeader{
-			MessageTypeCode: PFCP_Session_Establishment_Request,
-			SEID:            &seid,
-			SequenceNumber:  42,
-			Priority:        nil,
-		},
-		iEnodes: target.iEnodes,
-	}, nil
+	return merged, nil
 }
 
+// MergeIes applies the update IEs, e.g. those of a Session Modification Request, to the target IEs.
+// An update IE marked update= in the attribute set is merged recursively into the target IE with the same ID,
+// and one marked delete= removes it. Other IEs replace the target IE, or are added.
+// A multiple IE without an ID, e.g. the QER IDs of Update PDR, is a list which replaces the target list as a whole,
+// and so is an IE which is not in the attribute set, as it may be repeated. Transient IEs, e.g. Query URR, are not applied.
 func MergeIes(target, update *[]IeNode, attributeSet groupIeAttributeSet) error {
+	replaceLists(target, *update, attributeSet)
+
 	// for now assume that the Update message is Session Update (not, e.g., Association Update)
 	// Note - the clumsy multiple usages of '(*updatePtr)[i]' are needed to describe update-in-place of the structure
 label1:
@@ -58,7 +59,9 @@ label1:
 		action := insertAction
 		var targetID *IeID
 		ieAttributes, found := attributeSet[typeCode]
-		if found {
+		if found && ieAttributes.transient {
+			continue
+		} else if found {
 			if ieAttributes.isDelete || ieAttributes.isUpdate {
 				targetIeCode = ieAttributes.baseIe
 			}
@@ -67,26 +70,32 @@ label1:
 			} else if ieAttributes.isUpdate {
 				action = updateAction
 			}
-			if ieAttributes.multiple {
+			if ieAttributes.multiple && !(*update)[i].isGroup() {
+				// a list member, the target list has already been removed
+				*target = append(*target, (*update)[i])
+				continue
+			} else if ieAttributes.multiple {
 				if (*update)[i].IeID == nil {
 					return fmt.Errorf(("no ID found for multiple IE type"))
 				} else {
 					targetID = (*update)[i].IeID
 				}
 			}
-
-			// either a simple IE, or a an unknown or unmarked group IE.
-			// it could still be a delete over a simple IE, if empty
 		} else if (len((*update)[i].bytes) == 0) && (len((*update)[i].ies) == 0) {
-			action = deleteAction
+			// an IE not in the attribute set, if empty it deletes the list, which has already been removed
+			continue
+		} else {
+			// an IE not in the attribute set is a list member too
+			*target = append(*target, (*update)[i])
+			continue
 		}
 
 		for j := range *target {
-			if (*target)[j].IeTypeCode == targetIeCode && (targetID == nil || *targetID == *(*target)[j].IeID) {
+			if (*target)[j].IeTypeCode == targetIeCode && (*target)[j].enterpriseId == (*update)[i].enterpriseId && (targetID == nil || ((*target)[j].IeID != nil && *targetID == *(*target)[j].IeID)) {
 
 				switch action {
 				case updateAction: // this is the recursive case
-					if nextAttributeSet, found := groupIeAttributeSets[typeCode]; found {
+					if nextAttributeSet, found := (*update)[i].attributeSet(); found {
 						if err := MergeIes(&((*target)[j].ies), &((*update)[i].ies), nextAttributeSet); err != nil {
 							return err
 						}
@@ -94,7 +103,8 @@ label1:
 						return fmt.Errorf("attribute set not found for: %s", typeCode)
 					}
 				case deleteAction:
-					(*target)[j] = nullIe // note: requires nullIe to be removed on serialization and otherwise ignored during general processing
+					// the list is compacted, safe as the loop over the target ends here
+					*target = append((*target)[:j], (*target)[j+1:]...)
 				case insertAction: // this is actually replace when the target exists
 					(*target)[j] = (*update)[i]
 				}
@@ -114,3 +124,23 @@ label1:
 	}
 	return nil
 }
+
+// replaceLists removes the target IEs of every list which is present in the update,
+// the IEs not in the attribute set are lists too, for each enterprise in the case of vendor IEs
+func replaceLists(target *[]IeNode, update []IeNode, attributeSet groupIeAttributeSet) {
+	lists := map[vendorIeKey]struct{}{}
+	for i := range update {
+		if attributes, found := attributeSet[update[i].IeTypeCode]; !found || attributes.multiple && !update[i].isGroup() {
+			lists[vendorIeKey{update[i].enterpriseId, update[i].IeTypeCode}] = struct{}{}
+		}
+	}
+	if len(lists) > 0 {
+		kept := (*target)[:0]
+		for _, node := range *target {
+			if _, found := lists[vendorIeKey{node.enterpriseId, node.IeTypeCode}]; !found {
+				kept = append(kept, node)
+			}
+		}
+		*target = kept
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"testing"
+)
+
+func mergeTestMessages(t *testing.T) (ser, smr *PfcpMessage) {
+	ser = NewSessionMessage(PFCP_Session_Establishment_Request, 1,
+		IE_NodeIdFqdn("smf"),
+		IE_FSeid(1, testV4),
+		IE_CreatePdr(IE_PdrId(1), IE_Precedence(10), IE_Pdi(IE_SourceInterface(EnumAccess)), IE_FarId(1), IE_QerId(1), IE_QerId(2), IE_UrrId(1)),
+		IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumDrop)),
+		IE_CreateQer(IE_QerId(1), IE_GateStatus(GateOpen)),
+		IE_CreateQer(IE_QerId(2), IE_GateStatus(GateOpen)),
+		IE_CreateUrr(IE_UrrId(1)),
+		IE_CreateUrr(IE_UrrId(2)),
+		IE_CreateBar(IE_BarId(1), *NewIeNode(Downlink_Data_Notification_Delay, []byte{1})),
+	)
+	smr = NewSessionMessage(PFCP_Session_Modification_Request, 1,
+		IE_UpdatePdr(IE_PdrId(1), IE_Precedence(20), IE_QerId(3)),
+		IE_UpdateQer(IE_QerId(1), IE_GateStatus(GateStatus{UlGate: EnumClosed, DlGate: EnumClosed})),
+		IE_RemoveQer(IE_QerId(2)),
+		IE_CreateQer(IE_QerId(3), IE_GateStatus(GateOpen)),
+		IE_UpdateUrr(IE_UrrId(1), *NewIeNode(Measurement_Period, Encode_Uint32(60))),
+		IE_RemoveUrr(IE_UrrId(2)),
+		IE_UpdateBar(IE_BarId(1), *NewIeNode(Downlink_Data_Notification_Delay, []byte{2})),
+	)
+	for _, msg := range []*PfcpMessage{ser, smr} {
+		if err := msg.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return
+}
+
+func TestMergeSessionModification(t *testing.T) {
+	ser, smr := mergeTestMessages(t)
+	merged, err := ser.Merge(smr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := merged.Node().Getter()
+
+	var precedence T_Precedence
+	if err := root.GetById(Create_PDR, 1).GetByTc(Precedence).DecodeInto(&precedence); err != nil || precedence.Value != 20 {
+		t.Errorf("PDR precedence not updated")
+	}
+	// the QER ID list of the PDR is replaced, the URR ID list is kept
+	var qerIds []IeNode
+	for _, ie := range *root.GetById(Create_PDR, 1).Inner().Ies() {
+		if ie.IeTypeCode == QER_ID {
+			qerIds = append(qerIds, ie)
+		}
+	}
+	if len(qerIds) != 1 || qerIds[0].deserialiseUint() != 3 {
+		t.Errorf("PDR QER IDs not replaced: %v", qerIds)
+	}
+	if _, err := root.GetById(Create_PDR, 1).GetByTc(URR_ID).Return(); err != nil {
+		t.Errorf("PDR URR ID removed")
+	}
+
+	if gateStatus, err := root.GetById(Create_QER, 1).GetByTc(Gate_Status).Return(); err != nil || gateStatus.bytes[0] == 0 {
+		t.Errorf("QER gate status not updated")
+	}
+	if _, err := root.GetById(Create_QER, 2).Return(); err == nil {
+		t.Errorf("QER 2 not removed")
+	}
+	if _, err := root.GetById(Create_QER, 3).Return(); err != nil {
+		t.Errorf("QER 3 not created")
+	}
+	if _, err := root.GetById(Create_URR, 1).GetByTc(Measurement_Period).Return(); err != nil {
+		t.Errorf("URR measurement period not updated")
+	}
+	if _, err := root.GetById(Create_URR, 2).Return(); err == nil {
+		t.Errorf("URR 2 not removed")
+	}
+	if delay, err := root.GetById(Create_BAR, 1).GetByTc(Downlink_Data_Notification_Delay).Return(); err != nil || delay.bytes[0] != 2 {
+		t.Errorf("BAR not updated")
+	}
+}
+
+func TestMergeRemoveBar(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	smr := NewSessionMessage(PFCP_Session_Modification_Request, 1, IE_RemoveBar(IE_BarId(1)))
+	if err := smr.Validate(); err != nil {
+		t.Fatal(err)
+	} else if merged, err := ser.Merge(smr); err != nil {
+		t.Fatal(err)
+	} else if _, err := merged.Node().Getter().GetById(Create_BAR, 1).Return(); err == nil {
+		t.Errorf("BAR not removed")
+	}
+}
+
+// query IEs only ask for a report, and are not merged into the session
+func TestMergeTransient(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	smr := NewSessionMessage(PFCP_Session_Modification_Request, 1, *NewGroupNode(Query_URR, IE_UrrId(1)), *NewIeNode(Query_URR_Reference, Encode_Uint32(1)))
+	if err := smr.Validate(); err != nil {
+		t.Fatal(err)
+	} else if merged, err := ser.Merge(smr); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(merged.Serialise(), ser.Serialise()) {
+		t.Errorf("query merged\n%s", merged.Dumper())
+	}
+}
+
+func TestMergeTrafficEndpoint(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	create := NewSessionMessage(PFCP_Session_Modification_Request, 1, *NewGroupNode(Create_Traffic_Endpoint, *NewIeNode(Traffic_Endpoint_ID, []byte{1}), IE_NetworkInstance("access")))
+	update := NewSessionMessage(PFCP_Session_Modification_Request, 1, *NewGroupNode(Update_Traffic_Endpoint, *NewIeNode(Traffic_Endpoint_ID, []byte{1}), IE_NetworkInstance("core")))
+	remove := NewSessionMessage(PFCP_Session_Modification_Request, 1, *NewGroupNode(Remove_Traffic_Endpoint, *NewIeNode(Traffic_Endpoint_ID, []byte{1})))
+
+	for _, smr := range []*PfcpMessage{create, update, remove} {
+		if err := smr.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var err error
+	for _, smr := range []*PfcpMessage{create, update} {
+		if ser, err = ser.Merge(smr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if networkInstance, err := ser.Node().Getter().GetById(Create_Traffic_Endpoint, 1).GetByTc(Network_Instance).Return(); err != nil || !bytes.Equal(networkInstance.bytes, IE_NetworkInstance("core").bytes) {
+		t.Errorf("traffic endpoint not updated\n%s", ser.Dumper())
+	}
+	if ser, err = ser.Merge(remove); err != nil {
+		t.Fatal(err)
+	} else if _, err := ser.Node().Getter().GetById(Create_Traffic_Endpoint, 1).Return(); err == nil {
+		t.Errorf("traffic endpoint not removed")
+	}
+}
+
+// repeated IEs outside the grammar are replaced as a list, and an empty IE removes the list
+func TestMergeUnlistedList(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	vendorIes := func(payloads ...byte) []IeNode {
+		var ies []IeNode
+		for _, payload := range payloads {
+			ies = append(ies, *NewVendorIeNode(4711, testVendorUnknown, []byte{payload}))
+		}
+		return ies
+	}
+	count := func(message *PfcpMessage) (n int) {
+		for _, ie := range message.iEnodes {
+			if ie.IeTypeCode == testVendorUnknown {
+				n++
+			}
+		}
+		return n
+	}
+
+	var err error
+	for _, test := range []struct {
+		ies   []IeNode
+		count int
+	}{
+		{vendorIes(1, 2, 3), 3},
+		{vendorIes(4, 5), 2},
+		{append(vendorIes(6), *NewVendorIeNode(4711, testVendorUnknown, []byte{})), 1},
+		{[]IeNode{*NewVendorIeNode(4711, testVendorUnknown, []byte{})}, 0},
+	} {
+		if ser, err = ser.Merge(NewSessionMessage(PFCP_Session_Modification_Request, 1, test.ies...)); err != nil {
+			t.Fatal(err)
+		} else if n := count(ser); n != test.count {
+			t.Errorf("%d IEs merged, expected %d\n%s", n, test.count, ser.Dumper())
+		}
+	}
+}
+
+// deleted IEs are removed from the list, so repeated create and remove cycles leave the session unchanged
+func TestMergeCreateRemoveCycles(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	original := ser.Serialise()
+	create := NewSessionMessage(PFCP_Session_Modification_Request, 1, IE_CreateQer(IE_QerId(10), IE_GateStatus(GateOpen)), IE_CreateUrr(IE_UrrId(10)))
+	remove := NewSessionMessage(PFCP_Session_Modification_Request, 1, IE_RemoveQer(IE_QerId(10)), IE_RemoveUrr(IE_UrrId(10)), IE_RemoveUrr(IE_UrrId(2)))
+	restore := NewSessionMessage(PFCP_Session_Modification_Request, 1, IE_CreateUrr(IE_UrrId(2)))
+
+	for _, smr := range []*PfcpMessage{create, remove, restore} {
+		if err := smr.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var err error
+	for cycle := 0; cycle < 5; cycle++ {
+		for _, smr := range []*PfcpMessage{create, remove, restore} {
+			if ser, err = ser.Merge(smr); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, ie := range ser.iEnodes {
+			if ie.IeTypeCode == 0 {
+				t.Fatalf("cycle %d: placeholder IE left in\n%s", cycle, ser.Dumper())
+			}
+		}
+		if _, err := ParseValidate(ser.Serialise()); err != nil {
+			t.Fatalf("cycle %d: %s", cycle, err.Error())
+		} else if _, err := ser.Node().Getter().GetById(Create_QER, 10).Return(); err == nil {
+			t.Fatalf("cycle %d: QER 10 not removed", cycle)
+		}
+	}
+	if merged := ser.Serialise(); len(merged) != len(original) {
+		t.Errorf("session grew from %d to %d octets", len(original), len(merged))
+	}
+}
+
+// the merge returns a new message with the header of the target and leaves both inputs unchanged
+func TestMergeNonDestructive(t *testing.T) {
+	ser, smr := mergeTestMessages(t)
+	ser.SequenceNumber = 7
+	ser.Priority = new(uint8)
+	*ser.Priority = 3
+	originalSer, originalSmr := ser.Serialise(), smr.Serialise()
+
+	merged, err := ser.Merge(smr)
+	if err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(ser.Serialise(), originalSer) {
+		t.Errorf("target changed by merge\n%s", ser.Dumper())
+	} else if !bytes.Equal(smr.Serialise(), originalSmr) {
+		t.Errorf("update changed by merge\n%s", smr.Dumper())
+	} else if merged.MessageTypeCode != PFCP_Session_Establishment_Request || *merged.SEID != *ser.SEID || merged.SequenceNumber != 7 || *merged.Priority != 3 {
+		t.Errorf("header not kept: %s", merged.rawPfcpMessageHeader)
+	} else if merged.SEID == ser.SEID || merged.Priority == ser.Priority {
+		t.Errorf("header shared with the target")
+	}
+
+	// a rollback is a return to the unchanged target, which can be merged again
+	if again, err := ser.Merge(smr); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(again.Serialise(), merged.Serialise()) {
+		t.Errorf("second merge differs\n%s", again.Dumper())
+	}
+}
+
+func TestClone(t *testing.T) {
+	ser, _ := mergeTestMessages(t)
+	clone := ser.Clone()
+	if !bytes.Equal(clone.Serialise(), ser.Serialise()) {
+		t.Fatalf("clone differs\n%s", clone.Dumper())
+	}
+	original := ser.Serialise()
+	pdr := clone.Node().Getter().GetById(Create_PDR, 1).Inner()
+	*pdr.IeID = 2
+	pdr.ies[1].bytes[0] ^= 0xff
+	*clone.SEID = 2
+	clone.iEnodes[0] = IE_NodeIdFqdn("other")
+	if !bytes.Equal(ser.Serialise(), original) || *ser.SEID != 1 {
+		t.Errorf("change of the clone changed the original\n%s", ser.Dumper())
+	} else if _, err := ser.Node().Getter().GetById(Create_PDR, 1).Return(); err != nil {
+		t.Errorf("ID of the original changed")
+	}
+}
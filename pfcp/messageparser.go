@@ -22,14 +22,7 @@ func ExcerciseParser(rawMsg []byte) {
 	}
 }
 
+// ParseValidate validates with DefaultProfile
 func ParseValidate(rawMsg []byte) (*PfcpMessage, error) {
-	if msg, err := ParsePFCPHeader(rawMsg); err != nil {
-		return nil, err
-	} else if parsedMsg, err := msg.ParsePFCPPayload(); err != nil {
-		return nil, err
-	} else if err := parsedMsg.Validate(); err != nil {
-		return nil, err
-	} else {
-		return parsedMsg, nil
-	}
+	return DefaultProfile.ParseValidate(rawMsg)
 }
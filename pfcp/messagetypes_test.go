@@ -0,0 +1,45 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import "testing"
+
+// every message in the catalogue is either a request or a response, and has an IE set for validation
+func TestMessageTypeClassification(t *testing.T) {
+	for typeCode := range messageNames {
+		if typeCode.IsRequest() == typeCode.IsResponse() {
+			t.Errorf("%s is not exactly one of request or response", typeCode)
+		} else if _, found := MessageIeAttributeSets[typeCode]; !found {
+			t.Errorf("%s has no IE set", typeCode)
+		} else if typeCode.IsRequest() && !(typeCode + 1).IsResponse() {
+			t.Errorf("%s has no response", typeCode)
+		}
+	}
+}
+
+func TestNodeMessageValidation(t *testing.T) {
+	for _, msg := range []*PfcpMessage{
+		NewNodeMessage(PFCP_PFD_Management_Request,
+			*NewGroupNode(Application_IDs_PFDs,
+				*NewIeNode(Application_ID, []byte("app")),
+				*NewGroupNode(PFD_context, *NewIeNode(PFD_contents, []byte{0x01, 0, 0, 0})),
+			),
+		),
+		NewNodeMessage(PFCP_Node_Report_Request,
+			IE_NodeIdFqdn("upf"),
+			IE_Typed(&T_Node_Report_Type{NewFlags(0)}),
+			*NewGroupNode(User_Plane_Path_Failure_Report, *NewIeNode(Remote_GTP_U_Peer, []byte{0x02, 192, 0, 2, 1})),
+		),
+		NewNodeMessage(PFCP_Version_Not_Supported_Response),
+	} {
+		if _, err := ParseValidate(msg.Serialise()); err != nil {
+			t.Errorf("%s: %s", msg.TypeCode(), err.Error())
+		}
+	}
+
+	missingNodeId := NewNodeMessage(PFCP_Association_Release_Request)
+	if _, err := ParseValidate(missingNodeId.Serialise()); err == nil {
+		t.Errorf("association release request without node ID accepted")
+	}
+}
@@ -57,6 +57,20 @@ func NewNodeMessage(tc MessageTypeCode, iEnodes ...IeNode) *PfcpMessage {
 	return msg
 }
 
+// Clone returns a deep copy of the message, header and IEs
+func (msg *PfcpMessage) Clone() *PfcpMessage {
+	clone := &PfcpMessage{rawPfcpMessageHeader: msg.rawPfcpMessageHeader, iEnodes: cloneIes(msg.iEnodes)}
+	if msg.SEID != nil {
+		seid := *msg.SEID
+		clone.SEID = &seid
+	}
+	if msg.Priority != nil {
+		priority := *msg.Priority
+		clone.Priority = &priority
+	}
+	return clone
+}
+
 func (msg *PfcpMessage) typeName() string          { return msg.rawPfcpMessageHeader.typeCode().String() }
 func (msg *PfcpMessage) TypeCode() MessageTypeCode { return msg.rawPfcpMessageHeader.typeCode() }
 
@@ -111,22 +125,26 @@ func (msg PfcpMessage) dump(sb *strings.Builder, level int) {
 }
 
 func (msg *PfcpMessage) Validate() error {
-	sb := new(strings.Builder)
-	if attributeSet, present := MessageIeAttributeSets[msg.MessageTypeCode]; present {
+	return msg.ValidateWith(DefaultProfile)
+}
+
+// ValidateWith returns a *ValidationError when the message does not conform to the profile
+func (msg *PfcpMessage) ValidateWith(profile *ValidationProfile) error {
+	verr := &ValidationError{MessageTypeCode: msg.MessageTypeCode}
+	if attributeSet, present := profile.messageSets[msg.MessageTypeCode]; present {
 		// first directly validate the top level list, which is not a group IE itself
-		validateAttributeSet(sb, &msg.iEnodes, msg, attributeSet)
+		validateAttributeSet(verr, &msg.iEnodes, attributeSet, nil, profile.Strict)
+		validateConditionalRules(verr, msg.iEnodes, messageConditionalRules[msg.MessageTypeCode])
 		// now call recursive validate on the elements
-		for i := range msg.iEnodes {
-			msg.iEnodes[i].validate(sb)
-		}
+		validateMembers(verr, msg.iEnodes, attributeSet, nil, profile)
 	} else {
-		fmt.Fprintf(sb, "parser exception - unknown message type %d in validator (%s)\n", msg.MessageTypeCode, msg.MessageTypeCode.String())
+		verr.add(ViolationUnknownMessage, 0, nil, "%s in profile %s", msg.MessageTypeCode, profile.Name)
 	}
 
-	if sb.Len() == 0 {
+	if len(verr.Violations) == 0 {
 		return nil
 	} else {
-		return fmt.Errorf(sb.String())
+		return verr
 	}
 }
 
@@ -0,0 +1,125 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A ValidationProfile is the set of messages and IE sets which are valid for a peer.
+// The catalogue IE sets are the union over all supported releases, the release profiles
+// remove the messages and IEs which the release does not know, as given by the releases in ts29244.spec.
+// Which IEs are group IEs does not depend on the profile, only the allowed members do.
+//
+// A profile is lenient unless Strict is set: IEs which are unknown, or not expected in their IE set,
+// are kept in the IeNode tree and serialised unchanged, but are not validated (TS29.244 7.6.2).
+// A strict profile reports them as unallowed, which is intended for conformance testing.
+type ValidationProfile struct {
+	Name        string
+	Strict      bool
+	messageSets map[MessageTypeCode]groupIeAttributeSet
+	groupSets   map[IeTypeCode]groupIeAttributeSet
+}
+
+type Release uint8
+
+const (
+	Release15 Release = 15
+	Release16 Release = 16
+	Release17 Release = 17
+)
+
+func (release Release) String() string { return fmt.Sprintf("R%d", uint8(release)) }
+
+var (
+	// DefaultProfile accepts every message and IE in the catalogue, regardless of release
+	DefaultProfile = &ValidationProfile{Name: "default", messageSets: MessageIeAttributeSets, groupSets: groupIeAttributeSets}
+	ProfileR15     = NewReleaseProfile(Release15)
+	ProfileR16     = NewReleaseProfile(Release16)
+	ProfileR17     = NewReleaseProfile(Release17)
+)
+
+// StrictProfile is DefaultProfile in strict mode
+var StrictProfile = DefaultProfile.StrictMode()
+
+// StrictMode returns a strict copy of the profile, named e.g. "R16-strict"
+func (profile *ValidationProfile) StrictMode() *ValidationProfile {
+	if profile.Strict {
+		return profile
+	} else {
+		strict := *profile
+		strict.Name += "-strict"
+		strict.Strict = true
+		return &strict
+	}
+}
+
+// ValidationProfileByName accepts the name of a profile, optionally with the suffix "-strict"
+func ValidationProfileByName(name string) (*ValidationProfile, error) {
+	baseName, strict := strings.CutSuffix(name, "-strict")
+	for _, profile := range []*ValidationProfile{DefaultProfile, ProfileR15, ProfileR16, ProfileR17} {
+		if profile.Name != baseName {
+		} else if strict {
+			return profile.StrictMode(), nil
+		} else {
+			return profile, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown validation profile '%s'", name)
+}
+
+func NewReleaseProfile(release Release) *ValidationProfile {
+	inRelease := func(tc IeTypeCode) bool {
+		introduced, found := ieIntroducedIn[tc]
+		removed, isRemoved := ieRemovedIn[tc]
+		return (!found || introduced <= release) && (!isRemoved || release < removed)
+	}
+	restrict := func(attributeSet groupIeAttributeSet) groupIeAttributeSet {
+		restricted := groupIeAttributeSet{}
+		for tc, attributes := range attributeSet {
+			if inRelease(tc) {
+				restricted[tc] = attributes
+			}
+		}
+		return restricted
+	}
+
+	profile := &ValidationProfile{
+		Name:        release.String(),
+		messageSets: map[MessageTypeCode]groupIeAttributeSet{},
+		groupSets:   map[IeTypeCode]groupIeAttributeSet{},
+	}
+	for tc, attributeSet := range MessageIeAttributeSets {
+		if introduced, found := messageIntroducedIn[tc]; !found || introduced <= release {
+			profile.messageSets[tc] = restrict(attributeSet)
+		}
+	}
+	for tc, attributeSet := range groupIeAttributeSets {
+		profile.groupSets[tc] = restrict(attributeSet)
+	}
+	return profile
+}
+
+// attributeSet is IeNode.attributeSet, with the profile member sets for the catalogue group IEs
+func (profile *ValidationProfile) attributeSet(node *IeNode) (groupIeAttributeSet, bool) {
+	if node.IeTypeCode.IsVendor() {
+		return node.attributeSet()
+	} else {
+		attributeSet, found := profile.groupSets[node.IeTypeCode]
+		return attributeSet, found
+	}
+}
+
+func (profile *ValidationProfile) ParseValidate(rawMsg []byte) (*PfcpMessage, error) {
+	if msg, err := ParsePFCPHeader(rawMsg); err != nil {
+		return nil, err
+	} else if parsedMsg, err := msg.ParsePFCPPayload(); err != nil {
+		return nil, err
+	} else if err := parsedMsg.ValidateWith(profile); err != nil {
+		return nil, err
+	} else {
+		return parsedMsg, nil
+	}
+}
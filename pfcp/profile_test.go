@@ -0,0 +1,98 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestValidationProfiles(t *testing.T) {
+	nodeId := IE_NodeIdFqdn("upf")
+	upIpResourceInformation := NewNodeMessage(PFCP_Association_Setup_Response, IE_Cause(CauseAccepted), nodeId, IE_RecoveryTimeStamp(1), IE_UpIpRsrcInfo(netip.MustParseAddr("192.0.2.1")))
+	alternativeSmf := NewNodeMessage(PFCP_Association_Update_Request, nodeId, *NewIeNode(Alternative_SMF_IP_Address, []byte{0x02, 192, 0, 2, 1}))
+	sessionSetModification := NewNodeMessage(PFCP_Session_Set_Modification_Request, *NewIeNode(Alternative_SMF_IP_Address, []byte{0x02, 192, 0, 2, 1}))
+	withIe := func(ie IeNode) *PfcpMessage {
+		msg := ser2.Clone()
+		msg.iEnodes = append(msg.iEnodes, ie)
+		return msg
+	}
+	sNssai := withIe(IE_Typed(&T_S_NSSAI{SNssai{Sst: 1}}))
+	pfcpsereqFlags := withIe(IE_Typed(&T_PfcpsereqFlags{NewFlags(0)}))
+	qfi := uint8(9)
+	downlinkDataReport := NewSessionMessage(PFCP_Session_Report_Request, 1,
+		IE_Typed(&T_Report_Type{NewFlags(0x01)}),
+		*NewGroupNode(Downlink_Data_Report, IE_PdrId(1), IE_Typed(&T_Downlink_Data_Service_Information{DownlinkDataServiceInformation{Qfi: &qfi}})),
+		IE_Typed(&T_PfcpsrreqFlags{NewFlags(0)}),
+	)
+	nfInstanceId := NewNodeMessage(PFCP_Association_Setup_Request, nodeId, IE_RecoveryTimeStamp(1), *NewIeNode(NF_Instance_ID, make([]byte, 16)))
+
+	for _, testCase := range []struct {
+		msg                                        *PfcpMessage
+		defaultValid, r15Valid, r16Valid, r17Valid bool
+	}{
+		{upIpResourceInformation, true, true, false, false},
+		{alternativeSmf, true, false, true, true},
+		{sessionSetModification, true, false, false, true},
+		{sNssai, true, false, true, true},
+		{pfcpsereqFlags, true, true, true, true},
+		{ser2, true, true, true, true},
+		{downlinkDataReport, true, true, true, true},
+		{nfInstanceId, true, false, true, true},
+	} {
+		// IEs of other releases are unallowed only in strict mode, an unknown message is always rejected
+		for profile, valid := range map[*ValidationProfile]bool{
+			StrictProfile:           testCase.defaultValid,
+			ProfileR15.StrictMode(): testCase.r15Valid,
+			ProfileR16.StrictMode(): testCase.r16Valid,
+			ProfileR17.StrictMode(): testCase.r17Valid,
+			ProfileR15:              testCase.r15Valid || testCase.msg.TypeCode() != PFCP_Session_Set_Modification_Request,
+			ProfileR16:              testCase.r16Valid || testCase.msg.TypeCode() != PFCP_Session_Set_Modification_Request,
+		} {
+			if _, err := profile.ParseValidate(testCase.msg.Serialise()); valid && err != nil {
+				t.Errorf("%s rejected by profile %s: %s", testCase.msg.TypeCode(), profile.Name, err.Error())
+			} else if !valid && err == nil {
+				t.Errorf("%s accepted by profile %s", testCase.msg.TypeCode(), profile.Name)
+			}
+		}
+	}
+}
+
+func TestValidationProfileByName(t *testing.T) {
+	if profile, err := ValidationProfileByName("R16"); err != nil || profile != ProfileR16 {
+		t.Errorf("R16 profile not found")
+	}
+	if profile, err := ValidationProfileByName("R16-strict"); err != nil || !profile.Strict || profile.Name != "R16-strict" {
+		t.Errorf("strict R16 profile not found")
+	}
+	if _, err := ValidationProfileByName("R14"); err == nil {
+		t.Errorf("R14 profile found")
+	}
+}
+
+func TestUnknownIes(t *testing.T) {
+	unknown := *NewIeNode(IeTypeCode(4000), []byte{1, 2, 3})
+	// a group IE which is not expected in a heartbeat, its members are not validated
+	unexpectedGroup := *NewIeNode(Create_FAR, nil)
+	unexpectedGroup.ies = []IeNode{IE_PdrId(1), unknown}
+	msg := NewNodeMessage(PFCP_Heartbeat_Request, IE_RecoveryTimeStamp(1), unknown, unexpectedGroup)
+	raw := msg.Serialise()
+
+	if parsed, err := ParseValidate(raw); err != nil {
+		t.Errorf("unknown IEs rejected: %s", err.Error())
+	} else if len(parsed.iEnodes) != 3 || parsed.iEnodes[1].IeTypeCode != IeTypeCode(4000) {
+		t.Errorf("unknown IE not kept")
+	} else if !bytes.Equal(parsed.Serialise(), raw) {
+		t.Errorf("unknown IEs not serialised unchanged")
+	}
+
+	var verr *ValidationError
+	if _, err := StrictProfile.ParseValidate(raw); !errors.As(err, &verr) {
+		t.Errorf("unknown IEs accepted in strict mode")
+	} else if len(verr.Violations) < 2 || verr.Violations[0].Kind != ViolationUnallowedIe || verr.Violations[0].TypeCode != IeTypeCode(4000) {
+		t.Errorf("wrong violations %s", err.Error())
+	}
+}
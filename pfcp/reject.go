@@ -0,0 +1,72 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import "errors"
+
+// RejectionCause maps a ParseValidate error to the TS29.244 cause and Offending IE of the rejecting response.
+// offendingIe is zero when no single IE is at fault.
+func RejectionCause(err error) (cause uint8, offendingIe IeTypeCode) {
+	var verr *ValidationError
+	var lengthErr *IeLengthError
+	if errors.As(err, &verr) {
+		return verr.Cause()
+	} else if errors.As(err, &lengthErr) {
+		return InvalidLength, lengthErr.IeTypeCode
+	} else {
+		return CauseUnspecified, 0
+	}
+}
+
+// RejectRequest builds the response to a request which failed profile.ParseValidate with err.
+// The response carries the cause and Offending IE, and nodeId and the recovery time stamp when the response requires them.
+// The response has the sequence number of the request.
+// It is nil when the message cannot be answered: not a request, an unreadable header,
+// a response which is not in the profile, or one without Cause, i.e. Heartbeat.
+// The SEID of a session response is the CP F-SEID of a Session Establishment Request, where it can be read, otherwise 0,
+// as the peer SEID of an existing session is not known below the session layer.
+func RejectRequest(rawMsg []byte, err error, nodeId *IeNode, profile *ValidationProfile) *PfcpMessage {
+	header, headerErr := ParsePFCPHeader(rawMsg)
+	if headerErr != nil || !header.MessageTypeCode.IsRequest() {
+		return nil
+	}
+	responseTypeCode := header.MessageTypeCode + 1
+	attributeSet := profile.messageSets[responseTypeCode]
+	if _, found := attributeSet[Cause]; !found {
+		return nil
+	}
+
+	cause, offendingIe := RejectionCause(err)
+	ies := []IeNode{IE_Cause(cause)}
+	if _, found := attributeSet[Offending_IE]; found && offendingIe != 0 {
+		ies = append(ies, IE_OffendingIe(offendingIe))
+	}
+	if _, found := attributeSet[Node_ID]; found && nodeId != nil {
+		ies = append(ies, *nodeId)
+	}
+	if attributes, found := attributeSet[Recovery_Time_Stamp]; found && attributes.required {
+		ies = append(ies, IE_RecoveryTimeStamp(GetRecoveryTime()))
+	}
+
+	var response *PfcpMessage
+	if header.SEID == nil {
+		response = NewNodeMessage(responseTypeCode, ies...)
+	} else {
+		response = NewSessionMessage(responseTypeCode, rejectSeid(header), ies...)
+	}
+	response.SequenceNumber = header.SequenceNumber
+	return response
+}
+
+func rejectSeid(header rawPfcpMessage) SEID {
+	if header.MessageTypeCode != PFCP_Session_Establishment_Request {
+		return 0
+	} else if msg, err := header.ParsePFCPPayload(); err != nil {
+		return 0
+	} else if fseid, err := msg.Node().Getter().GetByTc(F_SEID).DeserialiseFSeid(); err != nil {
+		return 0
+	} else {
+		return SEID(fseid.Seid)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestRejectRequest(t *testing.T) {
+	nodeId := IE_NodeIdFqdn("upf")
+	rejectWith := func(profile *ValidationProfile, msg *PfcpMessage, corrupt func([]byte) []byte) *PfcpMessage {
+		raw := msg.Serialise()
+		if corrupt != nil {
+			raw = corrupt(raw)
+		}
+		if _, err := profile.ParseValidate(raw); err == nil {
+			t.Fatalf("%s is valid", msg.TypeCode())
+			return nil
+		} else {
+			return RejectRequest(raw, err, &nodeId, profile)
+		}
+	}
+	reject := func(msg *PfcpMessage, corrupt func([]byte) []byte) *PfcpMessage {
+		return rejectWith(StrictProfile, msg, corrupt)
+	}
+	check := func(response *PfcpMessage, tc MessageTypeCode, cause uint8, offendingIe IeTypeCode) {
+		if response == nil {
+			t.Errorf("%s: no response", tc)
+		} else if err := response.Validate(); err != nil {
+			t.Errorf("%s: invalid response %s", tc, err.Error())
+		} else if response.TypeCode() != tc {
+			t.Errorf("%s: wrong response type %s", tc, response.TypeCode())
+		} else if responseCause, _ := response.Node().ReadCauseCode(); responseCause != cause {
+			t.Errorf("%s: wrong cause %d", tc, responseCause)
+		} else if offendingIe == 0 {
+		} else if offending, err := response.Node().Getter().GetByTc(Offending_IE).DeserialiseU16(); err != nil || IeTypeCode(offending) != offendingIe {
+			t.Errorf("%s: wrong offending IE", tc)
+		}
+	}
+
+	check(reject(NewNodeMessage(PFCP_Association_Setup_Request, nodeId), nil), PFCP_Association_Setup_Response, MandatoryIeMissing, 0)
+	check(reject(NewSessionMessage(PFCP_Session_Deletion_Request, 1, IE_PdrId(1)), nil), PFCP_Session_Deletion_Response, MandatoryIeIncorrect, PDR_ID)
+
+	// the length of the Recovery Time Stamp IE overflows the message
+	overflow := reject(NewNodeMessage(PFCP_Association_Release_Request, nodeId, IE_RecoveryTimeStamp(1)), func(raw []byte) []byte {
+		binary.BigEndian.PutUint16(raw[len(raw)-6:], 5)
+		return raw
+	})
+	if overflow == nil || overflow.TypeCode() != PFCP_Association_Release_Response {
+		t.Errorf("no response for overflowing IE")
+	} else if cause, _ := overflow.Node().ReadCauseCode(); cause != InvalidLength {
+		t.Errorf("wrong cause %d for overflowing IE", cause)
+	}
+	// the message ends in part of an IE header
+	check(reject(NewNodeMessage(PFCP_Association_Release_Request, nodeId), func(raw []byte) []byte {
+		raw = append(raw, 0, 96)
+		binary.BigEndian.PutUint16(raw[2:4], uint16(len(raw)-4))
+		return raw
+	}), PFCP_Association_Release_Response, InvalidLength, 0)
+
+	if cause, offendingIe := RejectionCause(errors.New("other failure")); cause != CauseUnspecified || offendingIe != 0 {
+		t.Errorf("wrong cause %d for other failure", cause)
+	}
+	// a message of a later release is unknown to an R15 peer, which has no response to it
+	setModification := NewNodeMessage(PFCP_Session_Set_Modification_Request, *NewIeNode(Alternative_SMF_IP_Address, []byte{0x02, 192, 0, 2, 1}))
+	if rejectWith(ProfileR15, setModification, nil) != nil {
+		t.Errorf("request of a later release rejected")
+	}
+
+	if reject(NewNodeMessage(PFCP_Heartbeat_Request), nil) != nil {
+		t.Errorf("heartbeat request rejected")
+	}
+	if reject(NewNodeMessage(PFCP_Association_Setup_Response, nodeId), nil) != nil {
+		t.Errorf("response rejected")
+	}
+}
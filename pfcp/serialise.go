@@ -11,17 +11,32 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// Serialise returns the wire format of the message in a new slice of exactly the required size
 func (msg *PfcpMessage) Serialise() []byte {
-	payload := bytes.NewBuffer(nil)
-	serialise(payload, msg.iEnodes)
-	return msg.rawPfcpMessageHeader.serialise(payload.Bytes())
+	return msg.AppendSerialised(make([]byte, 0, msg.SerialisedLength()))
+}
+
+// AppendSerialised appends the wire format of the message to b, and returns the extended slice.
+// When b has capacity for SerialisedLength() more bytes nothing is allocated, e.g. for a reused send buffer b[:0].
+func (msg *PfcpMessage) AppendSerialised(b []byte) []byte {
+	start := len(b)
+	b = msg.rawPfcpMessageHeader.appendSerialised(b)
+	b = appendSerialised(b, msg.iEnodes)
+	// the message length excludes the first 4 octets of the header
+	binary.BigEndian.PutUint16(b[start+2:start+4], uint16(len(b)-start-4))
+	return b
+}
+
+func (msg *PfcpMessage) SerialisedLength() int {
+	if msg.SEID == nil {
+		return 8 + serialisedLength(msg.iEnodes)
+	} else {
+		return 16 + serialisedLength(msg.iEnodes)
+	}
 }
 
 func ReserialiseCheck(msg *PfcpMessage, raw []byte) error {
-	payload := bytes.NewBuffer(nil)
-	serialise(payload, msg.iEnodes)
-	wireformatCopy := msg.rawPfcpMessageHeader.serialise(payload.Bytes())
-	return BytesCompare(wireformatCopy, raw)
+	return BytesCompare(msg.Serialise(), raw)
 }
 
 func BytesCompare(a, b []byte) error {
@@ -59,27 +74,24 @@ func writeBE24(b []byte, u uint32) {
 	b[2] = uint8(u)
 }
 
-func (header rawPfcpMessageHeader) serialise(payload []byte) (resultSlice []byte) {
+// appendSerialised writes the header with a zero message length, which is patched once the IEs are written
+func (header *rawPfcpMessageHeader) appendSerialised(b []byte) []byte {
+	flags := uint8(0b00100000) // version 1
 	if header.SEID == nil {
-		resultSlice = make([]byte, 8)
-		binary.BigEndian.PutUint16(resultSlice[2:4], uint16(4+len(payload)))
-		writeBE24(resultSlice[4:7], header.SequenceNumber)
-	} else {
-		resultSlice = make([]byte, 16)
-		resultSlice[0] = resultSlice[0] | 0b00000001
-		binary.BigEndian.PutUint16(resultSlice[2:4], uint16(12+len(payload)))
-
-		if header.Priority != nil {
-			resultSlice[0] = resultSlice[0] | 0b00000010
-			resultSlice[15] = *header.Priority << 4
+		b = append(b, flags, uint8(header.MessageTypeCode), 0, 0, 0, 0, 0, 0)
+		writeBE24(b[len(b)-4:], header.SequenceNumber)
+		return b
+	}
 
-		}
-		binary.BigEndian.PutUint64(resultSlice[4:12], uint64(*header.SEID))
-		writeBE24(resultSlice[12:15], header.SequenceNumber)
+	flags |= 0b00000001
+	var priority uint8
+	if header.Priority != nil {
+		flags |= 0b00000010
+		priority = *header.Priority << 4
 	}
-	resultSlice[0] = resultSlice[0] | 0b00100000
-	// binary.BigEndian.PutUint16(resultSlice[2:4], uint16(len(payload)))
-	resultSlice[1] = uint8(header.MessageTypeCode)
-	resultSlice = append(resultSlice, payload...)
-	return
+	b = append(b, flags, uint8(header.MessageTypeCode), 0, 0)
+	b = binary.BigEndian.AppendUint64(b, uint64(*header.SEID))
+	b = append(b, 0, 0, 0, priority)
+	writeBE24(b[len(b)-4:], header.SequenceNumber)
+	return b
 }
@@ -0,0 +1,120 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// bufferedSerialise is the former serialiser, which copies each group IE up from its own buffer.
+// It is kept as the reference for the single pass serialiser and for the benchmarks.
+func bufferedSerialise(msg *PfcpMessage) []byte {
+	var serialise func(b *bytes.Buffer, ie IeNode)
+	serialise = func(b *bytes.Buffer, ie IeNode) {
+		if ie.ies != nil {
+			var nb bytes.Buffer
+			for _, child := range ie.ies {
+				serialise(&nb, child)
+			}
+			serialise(b, IeNode{IeTypeCode: ie.IeTypeCode, enterpriseId: ie.enterpriseId, bytes: nb.Bytes()})
+			return
+		}
+		length := len(ie.bytes)
+		if ie.IeTypeCode.IsVendor() {
+			length += 2
+		}
+		b.Write([]byte{uint8(ie.IeTypeCode >> 8), uint8(ie.IeTypeCode), uint8(length >> 8), uint8(length)})
+		if ie.IeTypeCode.IsVendor() {
+			b.Write([]byte{uint8(ie.enterpriseId >> 8), uint8(ie.enterpriseId)})
+		}
+		b.Write(ie.bytes)
+	}
+
+	payload := bytes.NewBuffer(nil)
+	for _, ie := range msg.iEnodes {
+		serialise(payload, ie)
+	}
+	var header []byte
+	if msg.SEID == nil {
+		header = make([]byte, 8)
+		binary.BigEndian.PutUint16(header[2:4], uint16(4+payload.Len()))
+		writeBE24(header[4:7], msg.SequenceNumber)
+	} else {
+		header = make([]byte, 16)
+		header[0] = 0b00000001
+		binary.BigEndian.PutUint16(header[2:4], uint16(12+payload.Len()))
+		if msg.Priority != nil {
+			header[0] |= 0b00000010
+			header[15] = *msg.Priority << 4
+		}
+		binary.BigEndian.PutUint64(header[4:12], uint64(*msg.SEID))
+		writeBE24(header[12:15], msg.SequenceNumber)
+	}
+	header[0] |= 0b00100000
+	header[1] = uint8(msg.MessageTypeCode)
+	return append(header, payload.Bytes()...)
+}
+
+func serialiseTestMessages() []*PfcpMessage {
+	priority := uint8(5)
+	prioritised := *SessionModificationRequest
+	prioritised.Priority = &priority
+	prioritised.SequenceNumber = 0x123456
+	return []*PfcpMessage{
+		ser2,
+		SessionEstablishmentRequest,
+		SessionModificationRequest,
+		&prioritised,
+		&HeartBeatRequest,
+		vendorTestMessage(*NewVendorGroupNode(testEnterprise, testVendorGroup, *NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1, 2, 3}))),
+	}
+}
+
+func TestSinglePassSerialise(t *testing.T) {
+	for _, msg := range serialiseTestMessages() {
+		reference := bufferedSerialise(msg)
+		if serialised := msg.Serialise(); !bytes.Equal(serialised, reference) {
+			t.Errorf("%s: serialised\n% x\nexpected\n% x", msg.TypeCode(), serialised, reference)
+		} else if len(serialised) != msg.SerialisedLength() || cap(serialised) != len(serialised) {
+			t.Errorf("%s: length %d, capacity %d, expected %d", msg.TypeCode(), len(serialised), cap(serialised), msg.SerialisedLength())
+		}
+
+		prefix := []byte{0xaa, 0xbb}
+		if appended := msg.AppendSerialised(prefix); !bytes.Equal(appended[:2], prefix) || !bytes.Equal(appended[2:], reference) {
+			t.Errorf("%s: append to a non empty slice failed", msg.TypeCode())
+		}
+	}
+}
+
+func TestAppendSerialisedAllocations(t *testing.T) {
+	msg := SessionEstablishmentRequest
+	b := make([]byte, 0, msg.SerialisedLength())
+	if allocations := testing.AllocsPerRun(100, func() { b = msg.AppendSerialised(b[:0]) }); allocations != 0 {
+		t.Errorf("%.0f allocations with sufficient capacity", allocations)
+	}
+}
+
+func BenchmarkSerialiseBuffered(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bufferedSerialise(SessionEstablishmentRequest)
+	}
+}
+
+func BenchmarkSerialise(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SessionEstablishmentRequest.Serialise()
+	}
+}
+
+func BenchmarkAppendSerialised(b *testing.B) {
+	b.ReportAllocs()
+	buffer := make([]byte, 0, 1500)
+	for i := 0; i < b.N; i++ {
+		buffer = SessionEstablishmentRequest.AppendSerialised(buffer[:0])
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParseText reads the indented text written by PfcpMessage.Dumper back into a message, e.g.
+//
+//	PFCP Message type=Session Establishment Request,seid=00001234, seq=0
+//	Node ID: smf0.example
+//	Create PDR (1)
+//	  PDR ID: 1
+//	  PDI
+//	    Source Interface: Access
+//
+// Each IE is a line, the members of a group IE follow it with a deeper indentation.
+// IEs are named as in the dump, or by their identifier, e.g. Create_PDR.
+// The value of a non-group IE follows ": " in the format of the dump for its type,
+// or, for any IE, as "hex:" and the hex payload.
+// Empty lines and lines starting with '#' are ignored.
+//
+// The dump does not show every IE exactly, e.g. Apply Action shows only one action,
+// and the message priority is not shown, so that hex: is needed to reproduce such IEs.
+func ParseText(text string) (*PfcpMessage, error) {
+	parser := textParser{}
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			parser.lines = append(parser.lines, textLine{number: i + 1, text: line, indent: len(line) - len(strings.TrimLeft(line, " "))})
+		}
+	}
+
+	if len(parser.lines) == 0 {
+		return nil, fmt.Errorf("empty message text")
+	} else if msg, err := parseTextHeader(parser.lines[0]); err != nil {
+		return nil, err
+	} else {
+		parser.next = 1
+		if len(parser.lines) > 1 {
+			if msg.iEnodes, err = parser.parseIes(parser.lines[1].indent); err != nil {
+				return nil, err
+			}
+		}
+		if parser.next < len(parser.lines) {
+			return nil, parser.lines[parser.next].errorf("unexpected indentation")
+		}
+		return msg, nil
+	}
+}
+
+type textLine struct {
+	number int
+	text   string
+	indent int
+}
+
+func (line textLine) errorf(format string, a ...any) error {
+	return fmt.Errorf("line %d: %s", line.number, fmt.Sprintf(format, a...))
+}
+
+type textParser struct {
+	lines []textLine
+	next  int
+}
+
+// parseIes reads the IE lines at the indentation, and, recursively, their members
+func (parser *textParser) parseIes(indent int) (ies []IeNode, err error) {
+	for parser.next < len(parser.lines) && parser.lines[parser.next].indent == indent {
+		line := parser.lines[parser.next]
+		parser.next++
+		node, err := parseTextIe(line)
+		if err != nil {
+			return nil, err
+		}
+		if node.ies != nil && parser.next < len(parser.lines) && parser.lines[parser.next].indent > indent {
+			if node.ies, err = parser.parseIes(parser.lines[parser.next].indent); err != nil {
+				return nil, err
+			}
+		}
+		ies = append(ies, *node)
+	}
+	return ies, nil
+}
+
+var textHeaderRegexp = regexp.MustCompile(`^PFCP Message type=(.+),\s*seid=([^,]+),\s*seq=(\d+)$`)
+
+func parseTextHeader(line textLine) (*PfcpMessage, error) {
+	fields := textHeaderRegexp.FindStringSubmatch(strings.TrimSpace(line.text))
+	if fields == nil {
+		return nil, line.errorf("expected a message header, 'PFCP Message type=<message>,seid=<seid>, seq=<sequence number>'")
+	}
+	msg := &PfcpMessage{}
+	if tc, found := messageTypeCodeByName(fields[1]); !found {
+		return nil, line.errorf("unknown message '%s'", fields[1])
+	} else {
+		msg.MessageTypeCode = tc
+	}
+	if fields[2] != "not present" {
+		if seid, err := strconv.ParseUint(fields[2], 10, 64); err != nil {
+			return nil, line.errorf("invalid SEID '%s'", fields[2])
+		} else {
+			msg.SEID = (*SEID)(&seid)
+		}
+	}
+	if sequenceNumber, err := strconv.ParseUint(fields[3], 10, 24); err != nil {
+		return nil, line.errorf("invalid sequence number '%s'", fields[3])
+	} else {
+		msg.SequenceNumber = uint32(sequenceNumber)
+	}
+	return msg, nil
+}
+
+// the ID of a group IE, which the dump appends to the name
+var textIdRegexp = regexp.MustCompile(`^(.*) \(\d+\)$`)
+
+func parseTextIe(line textLine) (*IeNode, error) {
+	text := strings.TrimSpace(line.text)
+	name, value, hasValue := strings.Cut(text, ":")
+	value = strings.TrimSpace(value)
+	if matches := textIdRegexp.FindStringSubmatch(text); !hasValue && matches != nil {
+		name = matches[1]
+	}
+
+	node, err := textIeByName(name)
+	if err != nil {
+		return nil, line.errorf("%s", err.Error())
+	} else if node.isGroup() && hasValue && !strings.HasPrefix(value, "hex:") {
+		return nil, line.errorf("group IE %s with a value", name)
+	} else if node.isGroup() && !hasValue {
+		node.ies = []IeNode{}
+	} else if !hasValue {
+		return nil, line.errorf("missing value for %s", name)
+	} else if node.bytes, err = parseTextValue(node, value); err != nil {
+		return nil, line.errorf("%s: %s", name, err.Error())
+	}
+	return node, nil
+}
+
+var (
+	textUnknownIeRegexp = regexp.MustCompile(`^(?:unknown )?IE\((\d+)\)$`)
+	textVendorIeRegexp  = regexp.MustCompile(`^vendor IE\((\d+)/(\d+)\)$`)
+)
+
+func textIeByName(name string) (*IeNode, error) {
+	if tc, found := ieTypeCodeByName(name); found {
+		return &IeNode{IeTypeCode: tc}, nil
+	} else if tc, found := ieTypeCodeByIdentifier(name); found {
+		return &IeNode{IeTypeCode: tc}, nil
+	} else if matches := textUnknownIeRegexp.FindStringSubmatch(name); matches != nil {
+		tc, err := strconv.ParseUint(matches[1], 10, 15)
+		return &IeNode{IeTypeCode: IeTypeCode(tc)}, err
+	} else if matches := textVendorIeRegexp.FindStringSubmatch(name); matches != nil {
+		if enterpriseId, err := strconv.ParseUint(matches[1], 10, 16); err != nil {
+			return nil, err
+		} else if tc, err := strconv.ParseUint(matches[2], 10, 15); err != nil {
+			return nil, err
+		} else {
+			return NewVendorIeNode(EnterpriseId(enterpriseId), IeTypeCode(tc), nil), nil
+		}
+	} else {
+		for key, properties := range vendorIes {
+			if properties.name == name {
+				return NewVendorIeNode(key.EnterpriseId, key.IeTypeCode, nil), nil
+			}
+		}
+		return nil, fmt.Errorf("unknown IE '%s'", name)
+	}
+}
+
+func ieTypeCodeByName(name string) (IeTypeCode, bool) {
+	for tc, ieName := range ieNames {
+		if ieName == name {
+			return tc, true
+		}
+	}
+	return 0, false
+}
+
+// parseTextValue is the inverse of IeNode.show
+func parseTextValue(node *IeNode, value string) ([]byte, error) {
+	if hexValue, found := strings.CutPrefix(value, "hex:"); found {
+		return hex.DecodeString(strings.TrimSpace(hexValue))
+	} else if strings.HasPrefix(value, "invalid format(") {
+		// showBytesAsError
+		return hex.DecodeString(value[strings.LastIndex(value, " - ")+3:])
+	} else if node.IeTypeCode.IsVendor() {
+		return hex.DecodeString(value)
+	} else if value == "" {
+		return []byte{}, nil
+	}
+
+	switch ieTypes[node.IeTypeCode] {
+	case ieTid, ieTintegral, ieTenum:
+		return parseTextIntegral(node.IeTypeCode, value)
+	case ieTenumInterface:
+		if enumInterface, err := parseEnumInterface(value); err != nil {
+			return nil, err
+		} else {
+			return Encode_InterfaceType(enumInterface), nil
+		}
+	case ieTueIpAddress:
+		return parseTextUeIpAddress(value)
+	case ieTbitRates:
+		var bitRate BitRate
+		if _, err := fmt.Sscanf(value, "Up:%d Dn:%d", &bitRate.Uplink, &bitRate.Downlink); err != nil {
+			return nil, fmt.Errorf("expected 'Up:<bit rate> Dn:<bit rate>'")
+		} else {
+			return Encode_BitRates(bitRate), nil
+		}
+	case ieTapn:
+		// the APN is shown as is, without decoding the labels
+		return []byte(value), nil
+	case ieTfseid:
+		return parseTextFSeid(value)
+	case ieTfteid:
+		return parseTextFTeid(value)
+	case ieTnodeid:
+		if addr, err := netip.ParseAddr(value); err == nil {
+			return Encode_NodeIdIp(addr), nil
+		} else {
+			return Encode_NodeIdFqdn(strings.Split(value, ".")...), nil
+		}
+	case ieTOuterHeaderCreate:
+		return parseTextOuterHeaderCreate(value)
+	case ieTApplyAction:
+		return parseTextApplyAction(value)
+	case ieTUPIpResInfo:
+		return parseTextUpIpResInfo(value)
+	case ieTsourceIpAddress:
+		return parseTextSourceIpAddress(value)
+	default:
+		return hex.DecodeString(value)
+	}
+}
+
+// parseTextIntegral encodes in the width of the typed value, or otherwise in the fewest octets
+func parseTextIntegral(tc IeTypeCode, value string) ([]byte, error) {
+	u64, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integer '%s'", value)
+	}
+	if typed := NewTypedIe(tc); typed != nil {
+		if field := reflect.ValueOf(typed).Elem().FieldByName("Value"); field.IsValid() && field.CanUint() {
+			if field.OverflowUint(u64) {
+				return nil, fmt.Errorf("%d exceeds %d bits", u64, field.Type().Bits())
+			}
+			field.SetUint(u64)
+			return typed.Encode(), nil
+		}
+	}
+	bytes := []byte{byte(u64)}
+	for u64 >>= 8; u64 != 0; u64 >>= 8 {
+		bytes = append([]byte{byte(u64)}, bytes...)
+	}
+	return bytes, nil
+}
+
+func parseEnumInterface(value string) (EnumInterface, error) {
+	for enumInterface, name := range EnumInterfaceNames {
+		if name == value {
+			return enumInterface, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown interface '%s'", value)
+}
+
+// parseTextAddrs is the inverse of showAddrs
+func parseTextAddrs(value string) (v4, v6 netip.Addr, err error) {
+	var addrs []netip.Addr
+	for _, s := range strings.Split(value, ",") {
+		if addr, err := netip.ParseAddr(s); err != nil {
+			return v4, v6, fmt.Errorf("invalid address '%s'", s)
+		} else {
+			addrs = append(addrs, addr)
+		}
+	}
+	v4, v6 = splitAddrs(addrs...)
+	return v4, v6, nil
+}
+
+func parseTextUint8(value, prefix string) (uint8, error) {
+	if u8, err := strconv.ParseUint(strings.TrimPrefix(value, prefix), 10, 8); err != nil {
+		return 0, fmt.Errorf("invalid '%s'", value)
+	} else {
+		return uint8(u8), nil
+	}
+}
+
+// "dst choose(v4) 192.0.2.1,2001:db8::1 delegation bits:8 prefix length:64"
+func parseTextUeIpAddress(value string) (bytes []byte, err error) {
+	var ueIpAddress UeIpAddress
+	words := strings.Fields(strings.NewReplacer("delegation bits:", "delegation-bits:", "prefix length:", "prefix-length:").Replace(value))
+	if len(words) == 0 || (words[0] != "src" && words[0] != "dst") {
+		return nil, fmt.Errorf("expected 'src' or 'dst'")
+	}
+	ueIpAddress.Destination = words[0] == "dst"
+	for _, word := range words[1:] {
+		switch {
+		case word == "choose(v4)":
+			ueIpAddress.ChooseV4 = true
+		case word == "choose(v6)":
+			ueIpAddress.ChooseV6 = true
+		case strings.HasPrefix(word, "delegation-bits:"):
+			ueIpAddress.PrefixDelegationBits, err = parseTextUint8(word, "delegation-bits:")
+		case strings.HasPrefix(word, "prefix-length:"):
+			ueIpAddress.PrefixLength, err = parseTextUint8(word, "prefix-length:")
+		default:
+			ueIpAddress.IpV4, ueIpAddress.IpV6, err = parseTextAddrs(word)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return Encode_UeIpAddress(ueIpAddress), nil
+}
+
+// "0000000001 : 192.0.2.1"
+func parseTextFSeid(value string) ([]byte, error) {
+	seidText, addrsText, hasAddrs := strings.Cut(value, " : ")
+	var v4, v6 netip.Addr
+	if seid, err := strconv.ParseUint(seidText, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid SEID '%s'", seidText)
+	} else if !hasAddrs {
+		return Encode_FSeid(seid), nil
+	} else if v4, v6, err = parseTextAddrs(addrsText); err != nil {
+		return nil, err
+	} else {
+		return Encode_FSeid(seid, v4, v6), nil
+	}
+}
+
+// "choose(v4) id:3", or "192.0.2.1:1234"
+func parseTextFTeid(value string) ([]byte, error) {
+	if choose, found := strings.CutPrefix(value, "choose"); found {
+		fteid := FTeid{}
+		choose, chooseId, hasChooseId := strings.Cut(choose, " id:")
+		fteid.ChooseV4 = strings.Contains(choose, "(v4)")
+		fteid.ChooseV6 = strings.Contains(choose, "(v6)")
+		if hasChooseId {
+			if id, err := parseTextUint8(chooseId, ""); err != nil {
+				return nil, err
+			} else {
+				fteid.ChooseId = &id
+			}
+		}
+		return Encode_FTeid(fteid), nil
+	} else if separator := strings.LastIndex(value, ":"); separator < 0 {
+		return nil, fmt.Errorf("expected 'choose' or '<addresses>:<teid>'")
+	} else if teid, err := strconv.ParseUint(value[separator+1:], 10, 32); err != nil {
+		return nil, fmt.Errorf("invalid TEID '%s'", value[separator+1:])
+	} else if v4, v6, err := parseTextAddrs(value[:separator]); err != nil {
+		return nil, err
+	} else {
+		return Encode_FTeid(*NewFTeid(TEID(teid), v4, v6)), nil
+	}
+}
+
+// "teid:004d2 ipv4:192.0.2.1 ipv6:2001:db8::1"
+func parseTextOuterHeaderCreate(value string) ([]byte, error) {
+	var teid uint64
+	var addrs []netip.Addr
+	var err error
+	for _, word := range strings.Fields(value) {
+		var addr netip.Addr
+		if teidText, found := strings.CutPrefix(word, "teid:"); found {
+			teid, err = strconv.ParseUint(teidText, 16, 32)
+		} else if addrText, found := strings.CutPrefix(word, "ipv4:"); found {
+			addr, err = netip.ParseAddr(addrText)
+		} else if addrText, found := strings.CutPrefix(word, "ipv6:"); found {
+			addr, err = netip.ParseAddr(addrText)
+		} else {
+			err = fmt.Errorf("unexpected '%s'", word)
+		}
+		if err != nil {
+			return nil, err
+		} else if addr.IsValid() {
+			addrs = append(addrs, addr)
+		}
+	}
+	return Encode_OuterHeaderCreation(TEID(teid), addrs...), nil
+}
+
+var textApplyActions = map[string]EnumAction{"DROP": EnumDrop, "FORW": EnumForw, "BUFF": EnumBuff, "NOCP": EnumNocp}
+
+func parseTextApplyAction(value string) ([]byte, error) {
+	if action, found := textApplyActions[value]; found {
+		return Encode_ApplyAction(action), nil
+	} else {
+		return nil, fmt.Errorf("unknown action '%s'", value)
+	}
+}
+
+// "192.0.2.1 teid range:5/3 internet Core"
+// the network instance is the text between the other fields, and the source interface is the last word
+func parseTextUpIpResInfo(value string) ([]byte, error) {
+	var upIpResInfo UpIpResInfo
+	addrsText, rest, _ := strings.Cut(value, " ")
+	if v4, v6, err := parseTextAddrs(addrsText); err != nil {
+		return nil, err
+	} else {
+		upIpResInfo.IpV4, upIpResInfo.IpV6 = v4, v6
+	}
+	if teidRange, found := strings.CutPrefix(rest, "teid range:"); found {
+		teidRange, rest, _ = strings.Cut(teidRange, " ")
+		if _, err := fmt.Sscanf(teidRange, "%d/%d", &upIpResInfo.TeidRange, &upIpResInfo.TeidRangeIndication); err != nil {
+			return nil, fmt.Errorf("invalid TEID range '%s'", teidRange)
+		}
+	}
+	for enumInterface, name := range EnumInterfaceNames {
+		if networkInstance, found := strings.CutSuffix(rest, name); found && (networkInstance == "" || strings.HasSuffix(networkInstance, " ")) {
+			sourceInterface := enumInterface
+			upIpResInfo.SourceInterface = &sourceInterface
+			rest = strings.TrimSuffix(networkInstance, " ")
+			break
+		}
+	}
+	if rest != "" {
+		upIpResInfo.NetworkInstance = []byte(rest)
+	}
+	return Encode_UpIpResInfo(upIpResInfo), nil
+}
+
+// "192.0.2.1/24"
+func parseTextSourceIpAddress(value string) ([]byte, error) {
+	var sourceIpAddress SourceIpAddress
+	addrsText, maskText, hasMask := strings.Cut(value, "/")
+	var err error
+	if sourceIpAddress.IpV4, sourceIpAddress.IpV6, err = parseTextAddrs(addrsText); err != nil {
+		return nil, err
+	} else if !hasMask {
+	} else if sourceIpAddress.MaskPrefixLength, err = parseTextUint8(maskText, ""); err != nil {
+		return nil, err
+	}
+	return Encode_SourceIpAddress(sourceIpAddress), nil
+}
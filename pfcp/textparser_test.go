@@ -0,0 +1,89 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+)
+
+func TestParseTextDump(t *testing.T) {
+	ueIpAddress := UeIpAddress{Destination: true, IpV6: testV6, ChooseV4: true, PrefixDelegationBits: 8, PrefixLength: 64}
+	sourceInterface := EnumCore
+	others := NewNodeMessage(PFCP_Association_Setup_Response,
+		IE_Cause(CauseAccepted),
+		IE_NodeIdIp(testV6),
+		IE_RecoveryTimeStamp(1),
+		*NewIeNode(UE_IP_Address, Encode_UeIpAddress(ueIpAddress)),
+		*NewIeNode(User_Plane_IP_Resource_Information, Encode_UpIpResInfo(UpIpResInfo{TeidRangeIndication: 3, TeidRange: 5, IpV4: testV4, NetworkInstance: []byte("internet"), SourceInterface: &sourceInterface})),
+		*NewIeNode(Source_IP_Address, Encode_SourceIpAddress(SourceIpAddress{IpV4: testV4, MaskPrefixLength: 24})),
+		IE_OuterHeaderCreation(1234, testV4, testV6),
+		IE_FTeid(FTeid{ChooseV6: true, ChooseId: new(uint8)}),
+		IE_FTeid_Ip(77, testV6),
+		IE_FSeid(5, testV4, testV6),
+		*NewIeNode(F_SEID, []byte{1, 2}),
+		*NewIeNode(IeTypeCode(4000), []byte{0xab}),
+	)
+	others.SequenceNumber = 99
+
+	for _, msg := range append([]*PfcpMessage{ser2, vendorTestMessage(), others}, TestSet1...) {
+		if parsed, err := ParseText(msg.Dumper()); err != nil {
+			t.Errorf("%s: %s in\n%s", msg.TypeCode(), err.Error(), msg.Dumper())
+		} else if !bytes.Equal(parsed.Serialise(), msg.Serialise()) {
+			t.Errorf("%s: read back as\n%s\nfrom\n%s", msg.TypeCode(), parsed.Dumper(), msg.Dumper())
+		}
+	}
+}
+
+func TestParseTextScenario(t *testing.T) {
+	text := `
+PFCP Message type=Session Establishment Request,seid=7, seq=1
+# a scenario written by hand, with identifiers and hex payloads
+Node_ID: 192.0.2.1
+F-SEID: 1 : 192.0.2.1
+Create PDR
+    PDR ID: 1
+    Precedence: 100
+    PDI
+        Source Interface: Access
+        F-TEID: choose(v4)
+    FAR ID: 1
+Create_FAR
+    FAR ID: 1
+    Apply Action: hex:0400
+`
+	expected := NewSessionMessage(PFCP_Session_Establishment_Request, 7,
+		IE_NodeIdIp(netip.MustParseAddr("192.0.2.1")),
+		IE_FSeid(1, netip.MustParseAddr("192.0.2.1")),
+		*NewIeNode(Create_PDR, nil),
+		*NewIeNode(Create_FAR, nil),
+	)
+	expected.SequenceNumber = 1
+	expected.iEnodes[2].ies = []IeNode{IE_PdrId(1), IE_Precedence(100), *NewIeNode(PDI, nil), IE_FarId(1)}
+	expected.iEnodes[2].ies[2].ies = []IeNode{IE_SourceInterface(EnumAccess), IE_FTeid_Choose_IpV4()}
+	expected.iEnodes[3].ies = []IeNode{IE_FarId(1), *NewIeNode(Apply_Action, []byte{4, 0})}
+
+	if msg, err := ParseText(text); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(msg.Serialise(), expected.Serialise()) {
+		t.Errorf("scenario read as\n%s", msg.Dumper())
+	} else if err := msg.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"PFCP Message type=No Such Request,seid=not present, seq=0",
+		"PFCP Message type=Heartbeat Request,seid=not present, seq=0\nNo Such IE: 1",
+		"PFCP Message type=Heartbeat Request,seid=not present, seq=0\nRecovery Time Stamp: x",
+		"PFCP Message type=Heartbeat Request,seid=not present, seq=0\nRecovery Time Stamp: 1\n  PDR ID: 1",
+		"PFCP Message type=Heartbeat Request,seid=not present, seq=0\nRecovery Time Stamp",
+		"PFCP Message type=Heartbeat Request,seid=not present, seq=0\nPDR ID: 65536",
+	} {
+		if _, err := ParseText(invalid); err == nil {
+			t.Errorf("%q accepted", invalid)
+		}
+	}
+}
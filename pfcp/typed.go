@@ -0,0 +1,120 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// TypedIe is implemented by the generated T_<IE> struct of every non-group IE in the catalogue.
+// Decode never panics, any payload which cannot be represented is an error.
+type TypedIe interface {
+	TypeCode() IeTypeCode
+	Decode(bytes []byte) error
+	Encode() []byte
+}
+
+// NewTypedIe returns an empty typed value for the type code, or nil for group and unknown IEs
+func NewTypedIe(tc IeTypeCode) TypedIe {
+	if newTypedIe, found := typedIes[tc]; found {
+		return newTypedIe()
+	} else {
+		return nil
+	}
+}
+
+func IE_Typed(ie TypedIe) IeNode {
+	return *NewIeNode(ie.TypeCode(), ie.Encode())
+}
+
+// Typed decodes the IE into the typed struct for its type code, e.g. *T_F_TEID
+func (node *IeNode) Typed() (TypedIe, error) {
+	if ie := NewTypedIe(node.IeTypeCode); ie == nil || node.IeTypeCode.IsVendor() {
+		return nil, fmt.Errorf("no typed IE for %s", node.name())
+	} else if err := ie.Decode(node.bytes); err != nil {
+		return nil, fmt.Errorf("%s: %s", node.IeTypeCode, err.Error())
+	} else {
+		return ie, nil
+	}
+}
+
+// DecodeInto decodes the IE into a caller supplied typed struct, which must match the type code of the IE
+func (node *IeNode) DecodeInto(ie TypedIe) error {
+	if ie.TypeCode() != node.IeTypeCode || node.IeTypeCode.IsVendor() {
+		return fmt.Errorf("type mismatch, %s is not %s", node.name(), ie.TypeCode())
+	} else if err := ie.Decode(node.bytes); err != nil {
+		return fmt.Errorf("%s: %s", node.IeTypeCode, err.Error())
+	} else {
+		return nil
+	}
+}
+
+func (get Get) Typed() (TypedIe, error) {
+	if node, err := get.Return(); err != nil {
+		return nil, err
+	} else {
+		return node.Typed()
+	}
+}
+
+// e.g. get.GetByTc(F_TEID).DecodeInto(&fteid), where fteid is a T_F_TEID
+func (get Get) DecodeInto(ie TypedIe) error {
+	if node, err := get.Return(); err != nil {
+		return err
+	} else {
+		return node.DecodeInto(ie)
+	}
+}
+
+// decoders for the scalar layouts of the generated structs
+
+func decodeUint8(bytes []byte) (uint8, error) {
+	if len(bytes) != 1 {
+		return 0, fmt.Errorf("wrong length (%d) for 8 bit value", len(bytes))
+	} else {
+		return bytes[0], nil
+	}
+}
+
+func decodeUint16(bytes []byte) (uint16, error) {
+	if len(bytes) != 2 {
+		return 0, fmt.Errorf("wrong length (%d) for 16 bit value", len(bytes))
+	} else {
+		return binary.BigEndian.Uint16(bytes), nil
+	}
+}
+
+func decodeUint32(bytes []byte) (uint32, error) {
+	if len(bytes) != 4 {
+		return 0, fmt.Errorf("wrong length (%d) for 32 bit value", len(bytes))
+	} else {
+		return binary.BigEndian.Uint32(bytes), nil
+	}
+}
+
+func decodeUint64(bytes []byte) (uint64, error) {
+	if len(bytes) != 8 {
+		return 0, fmt.Errorf("wrong length (%d) for 64 bit value", len(bytes))
+	} else {
+		return binary.BigEndian.Uint64(bytes), nil
+	}
+}
+
+// the decoded value does not alias the message buffer
+func decodeOctets(bytes []byte) ([]byte, error) {
+	return append([]byte{}, bytes...), nil
+}
+
+func encodeOctets(bytes []byte) []byte {
+	return bytes
+}
+
+func decodeString(bytes []byte) (string, error) {
+	return string(bytes), nil
+}
+
+func encodeString(s string) []byte {
+	return []byte(s)
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkTypedRoundTrip requires that any payload which decodes is re-encoded to a stable form,
+// i.e. decode(encode(v)) == v, and encode is byte exact from then on.
+func checkTypedRoundTrip(t *testing.T, tc IeTypeCode, payload []byte) (decoded bool) {
+	first := NewTypedIe(tc)
+	if err := first.Decode(payload); err != nil {
+		return false
+	}
+	encoded := first.Encode()
+	second := NewTypedIe(tc)
+	if err := second.Decode(encoded); err != nil {
+		t.Errorf("%s: re-encoded % x from % x does not decode: %s", tc, encoded, payload, err.Error())
+	} else if !reflect.DeepEqual(first, second) {
+		t.Errorf("%s: % x decodes to %+v, but re-encoded as % x decodes to %+v", tc, payload, first, encoded, second)
+	} else if reencoded := second.Encode(); !bytes.Equal(encoded, reencoded) {
+		t.Errorf("%s: encoding is not stable, % x then % x", tc, encoded, reencoded)
+	}
+	return true
+}
+
+// IEs from the existing encoders
+var encodedIes = []IeNode{
+	IE_FTeid_Ip(1234, testV4, testV6),
+	IE_FSeid(77, testV6),
+	IE_NodeIdFqdn("smf0", "ng4tcom"),
+	IE_NodeIdIp(testV6),
+	IE_UeIpAddress(testV4),
+	IE_OuterHeaderCreation(2000, testV4),
+	IE_UpIpRsrcInfo(testV4, testV6),
+	IE_SourceIpAddress(testV6),
+	IE_GateStatus(GateOpen),
+	IE_Mbr(BitRate{Uplink: 1 << 32, Downlink: 12345}),
+	IE_SourceInterface(EnumCore),
+	IE_PdrId(7),
+}
+
+// seedPayloads are the IE payloads found in the reference messages, and those of the existing encoders
+func seedPayloads() map[IeTypeCode][][]byte {
+	seeds := map[IeTypeCode][][]byte{}
+	for _, ie := range encodedIes {
+		seeds[ie.IeTypeCode] = append(seeds[ie.IeTypeCode], ie.bytes)
+	}
+	var walk func(ies []IeNode)
+	walk = func(ies []IeNode) {
+		for _, ie := range ies {
+			if ie.ies != nil {
+				walk(ie.ies)
+			} else {
+				seeds[ie.IeTypeCode] = append(seeds[ie.IeTypeCode], ie.bytes)
+			}
+		}
+	}
+	for _, msg := range []*PfcpMessage{ser2, vendorTestMessage()} {
+		if parsed, err := ParseValidate(msg.Serialise()); err == nil {
+			walk(parsed.iEnodes)
+		}
+	}
+	return seeds
+}
+
+func TestTypedIeRoundTrip(t *testing.T) {
+	seeds := seedPayloads()
+	for tc := range typedIes {
+		// a source for each type, so that the payloads do not depend on the map order
+		random := rand.New(rand.NewSource(29244 + int64(tc)))
+		decoded := 0
+		for _, seed := range seeds[tc] {
+			if !checkTypedRoundTrip(t, tc, seed) {
+				t.Errorf("%s: reference payload % x does not decode", tc, seed)
+			}
+		}
+		// random payloads, and the seed payloads and the encoded zero value, each with a random octet
+		mutable := append(seeds[tc], NewTypedIe(tc).Encode())
+		for i := 0; i < 2000; i++ {
+			var payload []byte
+			if i%2 == 0 {
+				payload = make([]byte, random.Intn(40))
+				random.Read(payload)
+			} else if payload = append([]byte{}, mutable[random.Intn(len(mutable))]...); len(payload) != 0 {
+				payload[random.Intn(len(payload))] = byte(random.Intn(256))
+			}
+			if checkTypedRoundTrip(t, tc, payload) {
+				decoded++
+			}
+		}
+		if decoded == 0 {
+			t.Errorf("%s: no random payload decoded", tc)
+		}
+	}
+}
+
+func FuzzTypedIe(f *testing.F) {
+	for tc, payloads := range seedPayloads() {
+		for _, payload := range payloads {
+			f.Add(uint16(tc), payload)
+		}
+	}
+	f.Fuzz(func(t *testing.T, tc uint16, payload []byte) {
+		if NewTypedIe(IeTypeCode(tc)) != nil {
+			checkTypedRoundTrip(t, IeTypeCode(tc), payload)
+		}
+	})
+}
+
+func TestTypedIeAccess(t *testing.T) {
+	msg, err := ParseValidate(ser2.Serialise())
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := msg.Node().Getter()
+
+	var fteid T_F_TEID
+	var precedence T_Precedence
+	if err := root.GetById(Create_PDR, 0).GetByTc(PDI).GetByTc(F_TEID).DecodeInto(&fteid); err != nil {
+		t.Error(err)
+	} else if !fteid.ChooseV4 || fteid.Teid != nil {
+		t.Errorf("choose FTEID decoded as %s", fteid.FTeid)
+	}
+	if err := root.GetById(Create_PDR, 32768).GetByTc(Precedence).DecodeInto(&precedence); err != nil {
+		t.Error(err)
+	} else if precedence.Value != 0x20 {
+		t.Errorf("precedence decoded as %d", precedence.Value)
+	}
+	if err := root.GetById(Create_PDR, 0).GetByTc(PDI).DecodeInto(&fteid); err == nil {
+		t.Errorf("group IE decoded as F-TEID")
+	}
+	if err := root.GetByTc(F_TEID).DecodeInto(&fteid); err == nil {
+		t.Errorf("missing IE decoded")
+	}
+
+	if typed, err := root.GetById(Create_FAR, 0).GetByTc(Apply_Action).Typed(); err != nil {
+		t.Error(err)
+	} else if applyAction, ok := typed.(*T_Apply_Action); !ok {
+		t.Errorf("Apply Action decoded as %T", typed)
+	} else if !applyAction.Has(ApplyActionForw) || applyAction.Has(ApplyActionDrop) {
+		t.Errorf("Apply Action FORW decoded as %b", applyAction.Bits)
+	}
+	if _, err := root.GetById(Create_FAR, 0).Typed(); err == nil {
+		t.Errorf("group IE has a typed value")
+	}
+}
+
+func TestTypedIeEncode(t *testing.T) {
+	total, downlink := uint64(1000), uint64(400)
+	volumeThreshold := T_Volume_Threshold{Volume{Total: &total, Downlink: &downlink}}
+	node := IE_Typed(&volumeThreshold)
+	if node.IeTypeCode != Volume_Threshold || !bytes.Equal(node.bytes[:1], []byte{0b101}) || len(node.bytes) != 17 {
+		t.Errorf("volume threshold encoded as % x", node.bytes)
+	}
+
+	reportingTriggers := T_Reporting_Triggers{NewFlags(ReportingTriggerVolth, ReportingTriggerVolqu)}
+	if encoded := reportingTriggers.Encode(); !bytes.Equal(encoded, []byte{0b10, 0b1}) {
+		t.Errorf("reporting triggers encoded as % x", encoded)
+	}
+
+	// the typed form of an IE agrees with the existing encoders
+	for _, ie := range encodedIes {
+		if typed, err := ie.Typed(); err != nil {
+			t.Errorf("%s: %s", ie.IeTypeCode, err.Error())
+		} else if encoded := typed.Encode(); !bytes.Equal(encoded, ie.bytes) {
+			t.Errorf("%s: % x encoded as % x", ie.IeTypeCode, ie.bytes, encoded)
+		}
+	}
+}
+
+// payloads of the structured IEs, as given by TS 29.244
+func TestTypedIeLayouts(t *testing.T) {
+	flowDescription, sdfFilterId := "permit out ip from any to assigned", uint32(7)
+	otherServerAddress := "2001:db8::1"
+	ppi, qfi := uint8(3), uint8(9)
+	for _, test := range []struct {
+		payload []byte
+		typed   TypedIe
+	}{
+		{append(append([]byte{0x11, 0, 0, 34}, flowDescription...), 0, 0, 0, 7),
+			&T_SDF_Filter{SdfFilter{FlowDescription: &flowDescription, SdfFilterId: &sdfFilterId}}},
+		{append([]byte{0x04, 0, 8, '1', '0', '.', '0', '.', '0', '.', '1', 0, 11}, otherServerAddress...),
+			&T_Redirect_Information{RedirectInformation{AddressType: 4, ServerAddress: "10.0.0.1", OtherServerAddress: &otherServerAddress}}},
+		{[]byte{0x03, 3, 9}, &T_Downlink_Data_Service_Information{DownlinkDataServiceInformation{PagingPolicyIndication: &ppi, Qfi: &qfi}}},
+		{[]byte{0x02, 192, 0, 2, 1, 0x00, 0x01, 0x00, 0x02}, &T_FQ_CSID{FqCsid{NodeAddress: []byte{192, 0, 2, 1}, Csids: []uint16{1, 2}}}},
+		{[]byte{0x02, 192, 0, 2, 1}, &T_Remote_GTP_U_Peer{RemoteGtpUPeer{IpV4: testV4}}},
+		{[]byte{0x01, 0, 0, 0, 9}, &T_Failed_Rule_ID{FailedRuleId{RuleIdType: 1, RuleId: 9}}},
+		{[]byte{1, 0, 0, 1}, &T_S_NSSAI{SNssai{Sst: 1, Sd: 1}}},
+	} {
+		decoded := NewTypedIe(test.typed.TypeCode())
+		if err := decoded.Decode(test.payload); err != nil {
+			t.Errorf("%s: %s", test.typed.TypeCode(), err.Error())
+		} else if !reflect.DeepEqual(decoded, test.typed) {
+			t.Errorf("%s: % x decoded as %+v", test.typed.TypeCode(), test.payload, decoded)
+		} else if encoded := test.typed.Encode(); !bytes.Equal(encoded, test.payload) {
+			t.Errorf("%s: %+v encoded as % x", test.typed.TypeCode(), test.typed, encoded)
+		}
+	}
+}
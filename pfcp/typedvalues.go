@@ -0,0 +1,720 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Decode/Encode methods for the value types which are embedded in the generated T_<IE> structs.
+// Decode is the inverse of Encode, but, spare bits and unsupported optional fields are not preserved.
+
+func (fteid *FTeid) Decode(bytes []byte) error {
+	if t, err := getFteid(bytes); err != nil {
+		return err
+	} else {
+		*fteid = *t
+		return nil
+	}
+}
+
+func (fteid FTeid) Encode() []byte { return Encode_FTeid(fteid) }
+
+func (fseid *FSeid) Decode(bytes []byte) error {
+	if t, err := getFSeid(bytes); err != nil {
+		return err
+	} else {
+		*fseid = *t
+		return nil
+	}
+}
+
+func (fseid FSeid) Encode() []byte { return Encode_FSeid(uint64(fseid.Seid), fseid.Addrs()...) }
+
+func (nodeId *NodeId) Decode(bytes []byte) error {
+	if t, err := getNodeId(bytes); err != nil {
+		return err
+	} else {
+		*nodeId = *t
+		return nil
+	}
+}
+
+func (nodeId NodeId) Encode() []byte {
+	if nodeId.Addr.IsValid() {
+		return Encode_NodeIdIp(nodeId.Addr)
+	} else {
+		return Encode_NodeIdFqdn(strings.Split(nodeId.Fqdn, ".")...)
+	}
+}
+
+func (ueIpAddress *UeIpAddress) Decode(bytes []byte) error {
+	if t, err := getUeIpAddress(bytes); err != nil {
+		return err
+	} else {
+		*ueIpAddress = *t
+		return nil
+	}
+}
+
+func (ueIpAddress UeIpAddress) Encode() []byte { return Encode_UeIpAddress(ueIpAddress) }
+
+func (outerHeaderCreate *OuterHeaderCreate) Decode(bytes []byte) error {
+	if t, err := getOuterHeaderCreate(bytes); err != nil {
+		return err
+	} else {
+		*outerHeaderCreate = *t
+		return nil
+	}
+}
+
+func (outerHeaderCreate OuterHeaderCreate) Encode() []byte {
+	return Encode_OuterHeaderCreation(outerHeaderCreate.Teid, outerHeaderCreate.Addrs()...)
+}
+
+func (upIpResInfo *UpIpResInfo) Decode(bytes []byte) error {
+	if t, err := getUpIpResInfo(bytes); err != nil {
+		return err
+	} else {
+		if t.NetworkInstance != nil {
+			t.NetworkInstance, _ = decodeOctets(t.NetworkInstance)
+		}
+		*upIpResInfo = *t
+		return nil
+	}
+}
+
+func (upIpResInfo UpIpResInfo) Encode() []byte { return Encode_UpIpResInfo(upIpResInfo) }
+
+func (sourceIpAddress *SourceIpAddress) Decode(bytes []byte) error {
+	if t, err := getSourceIpAddress(bytes); err != nil {
+		return err
+	} else {
+		*sourceIpAddress = *t
+		return nil
+	}
+}
+
+func (sourceIpAddress SourceIpAddress) Encode() []byte {
+	return Encode_SourceIpAddress(sourceIpAddress)
+}
+
+func (enumInterface *EnumInterface) Decode(bytes []byte) (err error) {
+	*enumInterface, err = readEnumInterface(bytes)
+	return
+}
+
+func (enumInterface EnumInterface) Encode() []byte { return Encode_InterfaceType(enumInterface) }
+
+// TS29.244 8.2.7
+func (gateStatus *GateStatus) Decode(bytes []byte) error {
+	if len(bytes) != 1 {
+		return fmt.Errorf("wrong length (%d) for gate status", len(bytes))
+	} else {
+		gateStatus.UlGate = EnumGateStatus(bytes[0]>>2) & 0b11
+		gateStatus.DlGate = EnumGateStatus(bytes[0]) & 0b11
+		return nil
+	}
+}
+
+func (gateStatus GateStatus) Encode() []byte { return Encode_GateStatus(gateStatus) }
+
+// TS29.244 8.2.8, MBR and GBR
+func (bitRate *BitRate) Decode(bytes []byte) error {
+	if len(bytes) != 10 {
+		return fmt.Errorf("wrong length (%d) for bit rates", len(bytes))
+	} else {
+		bitRate.Uplink = readIntegral(bytes[0:5])
+		bitRate.Downlink = readIntegral(bytes[5:10])
+		return nil
+	}
+}
+
+func (bitRate BitRate) Encode() []byte { return Encode_BitRates(bitRate) }
+
+// Flags is the common form of the many bit flag IEs, e.g. Apply Action and Reporting Triggers.
+// Bit 1 of the first octet is FlagBit 0, bit 1 of the second octet is FlagBit 8, and so on.
+type Flags struct {
+	Bits   uint64
+	Octets uint8 // the received length, kept so that re-encoding is byte exact
+}
+
+type FlagBit uint8
+
+// TS29.244 8.2.26
+const (
+	ApplyActionDrop FlagBit = iota
+	ApplyActionForw
+	ApplyActionBuff
+	ApplyActionNocp
+	ApplyActionDupl
+	ApplyActionIpma
+	ApplyActionIpmd
+	ApplyActionDfrt
+	ApplyActionEdrt
+	ApplyActionBdpn
+	ApplyActionDdpn
+)
+
+// TS29.244 8.2.19
+const (
+	ReportingTriggerPerio FlagBit = iota
+	ReportingTriggerVolth
+	ReportingTriggerTimth
+	ReportingTriggerQuhti
+	ReportingTriggerStart
+	ReportingTriggerStopt
+	ReportingTriggerDroth
+	ReportingTriggerLiusa
+	ReportingTriggerVolqu
+	ReportingTriggerTimqu
+	ReportingTriggerEnvcl
+	ReportingTriggerMacar
+	ReportingTriggerEveth
+	ReportingTriggerEvequ
+	ReportingTriggerIpmjl
+	ReportingTriggerQuvti
+	ReportingTriggerReemr
+	ReportingTriggerUpint
+)
+
+// TS29.244 8.2.21
+const (
+	ReportTypeDldr FlagBit = iota
+	ReportTypeUsar
+	ReportTypeErir
+	ReportTypeUpir
+	ReportTypeTmir
+	ReportTypeSesr
+	ReportTypeUisr
+)
+
+// TS29.244 8.2.40
+const (
+	MeasurementMethodDurat FlagBit = iota
+	MeasurementMethodVolum
+	MeasurementMethodEvent
+)
+
+func NewFlags(bits ...FlagBit) (flags Flags) {
+	for _, bit := range bits {
+		flags.Set(bit)
+	}
+	return
+}
+
+func (flags Flags) Has(bit FlagBit) bool {
+	return flags.Bits&(1<<bit) != 0
+}
+
+func (flags *Flags) Set(bit FlagBit) {
+	flags.Bits |= 1 << bit
+}
+
+func (flags *Flags) Decode(bytes []byte) error {
+	if len(bytes) == 0 || len(bytes) > 8 {
+		return fmt.Errorf("wrong length (%d) for flags", len(bytes))
+	} else {
+		flags.Bits = 0
+		for i := range bytes {
+			flags.Bits |= uint64(bytes[i]) << (8 * i)
+		}
+		flags.Octets = uint8(len(bytes))
+		return nil
+	}
+}
+
+func (flags Flags) Encode() (bytes []byte) {
+	for i := 0; i < int(flags.Octets) || flags.Bits>>(8*i) != 0 || i == 0; i++ {
+		bytes = append(bytes, uint8(flags.Bits>>(8*i)))
+	}
+	return
+}
+
+// TS29.244 8.2.13, and the same form in Volume Quota, Subsequent Volume Threshold/Quota
+// and (with the packet counts) Volume Measurement 8.2.44.
+// Absent fields are nil.
+type Volume struct {
+	Total, Uplink, Downlink                      *uint64
+	TotalPackets, UplinkPackets, DownlinkPackets *uint64
+}
+
+func (volume *Volume) fields() []**uint64 {
+	return []**uint64{&volume.Total, &volume.Uplink, &volume.Downlink, &volume.TotalPackets, &volume.UplinkPackets, &volume.DownlinkPackets}
+}
+
+func (volume *Volume) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	}
+	*volume = Volume{}
+	offset := 1
+	for i, field := range volume.fields() {
+		if bytes[0]&(1<<i) == 0 {
+		} else if len(bytes) < offset+8 {
+			return fmt.Errorf("volume IE too short")
+		} else {
+			value := binary.BigEndian.Uint64(bytes[offset : offset+8])
+			*field = &value
+			offset += 8
+		}
+	}
+	if offset != len(bytes) {
+		return fmt.Errorf("invalid volume IE length")
+	}
+	return nil
+}
+
+func (volume Volume) Encode() []byte {
+	bytes := []byte{0}
+	for i, field := range volume.fields() {
+		if *field != nil {
+			bytes[0] |= 1 << i
+			bytes = append(bytes, Encode_Uint64(**field)...)
+		}
+	}
+	return bytes
+}
+
+// TS29.244 8.2.64
+// ExtensionHeaderDeletion is nil when absent
+type OuterHeaderRemoval struct {
+	Description             uint8
+	ExtensionHeaderDeletion *uint8
+}
+
+func (outerHeaderRemoval *OuterHeaderRemoval) Decode(bytes []byte) error {
+	switch len(bytes) {
+	case 1:
+		*outerHeaderRemoval = OuterHeaderRemoval{Description: bytes[0]}
+	case 2:
+		extensionHeaderDeletion := bytes[1]
+		*outerHeaderRemoval = OuterHeaderRemoval{Description: bytes[0], ExtensionHeaderDeletion: &extensionHeaderDeletion}
+	default:
+		return fmt.Errorf("wrong length (%d) for outer header removal", len(bytes))
+	}
+	return nil
+}
+
+func (outerHeaderRemoval OuterHeaderRemoval) Encode() []byte {
+	if outerHeaderRemoval.ExtensionHeaderDeletion == nil {
+		return []byte{outerHeaderRemoval.Description}
+	} else {
+		return []byte{outerHeaderRemoval.Description, *outerHeaderRemoval.ExtensionHeaderDeletion}
+	}
+}
+
+// readField returns the field of the given length at offset, and the offset after it
+func readField(bytes []byte, offset, length int) ([]byte, int, error) {
+	if offset+length > len(bytes) {
+		return nil, offset, fmt.Errorf("IE too short")
+	} else {
+		return bytes[offset : offset+length], offset + length, nil
+	}
+}
+
+// readLengthField returns the field which follows a length of the given width at offset, and the offset after it
+func readLengthField(bytes []byte, offset, width int) ([]byte, int, error) {
+	if length, next, err := readField(bytes, offset, width); err != nil {
+		return nil, offset, err
+	} else {
+		return readField(bytes, next, int(readIntegral(length)))
+	}
+}
+
+func appendLengthField(bytes []byte, width int, field []byte) []byte {
+	if width == 1 {
+		bytes = append(bytes, uint8(len(field)))
+	} else {
+		bytes = append(bytes, Encode_Uint16(uint16(len(field)))...)
+	}
+	return append(bytes, field...)
+}
+
+func checkLength(bytes []byte, offset int) error {
+	if offset != len(bytes) {
+		return fmt.Errorf("invalid IE length")
+	}
+	return nil
+}
+
+// TS29.244 8.2.5
+// Absent fields are nil, FlowLabel has 24 bits
+type SdfFilter struct {
+	FlowDescription        *string
+	TosTrafficClass        *uint16
+	SecurityParameterIndex *uint32
+	FlowLabel              *uint32
+	SdfFilterId            *uint32
+}
+
+const (
+	sdfFilterFlagFD = 1 << iota
+	sdfFilterFlagTTC
+	sdfFilterFlagSPI
+	sdfFilterFlagFL
+	sdfFilterFlagBID
+)
+
+func (sdfFilter *SdfFilter) Decode(bytes []byte) error {
+	if len(bytes) < 2 {
+		return fmt.Errorf("SDF filter too short")
+	}
+	*sdfFilter = SdfFilter{}
+	flags, offset := bytes[0], 2
+	var field []byte
+	var err error
+	if flags&sdfFilterFlagFD != 0 {
+		if field, offset, err = readLengthField(bytes, offset, 2); err != nil {
+			return err
+		}
+		flowDescription := string(field)
+		sdfFilter.FlowDescription = &flowDescription
+	}
+	for _, f := range []struct {
+		flag   uint8
+		length int
+		value  func(uint64)
+	}{
+		{sdfFilterFlagTTC, 2, func(v uint64) { tosTrafficClass := uint16(v); sdfFilter.TosTrafficClass = &tosTrafficClass }},
+		{sdfFilterFlagSPI, 4, func(v uint64) { spi := uint32(v); sdfFilter.SecurityParameterIndex = &spi }},
+		{sdfFilterFlagFL, 3, func(v uint64) { flowLabel := uint32(v); sdfFilter.FlowLabel = &flowLabel }},
+		{sdfFilterFlagBID, 4, func(v uint64) { sdfFilterId := uint32(v); sdfFilter.SdfFilterId = &sdfFilterId }},
+	} {
+		if flags&f.flag == 0 {
+		} else if field, offset, err = readField(bytes, offset, f.length); err != nil {
+			return err
+		} else {
+			f.value(readIntegral(field))
+		}
+	}
+	return checkLength(bytes, offset)
+}
+
+func (sdfFilter SdfFilter) Encode() []byte {
+	bytes := []byte{0, 0}
+	if sdfFilter.FlowDescription != nil {
+		bytes[0] |= sdfFilterFlagFD
+		bytes = appendLengthField(bytes, 2, []byte(*sdfFilter.FlowDescription))
+	}
+	if sdfFilter.TosTrafficClass != nil {
+		bytes[0] |= sdfFilterFlagTTC
+		bytes = append(bytes, Encode_Uint16(*sdfFilter.TosTrafficClass)...)
+	}
+	if sdfFilter.SecurityParameterIndex != nil {
+		bytes[0] |= sdfFilterFlagSPI
+		bytes = append(bytes, Encode_Uint32(*sdfFilter.SecurityParameterIndex)...)
+	}
+	if sdfFilter.FlowLabel != nil {
+		bytes[0] |= sdfFilterFlagFL
+		bytes = append(bytes, Encode_Uint32(*sdfFilter.FlowLabel)[1:]...)
+	}
+	if sdfFilter.SdfFilterId != nil {
+		bytes[0] |= sdfFilterFlagBID
+		bytes = append(bytes, Encode_Uint32(*sdfFilter.SdfFilterId)...)
+	}
+	return bytes
+}
+
+// TS29.244 8.2.20
+// OtherServerAddress is nil when absent, it is present only for the address type 'IPv4 and IPv6 address'
+type RedirectInformation struct {
+	AddressType        uint8
+	ServerAddress      string
+	OtherServerAddress *string
+}
+
+func (redirectInformation *RedirectInformation) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	}
+	*redirectInformation = RedirectInformation{AddressType: bytes[0] & 0x0f}
+	if field, offset, err := readLengthField(bytes, 1, 2); err != nil {
+		return err
+	} else if redirectInformation.ServerAddress = string(field); offset == len(bytes) {
+		return nil
+	} else if field, offset, err = readLengthField(bytes, offset, 2); err != nil {
+		return err
+	} else {
+		otherServerAddress := string(field)
+		redirectInformation.OtherServerAddress = &otherServerAddress
+		return checkLength(bytes, offset)
+	}
+}
+
+func (redirectInformation RedirectInformation) Encode() []byte {
+	bytes := appendLengthField([]byte{redirectInformation.AddressType & 0x0f}, 2, []byte(redirectInformation.ServerAddress))
+	if redirectInformation.OtherServerAddress != nil {
+		bytes = appendLengthField(bytes, 2, []byte(*redirectInformation.OtherServerAddress))
+	}
+	return bytes
+}
+
+// TS29.244 8.2.23
+type ForwardingPolicy struct {
+	Identifier string
+}
+
+func (forwardingPolicy *ForwardingPolicy) Decode(bytes []byte) error {
+	if field, offset, err := readLengthField(bytes, 0, 1); err != nil {
+		return err
+	} else {
+		forwardingPolicy.Identifier = string(field)
+		return checkLength(bytes, offset)
+	}
+}
+
+func (forwardingPolicy ForwardingPolicy) Encode() []byte {
+	return appendLengthField(nil, 1, []byte(forwardingPolicy.Identifier))
+}
+
+// TS29.244 8.2.33
+// Absent fields are nil
+type DownlinkDataServiceInformation struct {
+	PagingPolicyIndication *uint8
+	Qfi                    *uint8
+}
+
+func (downlinkDataServiceInformation *DownlinkDataServiceInformation) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	}
+	*downlinkDataServiceInformation = DownlinkDataServiceInformation{}
+	offset := 1
+	for i, field := range []**uint8{&downlinkDataServiceInformation.PagingPolicyIndication, &downlinkDataServiceInformation.Qfi} {
+		if bytes[0]&(1<<i) == 0 {
+		} else if offset == len(bytes) {
+			return fmt.Errorf("IE too short")
+		} else {
+			value := bytes[offset] & 0x3f
+			*field = &value
+			offset++
+		}
+	}
+	return checkLength(bytes, offset)
+}
+
+func (downlinkDataServiceInformation DownlinkDataServiceInformation) Encode() []byte {
+	bytes := []byte{0}
+	for i, field := range []*uint8{downlinkDataServiceInformation.PagingPolicyIndication, downlinkDataServiceInformation.Qfi} {
+		if field != nil {
+			bytes[0] |= 1 << i
+			bytes = append(bytes, *field&0x3f)
+		}
+	}
+	return bytes
+}
+
+// TS29.244 8.2.46
+// NodeAddress is an IPv4 (NodeIdType 0) or IPv6 (1) address, or for NodeIdType 2, the MCC, MNC and a 12 bit integer
+type FqCsid struct {
+	NodeIdType  uint8
+	NodeAddress []byte
+	Csids       []uint16
+}
+
+var fqCsidNodeAddressLengths = []int{4, 16, 4}
+
+func (fqCsid *FqCsid) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	} else if nodeIdType := bytes[0] >> 4; int(nodeIdType) >= len(fqCsidNodeAddressLengths) {
+		return fmt.Errorf("invalid FQ-CSID node ID type %d", nodeIdType)
+	} else if nodeAddress, offset, err := readField(bytes, 1, fqCsidNodeAddressLengths[nodeIdType]); err != nil {
+		return err
+	} else if count := int(bytes[0] & 0x0f); offset+2*count != len(bytes) {
+		return fmt.Errorf("invalid IE length for %d CSIDs", count)
+	} else {
+		*fqCsid = FqCsid{NodeIdType: nodeIdType, NodeAddress: append([]byte{}, nodeAddress...)}
+		for ; offset < len(bytes); offset += 2 {
+			fqCsid.Csids = append(fqCsid.Csids, binary.BigEndian.Uint16(bytes[offset:offset+2]))
+		}
+		return nil
+	}
+}
+
+// Encode writes the node address at the length for its type, and at most 15 CSIDs, the limit of the IE
+func (fqCsid FqCsid) Encode() []byte {
+	nodeAddress := make([]byte, 4)
+	if int(fqCsid.NodeIdType) < len(fqCsidNodeAddressLengths) {
+		nodeAddress = make([]byte, fqCsidNodeAddressLengths[fqCsid.NodeIdType])
+	}
+	copy(nodeAddress, fqCsid.NodeAddress)
+	csids := fqCsid.Csids[:min(len(fqCsid.Csids), 15)]
+	bytes := append([]byte{fqCsid.NodeIdType<<4 | uint8(len(csids))}, nodeAddress...)
+	for _, csid := range csids {
+		bytes = append(bytes, Encode_Uint16(csid)...)
+	}
+	return bytes
+}
+
+// TS29.244 8.2.67
+type HeaderEnrichment struct {
+	HeaderType  uint8
+	Name, Value string
+}
+
+func (headerEnrichment *HeaderEnrichment) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	} else if name, offset, err := readLengthField(bytes, 1, 1); err != nil {
+		return err
+	} else if value, offset, err := readLengthField(bytes, offset, 1); err != nil {
+		return err
+	} else {
+		*headerEnrichment = HeaderEnrichment{HeaderType: bytes[0] & 0x1f, Name: string(name), Value: string(value)}
+		return checkLength(bytes, offset)
+	}
+}
+
+func (headerEnrichment HeaderEnrichment) Encode() []byte {
+	bytes := appendLengthField([]byte{headerEnrichment.HeaderType & 0x1f}, 1, []byte(headerEnrichment.Name))
+	return appendLengthField(bytes, 1, []byte(headerEnrichment.Value))
+}
+
+// TS29.244 8.2.70
+// DestinationInterface and NetworkInstance are nil when absent
+type RemoteGtpUPeer struct {
+	IpV4, IpV6           netip.Addr
+	DestinationInterface *EnumInterface
+	NetworkInstance      []byte
+}
+
+const (
+	remoteGtpUPeerFlagV6 = 1 << iota
+	remoteGtpUPeerFlagV4
+	remoteGtpUPeerFlagDI
+	remoteGtpUPeerFlagNI
+)
+
+func (remoteGtpUPeer *RemoteGtpUPeer) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	}
+	*remoteGtpUPeer = RemoteGtpUPeer{}
+	flags, offset := bytes[0], 1
+	var field []byte
+	var err error
+	if flags&remoteGtpUPeerFlagV4 != 0 {
+		if field, offset, err = readField(bytes, offset, 4); err != nil {
+			return err
+		}
+		remoteGtpUPeer.IpV4 = ReadIpV4(field)
+	}
+	if flags&remoteGtpUPeerFlagV6 != 0 {
+		if field, offset, err = readField(bytes, offset, 16); err != nil {
+			return err
+		}
+		remoteGtpUPeer.IpV6 = ReadIpV6(field)
+	}
+	if flags&remoteGtpUPeerFlagDI != 0 {
+		if field, offset, err = readLengthField(bytes, offset, 2); err != nil {
+			return err
+		} else if destinationInterface, err := readEnumInterface(field); err != nil {
+			return err
+		} else {
+			remoteGtpUPeer.DestinationInterface = &destinationInterface
+		}
+	}
+	if flags&remoteGtpUPeerFlagNI != 0 {
+		if field, offset, err = readLengthField(bytes, offset, 2); err != nil {
+			return err
+		}
+		remoteGtpUPeer.NetworkInstance = append([]byte{}, field...)
+	}
+	return checkLength(bytes, offset)
+}
+
+func (remoteGtpUPeer RemoteGtpUPeer) Encode() []byte {
+	bytes := []byte{0}
+	if remoteGtpUPeer.IpV4.IsValid() {
+		bytes[0] |= remoteGtpUPeerFlagV4
+		bytes = append(bytes, remoteGtpUPeer.IpV4.AsSlice()...)
+	}
+	if remoteGtpUPeer.IpV6.IsValid() {
+		bytes[0] |= remoteGtpUPeerFlagV6
+		bytes = append(bytes, remoteGtpUPeer.IpV6.AsSlice()...)
+	}
+	if remoteGtpUPeer.DestinationInterface != nil {
+		bytes[0] |= remoteGtpUPeerFlagDI
+		bytes = appendLengthField(bytes, 2, Encode_InterfaceType(*remoteGtpUPeer.DestinationInterface))
+	}
+	if remoteGtpUPeer.NetworkInstance != nil {
+		bytes[0] |= remoteGtpUPeerFlagNI
+		bytes = appendLengthField(bytes, 2, remoteGtpUPeer.NetworkInstance)
+	}
+	return bytes
+}
+
+// TS29.244 8.2.80
+// RuleIdType is 0 for a PDR, then FAR, QER, URR, BAR, MAR and SRR
+type FailedRuleId struct {
+	RuleIdType uint8
+	RuleId     uint32
+}
+
+var failedRuleIdLengths = []int{2, 4, 4, 4, 1, 2, 1}
+
+func (failedRuleId *FailedRuleId) Decode(bytes []byte) error {
+	if len(bytes) == 0 {
+		return fmt.Errorf("empty IE")
+	} else if ruleIdType := bytes[0] & 0x1f; int(ruleIdType) >= len(failedRuleIdLengths) {
+		return fmt.Errorf("invalid rule ID type %d", ruleIdType)
+	} else if len(bytes) != 1+failedRuleIdLengths[ruleIdType] {
+		return fmt.Errorf("wrong length (%d) for rule ID type %d", len(bytes), ruleIdType)
+	} else {
+		*failedRuleId = FailedRuleId{RuleIdType: ruleIdType, RuleId: uint32(readIntegral(bytes[1:]))}
+		return nil
+	}
+}
+
+func (failedRuleId FailedRuleId) Encode() []byte {
+	length := 4
+	if int(failedRuleId.RuleIdType) < len(failedRuleIdLengths) {
+		length = failedRuleIdLengths[failedRuleId.RuleIdType]
+	}
+	return append([]byte{failedRuleId.RuleIdType & 0x1f}, Encode_Uint32(failedRuleId.RuleId)[4-length:]...)
+}
+
+// TS29.244 8.2.87, the multiplier is ValueDigits * 10^Exponent
+type MultiplierValue struct {
+	ValueDigits int64
+	Exponent    int32
+}
+
+func (multiplierValue *MultiplierValue) Decode(bytes []byte) error {
+	if len(bytes) != 12 {
+		return fmt.Errorf("wrong length (%d) for multiplier", len(bytes))
+	} else {
+		multiplierValue.ValueDigits = int64(binary.BigEndian.Uint64(bytes[0:8]))
+		multiplierValue.Exponent = int32(binary.BigEndian.Uint32(bytes[8:12]))
+		return nil
+	}
+}
+
+func (multiplierValue MultiplierValue) Encode() []byte {
+	return append(Encode_Uint64(uint64(multiplierValue.ValueDigits)), Encode_Uint32(uint32(multiplierValue.Exponent))...)
+}
+
+// TS29.244 S-NSSAI, the SST and the 24 bit SD
+type SNssai struct {
+	Sst uint8
+	Sd  uint32
+}
+
+func (sNssai *SNssai) Decode(bytes []byte) error {
+	if len(bytes) != 4 {
+		return fmt.Errorf("wrong length (%d) for S-NSSAI", len(bytes))
+	} else {
+		*sNssai = SNssai{Sst: bytes[0], Sd: uint32(readIntegral(bytes[1:4]))}
+		return nil
+	}
+}
+
+func (sNssai SNssai) Encode() []byte {
+	return append([]byte{sNssai.Sst}, Encode_Uint32(sNssai.Sd)[1:]...)
+}
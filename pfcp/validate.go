@@ -4,9 +4,6 @@
 package pfcp
 
 import (
-	"fmt"
-	"strings"
-
 	log "github.com/sirupsen/logrus"
 )
 
@@ -15,54 +12,90 @@ import (
 // there should be a abstraction which avoids duplication, but, this is plain golang only....
 // Generics might work but their limitations and complexity are to painful to consider trying.
 
-// For initial use, use a string buffer to record each failing case.
-// Success is an empty buffer
+// Each failing case is recorded as a Violation in a ValidationError.
+// Success is an empty list of violations
+
+// Violations are first collected relative to the IE list being checked, because the ID of a group IE
+// is only known once its own list has been checked, and then placed under the path of the list.
 
-// func (thisIe ieNode) validate(sb *strings.Builder) {
-// 	// currently, validation of plain IEs always succeeds
-// }
+func (thisNode *IeNode) pathElement() IePathElement {
+	return IePathElement{TypeCode: thisNode.IeTypeCode, EnterpriseId: thisNode.enterpriseId, Id: thisNode.IeID}
+}
 
-func (thisNode *IeNode) validate(sb *strings.Builder) {
-	if !thisNode.IeTypeCode.isGroupIe() {
-	} else if attributeSet, present := groupIeAttributeSets[thisNode.IeTypeCode]; !present {
-		fmt.Fprintf(sb, "missing IE value in groupIeAttributeSets %d\n", thisNode.IeTypeCode)
+func (thisNode *IeNode) validate(verr *ValidationError, parentPath IePath, profile *ValidationProfile) {
+	if attributeSet, isGroup := profile.attributeSet(thisNode); !isGroup {
 	} else {
 		// first validate the local IE set
-		thisNode.IeID = validateAttributeSet(sb, &thisNode.ies, thisNode.IeTypeCode, attributeSet)
+		local := &ValidationError{}
+		thisNode.IeID = validateAttributeSet(local, &thisNode.ies, attributeSet, thisNode.vendorMembers(), profile.Strict)
+		if !thisNode.IeTypeCode.IsVendor() {
+			validateConditionalRules(local, thisNode.ies, groupConditionalRules[thisNode.IeTypeCode])
+		}
+		path := parentPath.append(thisNode.pathElement())
+		verr.addUnder(path, local)
 		// then, recursively validate
-		for i := range thisNode.ies {
-			thisNode.ies[i].validate(sb)
+		validateMembers(verr, thisNode.ies, attributeSet, path, profile)
+	}
+}
+
+// validateMembers recursively validates the IEs of a list, except, when lenient, those which are not in its attribute set
+func validateMembers(verr *ValidationError, ies []IeNode, attributeSet groupIeAttributeSet, path IePath, profile *ValidationProfile) {
+	for i := range ies {
+		if _, found := attributeSet[ies[i].IeTypeCode]; found || ies[i].IeTypeCode.IsVendor() || profile.Strict {
+			ies[i].validate(verr, path, profile)
 		}
 	}
 }
 
-func validateAttributeSet(sb *strings.Builder, ies *[]IeNode, source pfcpTypeCode, attributeSet groupIeAttributeSet) (groupId *IeID) {
-	// source parameter is used for context in reports/logs
+// addUnder adds the violations of a nested IE list, whose paths are relative to the list
+func (verr *ValidationError) addUnder(path IePath, nested *ValidationError) {
+	for _, violation := range nested.Violations {
+		violation.Path = append(append(IePath{}, path...), violation.Path...)
+		verr.Violations = append(verr.Violations, violation)
+	}
+}
+
+// IEs which are not in the attribute set are only reported when strict, otherwise they are ignored.
+// The vendor members are those of a vendor group IE, nil for other IE sets, in which vendor IEs are allowed anywhere.
+func validateAttributeSet(verr *ValidationError, ies *[]IeNode, attributeSet groupIeAttributeSet, vendorMembers map[vendorIeKey]groupIeAttributes, strict bool) (groupId *IeID) {
 	countRequired, idRequired := attributeSet.properties()
 	requiredMap := make(map[IeTypeCode]struct{})
 	uniqueMap := make(map[IeTypeCode]struct{})
+	vendorMap := make(map[vendorIeKey]struct{})
 
 	for i := range *ies {
 		thisTypeCode := (*ies)[i].IeTypeCode
-		if attributes, found := attributeSet[thisTypeCode]; !found {
-			fmt.Fprintf(sb, "unallowed IE %d (%s) in IE set '%s'\n", thisTypeCode, thisTypeCode, source)
+		thisPath := IePath{(*ies)[i].pathElement()}
+		if attributes, found := attributeSet[thisTypeCode]; thisTypeCode.IsVendor() {
+			// vendor IEs are allowed anywhere, unless the IE set says otherwise (TS 29.244 7.1)
+			key := vendorIeKey{(*ies)[i].enterpriseId, thisTypeCode}
+			if attributes, found := vendorMembers[key]; !found {
+			} else if _, seen := vendorMap[key]; seen && !attributes.multiple {
+				verr.add(ViolationDuplicateIe, thisTypeCode, thisPath, "")
+			} else {
+				vendorMap[key] = struct{}{}
+			}
+		} else if !found && strict {
+			verr.add(ViolationUnallowedIe, thisTypeCode, thisPath, "")
+		} else if !found {
+			// an unknown or unexpected IE is kept, but neither its content nor, for a group IE, its members are checked
 		} else {
 			if attributes.required {
 				requiredMap[thisTypeCode] = struct{}{}
 			}
 			if !attributes.multiple {
 				if _, found := uniqueMap[thisTypeCode]; found {
-					fmt.Fprintf(sb, "illegal duplicate IE (type %d - %s) found in group IE %s\n", thisTypeCode, thisTypeCode, source)
+					verr.add(ViolationDuplicateIe, thisTypeCode, thisPath, "")
 				} else {
 					uniqueMap[thisTypeCode] = struct{}{}
 				}
 			}
 			if attributes.isID {
-				if !thisTypeCode.isGroupIe() {
+				if !(*ies)[i].isGroup() {
 					idValue := (*ies)[i].ParseID()
 					groupId = &idValue
 				} else {
-					fmt.Fprintf(sb, "illegal ID IE (type %d), the ID IE cannot be a group IE!  found in group IE %s\n", thisTypeCode, source)
+					verr.add(ViolationGroupId, thisTypeCode, thisPath, "")
 				}
 			}
 		}
@@ -70,25 +103,36 @@ func validateAttributeSet(sb *strings.Builder, ies *[]IeNode, source pfcpTypeCod
 
 	// Only now can check required list, as all IEs have been visited
 	if int(countRequired) != len(requiredMap) {
-		missing := setRemove(attributeSet.required(), requiredMap)
+		for _, missing := range setRemove(attributeSet.required(), requiredMap) {
+			verr.add(ViolationMissingIe, missing, IePath{{TypeCode: missing}}, "")
+		}
+	}
 
-		fmt.Fprintf(sb, "missing required elements in %s:", source)
-		for _, ieCodes := range missing {
-			fmt.Fprintf(sb, " %s (%d)", ieCodes, ieCodes)
+	for key, attributes := range vendorMembers {
+		if _, found := vendorMap[key]; attributes.required && !found {
+			verr.add(ViolationMissingIe, key.IeTypeCode, IePath{{TypeCode: key.IeTypeCode, EnterpriseId: key.EnterpriseId}}, "")
 		}
-		fmt.Fprintf(sb, "\n")
 	}
 
 	if idRequired && groupId == nil {
-		fmt.Fprintf(sb, "expected ID IE not found in group IE %s\n", source)
+		for tc, attributes := range attributeSet {
+			// a required ID IE has already been reported as missing
+			if attributes.isID && !attributes.required {
+				verr.add(ViolationMissingId, tc, nil, "%s", tc)
+			}
+		}
 	}
 	return
 }
 
+func validateConditionalRules(verr *ValidationError, ies []IeNode, rules []conditionalRule) {
+	verr.Violations = append(verr.Violations, applyConditionalRules(ies, rules)...)
+}
+
 func (thisIe *IeNode) ParseID() IeID {
 	// tdod implement specific length checks for the distinct IE types
 	switch thisIe.IeTypeCode {
-	case PDR_ID, FAR_ID, BAR_ID, URR_ID, QER_ID:
+	case PDR_ID, FAR_ID, BAR_ID, URR_ID, QER_ID, Traffic_Endpoint_ID, MAR_ID, SRR_ID, MBS_Unicast_Parameters_ID:
 		return IeID(thisIe.deserialiseUint())
 	}
 
@@ -0,0 +1,149 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ViolationKind uint8
+
+const (
+	ViolationUnknownMessage ViolationKind = iota
+	ViolationUnallowedIe
+	ViolationDuplicateIe
+	ViolationMissingIe
+	ViolationMissingConditionalIe
+	ViolationExclusiveIes
+	ViolationMissingId
+	ViolationGroupId // the ID of a group IE is itself a group IE
+)
+
+var violationKindNames = map[ViolationKind]string{
+	ViolationUnknownMessage:       "unknown message type",
+	ViolationUnallowedIe:          "unallowed IE",
+	ViolationDuplicateIe:          "illegal duplicate IE",
+	ViolationMissingIe:            "missing required IE",
+	ViolationMissingConditionalIe: "missing conditional IE",
+	ViolationExclusiveIes:         "mutually exclusive IEs",
+	ViolationMissingId:            "missing ID IE",
+	ViolationGroupId:              "group IE as ID IE",
+}
+
+func (kind ViolationKind) String() string {
+	if name, found := violationKindNames[kind]; found {
+		return name
+	} else {
+		return fmt.Sprintf("unknown violation (%d)", kind)
+	}
+}
+
+// IePathElement locates an IE within its enclosing IE list, Id is set for group IEs which have an ID IE
+type IePathElement struct {
+	TypeCode     IeTypeCode
+	EnterpriseId EnterpriseId
+	Id           *IeID
+}
+
+func (element IePathElement) String() string {
+	var name string
+	if element.TypeCode.IsVendor() {
+		name = fmt.Sprintf("vendor(%d/%d)", element.EnterpriseId, element.TypeCode&^vendorTypeCodeFlag)
+	} else if identifier, found := ieIdentifiers[element.TypeCode]; found {
+		name = identifier
+	} else {
+		name = fmt.Sprintf("IE(%d)", element.TypeCode)
+	}
+	if element.Id != nil {
+		return fmt.Sprintf("%s[id=%d]", name, *element.Id)
+	} else {
+		return name
+	}
+}
+
+// IePath is the path from the message root, e.g. Create_PDR[id=3]/PDI/F_TEID
+type IePath []IePathElement
+
+func (path IePath) String() string {
+	elements := make([]string, len(path))
+	for i := range path {
+		elements[i] = path[i].String()
+	}
+	return strings.Join(elements, "/")
+}
+
+// append always copies, paths are shared between the violations of sibling IEs
+func (path IePath) append(element IePathElement) IePath {
+	return append(append(IePath{}, path...), element)
+}
+
+// Violation is a single validation failure.
+// Path is the offending IE, or for a missing IE, the path at which it is missing.
+// TypeCode is the offending or missing IE, and is zero for an unknown message type.
+type Violation struct {
+	Kind     ViolationKind
+	TypeCode IeTypeCode
+	Path     IePath
+	Detail   string
+}
+
+func (violation Violation) String() string {
+	s := violation.Kind.String()
+	if len(violation.Path) > 0 {
+		s += " " + violation.Path.String()
+	}
+	if violation.Detail != "" {
+		s += " (" + violation.Detail + ")"
+	}
+	return s
+}
+
+// ValidationError is returned by Validate and ParseValidate when a message does not conform to its IE sets
+type ValidationError struct {
+	MessageTypeCode MessageTypeCode
+	Violations      []Violation
+}
+
+func (verr *ValidationError) Error() string {
+	lines := make([]string, len(verr.Violations))
+	for i := range verr.Violations {
+		lines[i] = verr.Violations[i].String()
+	}
+	return fmt.Sprintf("invalid %s: %s", verr.MessageTypeCode, strings.Join(lines, "; "))
+}
+
+func (verr *ValidationError) add(kind ViolationKind, tc IeTypeCode, path IePath, format string, a ...any) {
+	verr.Violations = append(verr.Violations, Violation{Kind: kind, TypeCode: tc, Path: path, Detail: fmt.Sprintf(format, a...)})
+}
+
+// Has reports whether any violation is of the kind
+func (verr *ValidationError) Has(kind ViolationKind) bool {
+	for i := range verr.Violations {
+		if verr.Violations[i].Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Cause returns the TS29.244 cause value for rejecting the message, and the type code for the Offending IE.
+// Missing IEs take precedence over incorrect IEs, as the peer cannot correct the latter without the former.
+func (verr *ValidationError) Cause() (cause uint8, offendingIe IeTypeCode) {
+	for _, kind := range []ViolationKind{ViolationMissingIe, ViolationMissingId, ViolationMissingConditionalIe} {
+		for _, violation := range verr.Violations {
+			if violation.Kind != kind {
+			} else if kind == ViolationMissingConditionalIe {
+				return ConditionalIeMissing, violation.TypeCode
+			} else {
+				return MandatoryIeMissing, violation.TypeCode
+			}
+		}
+	}
+	if len(verr.Violations) == 0 || verr.Violations[0].Kind == ViolationUnknownMessage {
+		return CauseUnspecified, 0
+	} else {
+		return MandatoryIeIncorrect, verr.Violations[0].TypeCode
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestValidationErrorPaths(t *testing.T) {
+	msg := NewSessionMessage(PFCP_Session_Establishment_Request, 1,
+		IE_NodeIdFqdn("smf"),
+		IE_FSeid(1, netip.MustParseAddr("192.0.2.1")),
+		IE_CreatePdr(
+			IE_PdrId(3),
+			IE_Pdi(IE_FTeid_Choose_IpV4()), // no Source Interface
+			IE_Precedence(1),
+			IE_Precedence(2),
+		),
+		IE_CreatePdr(IE_Pdi(IE_SourceInterface(EnumCore))), // no PDR ID
+		IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumDrop), IE_QerId(1)),
+	)
+
+	var verr *ValidationError
+	if err := msg.ValidateWith(StrictProfile); err == nil {
+		t.Fatal("invalid message accepted")
+	} else if !errors.As(err, &verr) {
+		t.Fatalf("not a ValidationError: %T", err)
+	}
+
+	expected := map[string]Violation{
+		"Create_PDR[id=3]/PDI/Source_Interface": {Kind: ViolationMissingIe, TypeCode: Source_Interface},
+		"Create_PDR[id=3]/Precedence":           {Kind: ViolationDuplicateIe, TypeCode: Precedence},
+		"Create_PDR/PDR_ID":                     {Kind: ViolationMissingIe, TypeCode: PDR_ID},
+		"Create_FAR[id=1]/QER_ID":               {Kind: ViolationUnallowedIe, TypeCode: QER_ID},
+	}
+	for _, violation := range verr.Violations {
+		if want, found := expected[violation.Path.String()]; !found {
+			t.Errorf("unexpected violation %s", violation)
+		} else if want.Kind != violation.Kind || want.TypeCode != violation.TypeCode {
+			t.Errorf("wrong violation %s", violation)
+		} else {
+			delete(expected, violation.Path.String())
+		}
+	}
+	for path, violation := range expected {
+		t.Errorf("%s not found at %s", violation.Kind, path)
+	}
+
+	if cause, offendingIe := verr.Cause(); cause != MandatoryIeMissing || (offendingIe != Source_Interface && offendingIe != PDR_ID) {
+		t.Errorf("wrong cause %d and offending IE %s", cause, offendingIe)
+	}
+}
+
+func TestValidationErrorCause(t *testing.T) {
+	unknown := NewNodeMessage(MessageTypeCode(99))
+	if err := unknown.Validate(); err == nil {
+		t.Error("unknown message accepted")
+	} else if cause, _ := err.(*ValidationError).Cause(); !err.(*ValidationError).Has(ViolationUnknownMessage) || cause != CauseUnspecified {
+		t.Errorf("wrong cause %d for %s", cause, err.Error())
+	}
+
+	unallowed := NewNodeMessage(PFCP_Heartbeat_Request, IE_RecoveryTimeStamp(1), IE_PdrId(1))
+	if err := unallowed.ValidateWith(StrictProfile); err == nil {
+		t.Error("unallowed IE accepted")
+	} else if cause, offendingIe := err.(*ValidationError).Cause(); cause != MandatoryIeIncorrect || offendingIe != PDR_ID {
+		t.Errorf("wrong cause %d and offending IE %s", cause, offendingIe)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"fmt"
+)
+
+// Vendor-specific IEs (TS 29.244 8.1.1) have the top bit of the type code set,
+// and carry a 16 bit IANA enterprise ID ahead of the IE payload.
+// The type code is only meaningful in combination with the enterprise ID,
+// so vendor IE properties are registered per (enterprise ID, type code) pair.
+// The functions for vendor IEs set the vendor bit themselves: a vendor type code may be given with or without it.
+
+type EnterpriseId uint16
+
+const vendorTypeCodeFlag IeTypeCode = 0x8000
+
+func (typeCode IeTypeCode) IsVendor() bool {
+	return typeCode&vendorTypeCodeFlag != 0
+}
+
+type VendorIeDecoder func(bytes []byte) string
+
+// VendorIeMember describes an IE allowed in a registered vendor group IE.
+// A vendor member is marked by Vendor, or by the vendor bit of its type code, and its enterprise ID is that of the group,
+// unless EnterpriseId is given. Vendor members are matched by enterprise ID as well as type code.
+type VendorIeMember struct {
+	IeTypeCode
+	Vendor       bool
+	EnterpriseId EnterpriseId
+	Required     bool
+	Multiple     bool
+}
+
+type vendorIeKey struct {
+	EnterpriseId
+	IeTypeCode
+}
+
+type vendorIeProperties struct {
+	name          string
+	decoder       VendorIeDecoder                   // nil unless registered, in which case the bytes are shown
+	attributeSet  groupIeAttributeSet               // only present if the IE IS group, the members which are not vendor IEs
+	vendorMembers map[vendorIeKey]groupIeAttributes // the members which are vendor IEs
+}
+
+// the registry is not locked, registration is expected from init() or at least before any parsing
+var vendorIes = map[vendorIeKey]vendorIeProperties{}
+
+func RegisterVendorIe(enterpriseId EnterpriseId, tc IeTypeCode, name string, decoder VendorIeDecoder) error {
+	return registerVendorIe(vendorIeKey{enterpriseId, tc | vendorTypeCodeFlag}, vendorIeProperties{name: name, decoder: decoder})
+}
+
+func RegisterVendorGroupIe(enterpriseId EnterpriseId, tc IeTypeCode, name string, members ...VendorIeMember) error {
+	properties := vendorIeProperties{
+		name:          name,
+		attributeSet:  groupIeAttributeSet{},
+		vendorMembers: map[vendorIeKey]groupIeAttributes{},
+	}
+	for _, member := range members {
+		attributes := groupIeAttributes{required: member.Required, multiple: member.Multiple}
+		if !member.Vendor && !member.IeTypeCode.IsVendor() {
+			properties.attributeSet[member.IeTypeCode] = attributes
+		} else if member.EnterpriseId == 0 {
+			properties.vendorMembers[vendorIeKey{enterpriseId, member.IeTypeCode | vendorTypeCodeFlag}] = attributes
+		} else {
+			properties.vendorMembers[vendorIeKey{member.EnterpriseId, member.IeTypeCode | vendorTypeCodeFlag}] = attributes
+		}
+	}
+	return registerVendorIe(vendorIeKey{enterpriseId, tc | vendorTypeCodeFlag}, properties)
+}
+
+func registerVendorIe(key vendorIeKey, properties vendorIeProperties) error {
+	if _, found := vendorIes[key]; found {
+		return fmt.Errorf("vendor IE %d/%d is already registered", key.EnterpriseId, key.IeTypeCode&^vendorTypeCodeFlag)
+	} else {
+		vendorIes[key] = properties
+		return nil
+	}
+}
+
+func NewVendorIeNode(enterpriseId EnterpriseId, tc IeTypeCode, bytes []byte) *IeNode {
+	return &IeNode{IeTypeCode: tc | vendorTypeCodeFlag, enterpriseId: enterpriseId, bytes: bytes}
+}
+
+func NewVendorGroupNode(enterpriseId EnterpriseId, tc IeTypeCode, ies ...IeNode) *IeNode {
+	return &IeNode{IeTypeCode: tc | vendorTypeCodeFlag, enterpriseId: enterpriseId, ies: ies}
+}
+
+func (node *IeNode) EnterpriseId() (EnterpriseId, bool) {
+	return node.enterpriseId, node.IeTypeCode.IsVendor()
+}
+
+func (node *IeNode) vendorProperties() (properties vendorIeProperties, found bool) {
+	if node.IeTypeCode.IsVendor() {
+		properties, found = vendorIes[vendorIeKey{node.enterpriseId, node.IeTypeCode}]
+	}
+	return
+}
+
+// attributeSet extends the static groupIeAttributeSets with the registered vendor group IEs
+func (node *IeNode) attributeSet() (groupIeAttributeSet, bool) {
+	if !node.IeTypeCode.IsVendor() {
+		attributeSet, found := groupIeAttributeSets[node.IeTypeCode]
+		return attributeSet, found
+	} else if properties, found := node.vendorProperties(); found && properties.attributeSet != nil {
+		return properties.attributeSet, true
+	} else {
+		return nil, false
+	}
+}
+
+// vendorMembers are the members of a registered vendor group IE which are vendor IEs, nil for other IEs
+func (node *IeNode) vendorMembers() map[vendorIeKey]groupIeAttributes {
+	properties, _ := node.vendorProperties()
+	return properties.vendorMembers
+}
+
+func (node *IeNode) isGroup() bool {
+	_, isGroup := node.attributeSet()
+	return isGroup
+}
+
+func (node *IeNode) name() string {
+	if !node.IeTypeCode.IsVendor() {
+		return node.IeTypeCode.String()
+	} else if properties, found := node.vendorProperties(); found {
+		return properties.name
+	} else {
+		return fmt.Sprintf("vendor IE(%d/%d)", node.enterpriseId, node.IeTypeCode&^vendorTypeCodeFlag)
+	}
+}
+
+func (node *IeNode) showVendor() string {
+	if properties, found := node.vendorProperties(); found && properties.decoder != nil {
+		return properties.decoder(node.bytes)
+	} else {
+		return showBytes(node.bytes)
+	}
+}
+
+func (node *IeNode) getVendor(enterpriseId EnterpriseId, tc IeTypeCode) *IeNode {
+	for i := range node.ies {
+		if tc|vendorTypeCodeFlag == node.ies[i].IeTypeCode && enterpriseId == node.ies[i].enterpriseId {
+			return &node.ies[i]
+		}
+	}
+	return nil
+}
+
+func (get Get) GetVendor(enterpriseId EnterpriseId, tc IeTypeCode) Get {
+	if len(get.err) == 0 {
+		if ie := get.IeNode.getVendor(enterpriseId, tc); ie != nil {
+			get = get.found(ie, IePathElement{TypeCode: tc | vendorTypeCodeFlag, EnterpriseId: enterpriseId}.String())
+		} else {
+			// local fail
+			get = get.fail("GetVendor, not found, %d/%d", enterpriseId, tc)
+		}
+	} else {
+		// prior fail
+		get.contexts = append(get.contexts, get.IeNode.IeTypeCode)
+	}
+	return get
+}
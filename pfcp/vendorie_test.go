@@ -0,0 +1,124 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package pfcp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const (
+	testEnterprise       EnterpriseId = 0x1234
+	testVendorPlain      IeTypeCode   = 0x8001
+	testVendorGroup      IeTypeCode   = 0x8002
+	testVendorUnknown    IeTypeCode   = 0x8003
+	testVendorPlainShown string       = "0x8001 payload"
+)
+
+func init() {
+	if err := RegisterVendorIe(testEnterprise, testVendorPlain, "Test Vendor Plain", func(bytes []byte) string {
+		return fmt.Sprintf("%#x payload", bytes)
+	}); err != nil {
+		panic(err)
+	}
+	// the vendor bit is set by the registration, as by NewVendorIeNode
+	if err := RegisterVendorGroupIe(testEnterprise, testVendorGroup&^vendorTypeCodeFlag, "Test Vendor Group",
+		VendorIeMember{IeTypeCode: testVendorPlain, Required: true},
+		VendorIeMember{IeTypeCode: testVendorUnknown &^ vendorTypeCodeFlag, Vendor: true, EnterpriseId: 4711},
+		VendorIeMember{IeTypeCode: FAR_ID, Multiple: true},
+	); err != nil {
+		panic(err)
+	}
+}
+
+func TestVendorRegistration(t *testing.T) {
+	if err := RegisterVendorIe(testEnterprise, testVendorPlain&^vendorTypeCodeFlag, "Again", nil); err == nil {
+		t.Errorf("vendor IE registered twice")
+	} else if node := NewVendorGroupNode(testEnterprise, testVendorGroup); !node.isGroup() || node.name() != "Test Vendor Group" {
+		t.Errorf("vendor group registered without the vendor bit not found")
+	}
+}
+
+// vendor members are matched by enterprise ID as well as type code
+func TestVendorGroupEnterprise(t *testing.T) {
+	for name, test := range map[string]struct {
+		members []IeNode
+		valid   bool
+	}{
+		"same enterprise":           {[]IeNode{*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1})}, true},
+		"other enterprise":          {[]IeNode{*NewVendorIeNode(4711, testVendorPlain, []byte{1})}, false},
+		"duplicate":                 {[]IeNode{*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1}), *NewVendorIeNode(testEnterprise, testVendorPlain, []byte{2})}, false},
+		"duplicate of other":        {[]IeNode{*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1}), *NewVendorIeNode(4711, testVendorPlain, []byte{2})}, true},
+		"member of given":           {[]IeNode{*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1}), *NewVendorIeNode(4711, testVendorUnknown, []byte{2})}, true},
+		"duplicate member of given": {[]IeNode{*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{1}), *NewVendorIeNode(4711, testVendorUnknown, []byte{2}), *NewVendorIeNode(4711, testVendorUnknown, []byte{3})}, false},
+	} {
+		msg := vendorTestMessage(*NewVendorGroupNode(testEnterprise, testVendorGroup, test.members...))
+		if _, err := ParseValidate(msg.Serialise()); (err == nil) != test.valid {
+			t.Errorf("%s: validation returned %v", name, err)
+		}
+	}
+}
+
+func vendorTestMessage(vendorIes ...IeNode) *PfcpMessage {
+	return NewSessionMessage(
+		PFCP_Session_Establishment_Request,
+		1234,
+		append([]IeNode{
+			IE_NodeIdFqdn("smf0"),
+			IE_FSeid(1, testV4),
+			IE_CreatePdr(
+				IE_PdrId(1),
+				IE_Pdi(IE_SourceInterface(EnumAccess)),
+				IE_FarId(1),
+				*NewVendorIeNode(testEnterprise, testVendorUnknown, []byte{0xde, 0xad}),
+			),
+			IE_CreateFar(IE_FarId(1), IE_ApplyAction(EnumDrop)),
+		}, vendorIes...)...,
+	)
+}
+
+func TestVendorIeRoundTrip(t *testing.T) {
+	msg := vendorTestMessage(
+		*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{0x80, 0x01}),
+		*NewVendorIeNode(4711, testVendorPlain, []byte{}),
+		*NewVendorGroupNode(testEnterprise, testVendorGroup,
+			*NewVendorIeNode(testEnterprise, testVendorPlain, []byte{0x01}),
+			IE_FarId(1),
+			IE_FarId(2),
+		),
+	)
+	bytes := msg.Serialise()
+	if parsed, err := ParseValidate(bytes); err != nil {
+		t.Fatal(err)
+	} else if err := ReserialiseCheck(parsed, bytes); err != nil {
+		t.Fatal(err)
+	} else if group, err := parsed.Node().Getter().GetVendor(testEnterprise, testVendorGroup).Return(); err != nil {
+		t.Fatal(err)
+	} else if len(group.ies) != 3 {
+		t.Errorf("vendor group IE parsed with %d members", len(group.ies))
+	} else if other, err := parsed.Node().Getter().GetVendor(4711, testVendorPlain).Return(); err != nil {
+		t.Fatal(err)
+	} else if enterpriseId, isVendor := other.EnterpriseId(); !isVendor || enterpriseId != 4711 {
+		t.Errorf("wrong enterprise ID %d", enterpriseId)
+	} else if dump := parsed.Dumper(); !strings.Contains(dump, "Test Vendor Plain: "+testVendorPlainShown) {
+		t.Errorf("registered vendor decoder not used:\n%s", dump)
+	} else if !strings.Contains(dump, "vendor IE(4660/3)") || !strings.Contains(dump, "vendor IE(4711/1)") {
+		t.Errorf("unregistered vendor IEs not named:\n%s", dump)
+	}
+}
+
+func TestVendorGroupValidation(t *testing.T) {
+	msg := vendorTestMessage(*NewVendorGroupNode(testEnterprise, testVendorGroup, IE_FarId(1)))
+	if _, err := ParseValidate(msg.Serialise()); err == nil {
+		t.Errorf("vendor group IE without required member accepted")
+	}
+}
+
+func TestVendorIeShort(t *testing.T) {
+	bytes := []byte{0x80, 0x01, 0x00, 0x01, 0x12}
+	if _, err := readIes(bytes); err == nil {
+		t.Errorf("vendor IE without enterprise ID accepted")
+	}
+}
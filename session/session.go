@@ -5,6 +5,7 @@ package session
 
 import (
 	"fmt"
+	"net/netip"
 
 	"pfcpcore/pfcp"
 )
@@ -61,7 +62,7 @@ func AccessSER(ser *pfcp.PfcpMessage) {
 
 type Session struct {
 	Seid                                    pfcp.SEID
-	UeIpAdress, EnbIpAddress, CoreIpAddress pfcp.IpV4
+	UeIpAdress, EnbIpAddress, CoreIpAddress netip.Addr
 	UplinkTeid, DownlinkTeid                pfcp.TEID
 }
 
@@ -103,7 +104,7 @@ func ParseSER(ser *pfcp.IeNode) (uint64, error) {
 	return root.GetByTc(pfcp.F_SEID).DeserialiseU64()
 }
 
-func ParseUpfSER(ser *pfcp.IeNode) (session Session) {
+func ParseUpfSER(ser *pfcp.IeNode) (session Session, err error) {
 	fmt.Printf("now attempting to parse message\n")
 	root := ser.Getter()
 	accessPdr := root.GetByPredicate(pfcp.Create_PDR, pdrHasInterfaceType(pfcp.EnumAccess))
@@ -123,23 +124,22 @@ func ParseUpfSER(ser *pfcp.IeNode) (session Session) {
 	seid, _ := root.GetByTc(pfcp.F_SEID).DeserialiseU64()
 	session.Seid = pfcp.SEID(seid)
 
-	session.UeIpAdress = corePdr.GetByTc(pfcp.PDI).GetByTc(pfcp.UE_IP_Address).Inner().DeserialiseUeIPAddress()
-
-	outerHeader := coreFar.
-		GetByTc(pfcp.Forwarding_Parameters).
-		GetByTc(pfcp.Outer_Header_Creation).
-		Inner().DeserialiseOuterHeader()
-
-	session.EnbIpAddress = outerHeader.IpV4
-	session.DownlinkTeid = outerHeader.Teid
-
-	uplinkFTeid := accessPdr.GetByTc(pfcp.PDI).
-		GetByTc(pfcp.F_TEID).
-		Inner().DeserialiseFTeid()
-
-	session.UplinkTeid = *uplinkFTeid.Teid
-	session.CoreIpAddress = *uplinkFTeid.IpV4
-	return
+	if ueIpAddress, err := corePdr.GetByTc(pfcp.PDI).GetByTc(pfcp.UE_IP_Address).DeserialiseUeIPAddress(); err != nil {
+		return session, err
+	} else if outerHeader, err := coreFar.GetByTc(pfcp.Forwarding_Parameters).GetByTc(pfcp.Outer_Header_Creation).DeserialiseOuterHeader(); err != nil {
+		return session, err
+	} else if uplinkFTeid, err := accessPdr.GetByTc(pfcp.PDI).GetByTc(pfcp.F_TEID).DeserialiseFTeid(); err != nil {
+		return session, err
+	} else if uplinkFTeid.Teid == nil {
+		return session, fmt.Errorf("missing teid in FTEID")
+	} else {
+		session.UeIpAdress = ueIpAddress.Addr()
+		session.EnbIpAddress = outerHeader.Addr()
+		session.DownlinkTeid = outerHeader.Teid
+		session.UplinkTeid = *uplinkFTeid.Teid
+		session.CoreIpAddress = uplinkFTeid.Addr()
+		return session, nil
+	}
 }
 
 // example custom predicate (the only known use is for tracking down a PDR based on role)
@@ -95,6 +95,15 @@ func (SessionStateStore *SessionStateStore) Remove(upfSeid pfcp.SEID) (pfcp.SEID
 	}
 }
 
+// RemoveAll empties the store, and returns the local SEIDs of the sessions it held
+func (SessionStateStore *SessionStateStore) RemoveAll() (upfSeids []pfcp.SEID) {
+	for upfSeid := range SessionStateStore.sessions {
+		upfSeids = append(upfSeids, upfSeid)
+	}
+	SessionStateStore.sessions = map[SessionStateStoreKey]internalSessionStateElement{}
+	return
+}
+
 func (SessionStateStore *SessionStateStore) nextSeid() SessionStateStoreKey {
 	// assign random SEID to distinguish local and peer SEID usage
 	// in future, the local SEID could be usefully distinguished from peer assigned SEID
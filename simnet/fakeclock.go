@@ -0,0 +1,96 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package simnet
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// FakeClock is a transport.Clock, and a Clock of the simulated network, which moves only when the test advances it
+type FakeClock struct {
+	mutex   sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at      time.Time
+	channel chan time.Time
+}
+
+func NewFakeClock() *FakeClock {
+	return &FakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (clock *FakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *FakeClock) After(d time.Duration) <-chan time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	channel := make(chan time.Time, 1)
+	if d <= 0 {
+		channel <- clock.now
+	} else {
+		clock.waiters = append(clock.waiters, fakeWaiter{at: clock.now.Add(d), channel: channel})
+	}
+	return channel
+}
+
+// Advance moves the clock on, firing the timers which are then due
+func (clock *FakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(d)
+	waiting := clock.waiters[:0]
+	for _, waiter := range clock.waiters {
+		if waiter.at.After(clock.now) {
+			waiting = append(waiting, waiter)
+		} else {
+			waiter.channel <- waiter.at
+		}
+	}
+	clock.waiters = waiting
+}
+
+// AdvanceToNext moves the clock on to the earliest pending timer
+func (clock *FakeClock) AdvanceToNext() {
+	clock.mutex.Lock()
+	next := clock.waiters[0].at
+	for _, waiter := range clock.waiters {
+		if waiter.at.Before(next) {
+			next = waiter.at
+		}
+	}
+	d := next.Sub(clock.now)
+	clock.mutex.Unlock()
+	clock.Advance(d)
+}
+
+// WaitForTimers waits until n timers are pending, i.e. until the code under test is waiting for the clock
+func (clock *FakeClock) WaitForTimers(n int) error {
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if clock.Pending() == n {
+			return nil
+		}
+	}
+	return fmt.Errorf("timeout waiting for %d timers, %d pending", n, clock.Pending())
+}
+
+// Elapsed is the time the clock has been advanced by
+func (clock *FakeClock) Elapsed() time.Duration {
+	return clock.Now().Sub(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+// Pending is the number of timers which are not yet due
+func (clock *FakeClock) Pending() int {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return len(clock.waiters)
+}
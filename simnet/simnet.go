@@ -0,0 +1,235 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package simnet
+
+/*
+simnet is an in-memory network for tests, in place of UDP sockets.
+A Link joins two Ends, each of which is a udpserver.DatagramPeer, so that two transports can talk over it.
+The datagrams sent from an End are subject to its Conditions: they may be lost, duplicated, reordered or delayed.
+The faults are drawn from a random source with a fixed seed, or given by a script,
+so that a test sees the same faults on each run, and with a fake clock, at the same times.
+*/
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"pfcpcore/udpserver"
+)
+
+// Fault is what the network does with a datagram
+type Fault int
+
+const (
+	Deliver   Fault = iota
+	Lose            // the datagram is not delivered
+	Duplicate       // the datagram is delivered twice
+	Reorder         // the datagram is delivered after the next one
+	Delay           // the datagram is delivered after Conditions.Delay, other datagrams may overtake it
+)
+
+func (fault Fault) String() string {
+	switch fault {
+	case Deliver:
+		return "deliver"
+	case Lose:
+		return "lose"
+	case Duplicate:
+		return "duplicate"
+	case Reorder:
+		return "reorder"
+	case Delay:
+		return "delay"
+	default:
+		return "invalid fault"
+	}
+}
+
+// Conditions are the faults of the datagrams sent from an End, each with its probability.
+// Script, if set, decides the fault of the nth datagram, counting from 1, in place of the probabilities.
+type Conditions struct {
+	Loss, Duplication, Reordering, Delaying float64
+	Delay                                   time.Duration
+	Seed                                    int64
+	Script                                  func(n int) Fault
+}
+
+// Stats counts the datagrams sent from an End, and their faults
+type Stats struct {
+	Sent, Delivered, Lost, Duplicated, Reordered, Delayed int
+}
+
+// Clock times the delays, a transport.Clock will do, so that a test controls delays and retransmissions together
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// End is one end of a Link
+type End struct {
+	send, receive chan *udpserver.UdpMessage
+	remote        *End
+	clock         Clock
+
+	mutex      sync.Mutex
+	conditions Conditions
+	random     *rand.Rand
+	stats      Stats
+	held       *udpserver.UdpMessage // a reordered datagram, waiting for the next one
+	dropped    bool
+	done       chan struct{}
+
+	receiving sync.RWMutex // held to deliver, so that Drop does not close the receive channel under a sender
+}
+
+// NewLink joins two Ends, which deliver every datagram until their conditions are set.
+// The clock times delays, nil for the system clock.
+func NewLink(clock Clock) (a, b *End) {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	a, b = newEnd(clock), newEnd(clock)
+	a.remote, b.remote = b, a
+	go a.forward()
+	go b.forward()
+	return a, b
+}
+
+func newEnd(clock Clock) *End {
+	return &End{
+		send:    make(chan *udpserver.UdpMessage),
+		receive: make(chan *udpserver.UdpMessage),
+		clock:   clock,
+		random:  rand.New(rand.NewSource(0)),
+		done:    make(chan struct{}),
+	}
+}
+
+func (end *End) SendChannel() chan<- *udpserver.UdpMessage    { return end.send }
+func (end *End) ReceiveChannel() <-chan *udpserver.UdpMessage { return end.receive }
+
+// Drop stops delivery to the End, and closes its receive channel, datagrams sent from it are discarded
+func (end *End) Drop() {
+	end.mutex.Lock()
+	dropped := end.dropped
+	if !dropped {
+		end.dropped = true
+		close(end.done)
+	}
+	end.mutex.Unlock()
+	if !dropped {
+		end.receiving.Lock()
+		close(end.receive)
+		end.receiving.Unlock()
+	}
+}
+
+// SetConditions sets the faults of the datagrams sent from the End from now on, and restarts the count of the script
+func (end *End) SetConditions(conditions Conditions) {
+	end.mutex.Lock()
+	defer end.mutex.Unlock()
+	end.conditions = conditions
+	end.random = rand.New(rand.NewSource(conditions.Seed))
+	end.stats = Stats{}
+}
+
+// Stats returns the counts of the datagrams sent from the End since its conditions were set
+func (end *End) Stats() Stats {
+	end.mutex.Lock()
+	defer end.mutex.Unlock()
+	return end.stats
+}
+
+func (end *End) forward() {
+	for message := range end.send {
+		end.mutex.Lock()
+		if end.dropped {
+			end.mutex.Unlock()
+			continue
+		}
+		end.stats.Sent++
+		fault := end.fault()
+		held := end.held
+		end.held = nil
+		switch fault {
+		case Lose:
+			end.stats.Lost++
+		case Duplicate:
+			end.stats.Duplicated++
+		case Reorder:
+			end.stats.Reordered++
+			// a datagram already held is delivered now, it is overtaken by one only
+			end.held = message
+		case Delay:
+			end.stats.Delayed++
+		}
+		delay := end.conditions.Delay
+		end.mutex.Unlock()
+
+		if fault == Deliver || fault == Duplicate {
+			end.deliver(message)
+		}
+		if fault == Duplicate {
+			end.deliver(copyMessage(message))
+		}
+		if fault == Delay {
+			go func(message *udpserver.UdpMessage) {
+				<-end.clock.After(delay)
+				end.deliver(message)
+			}(message)
+		}
+		if held != nil {
+			end.deliver(held)
+		}
+	}
+}
+
+// fault decides the fault of the next datagram. The mutex must be held.
+func (end *End) fault() Fault {
+	conditions := &end.conditions
+	if conditions.Script != nil {
+		return conditions.Script(end.stats.Sent)
+	}
+	// one draw for each datagram, so that the faults depend only on the seed and the count
+	p := end.random.Float64()
+	if p < conditions.Loss {
+		return Lose
+	} else if p -= conditions.Loss; p < conditions.Duplication {
+		return Duplicate
+	} else if p -= conditions.Duplication; p < conditions.Reordering {
+		return Reorder
+	} else if p -= conditions.Reordering; p < conditions.Delaying {
+		return Delay
+	} else {
+		return Deliver
+	}
+}
+
+// deliver passes the datagram to the remote End, unless it is dropped
+func (end *End) deliver(message *udpserver.UdpMessage) {
+	remote := end.remote
+	remote.receiving.RLock()
+	defer remote.receiving.RUnlock()
+	select {
+	case <-remote.done:
+		return
+	default:
+	}
+	select {
+	case remote.receive <- message:
+		end.mutex.Lock()
+		end.stats.Delivered++
+		end.mutex.Unlock()
+	case <-remote.done:
+	}
+}
+
+// copyMessage copies a duplicated datagram, as the receiver may modify it
+func copyMessage(message *udpserver.UdpMessage) *udpserver.UdpMessage {
+	return &udpserver.UdpMessage{Payload: append([]byte(nil), message.Payload...), Remote: message.Remote}
+}
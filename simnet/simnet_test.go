@@ -0,0 +1,106 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package simnet_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"pfcpcore/simnet"
+	"pfcpcore/udpserver"
+)
+
+// exchange sends the datagrams 1..n from a, and returns those received at b, until none arrives for a while
+func exchange(a, b *simnet.End, n int) (received []byte) {
+	go func() {
+		for i := 1; i <= n; i++ {
+			a.SendChannel() <- udpserver.ToUdpMessage([]byte{byte(i)})
+		}
+	}()
+	for {
+		select {
+		case message := <-b.ReceiveChannel():
+			received = append(received, message.Payload[0])
+		case <-time.After(50 * time.Millisecond):
+			return
+		}
+	}
+}
+
+func TestScript(t *testing.T) {
+	for name, test := range map[string]struct {
+		faults   []simnet.Fault
+		received []byte
+		stats    simnet.Stats
+	}{
+		"mixed": {
+			[]simnet.Fault{simnet.Deliver, simnet.Lose, simnet.Duplicate, simnet.Reorder, simnet.Deliver},
+			[]byte{1, 3, 3, 5, 4},
+			simnet.Stats{Sent: 5, Delivered: 5, Lost: 1, Duplicated: 1, Reordered: 1},
+		},
+		"reorder twice": {
+			[]simnet.Fault{simnet.Reorder, simnet.Reorder, simnet.Deliver},
+			[]byte{1, 3, 2},
+			simnet.Stats{Sent: 3, Delivered: 3, Reordered: 2},
+		},
+	} {
+		a, b := simnet.NewLink(nil)
+		a.SetConditions(simnet.Conditions{Script: func(n int) simnet.Fault { return test.faults[n-1] }})
+
+		if received := exchange(a, b, len(test.faults)); !slices.Equal(received, test.received) {
+			t.Errorf("%s: received %v", name, received)
+		} else if stats := a.Stats(); stats != test.stats {
+			t.Errorf("%s: stats %+v", name, stats)
+		}
+	}
+}
+
+// the same seed gives the same faults
+func TestSeed(t *testing.T) {
+	conditions := simnet.Conditions{Loss: 0.2, Duplication: 0.1, Reordering: 0.1, Seed: 7}
+	var results [2][]byte
+	for i := range results {
+		a, b := simnet.NewLink(nil)
+		a.SetConditions(conditions)
+		results[i] = exchange(a, b, 50)
+	}
+	if !slices.Equal(results[0], results[1]) {
+		t.Errorf("different faults with the same seed\n%v\n%v", results[0], results[1])
+	} else if len(results[0]) == 50 {
+		t.Errorf("no faults")
+	}
+}
+
+type manualClock chan time.Time
+
+func (clock manualClock) After(time.Duration) <-chan time.Time { return clock }
+
+func TestDelay(t *testing.T) {
+	clock := make(manualClock)
+	a, b := simnet.NewLink(clock)
+	a.SetConditions(simnet.Conditions{Script: func(n int) simnet.Fault {
+		if n == 1 {
+			return simnet.Delay
+		}
+		return simnet.Deliver
+	}, Delay: time.Second})
+
+	if received := exchange(a, b, 2); !slices.Equal(received, []byte{2}) {
+		t.Errorf("received %v before the delay", received)
+	}
+	clock <- time.Time{}
+	if message := <-b.ReceiveChannel(); message.Payload[0] != 1 {
+		t.Errorf("received %d after the delay", message.Payload[0])
+	}
+}
+
+func TestDrop(t *testing.T) {
+	a, b := simnet.NewLink(nil)
+	b.Drop()
+	a.SendChannel() <- udpserver.ToUdpMessage([]byte{1})
+	if _, open := <-b.ReceiveChannel(); open {
+		t.Error("datagram received after drop")
+	}
+}
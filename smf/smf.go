@@ -4,6 +4,7 @@
 package smf
 
 import (
+	"context"
 	"fmt"
 	"net/netip"
 
@@ -23,7 +24,7 @@ type Association struct {
 func (association *Association) baseSER(ies ...pfcp.IeNode) (pfcp.SEID, *pfcp.PfcpMessage) {
 	association.nextSeid += 1
 	baseIes := []pfcp.IeNode{
-		pfcp.IE_NodeIdIpV4(association.nodeId),
+		pfcp.IE_NodeIdIp(association.nodeId),
 		pfcp.IE_FSeid(association.nextSeid, association.nodeId),
 	}
 	return pfcp.SEID(association.nextSeid), pfcp.NewSessionMessage(
@@ -39,7 +40,13 @@ type Session struct {
 }
 
 func (Session *Session) Modify(ies ...pfcp.IeNode) error {
+	return Session.ModifyContext(context.Background(), ies...)
+}
+
+// ModifyContext is Modify which is cancelled with the context
+func (Session *Session) ModifyContext(ctx context.Context, ies ...pfcp.IeNode) error {
 	_, err := doRequest(
+		ctx,
 		Session.PfcpPeer,
 		pfcp.NewSessionMessage(
 			pfcp.PFCP_Session_Modification_Request,
@@ -51,7 +58,13 @@ func (Session *Session) Modify(ies ...pfcp.IeNode) error {
 }
 
 func (Session *Session) Delete() error {
+	return Session.DeleteContext(context.Background())
+}
+
+// DeleteContext is Delete which is cancelled with the context
+func (Session *Session) DeleteContext(ctx context.Context) error {
 	_, err := doRequest(
+		ctx,
 		Session.PfcpPeer,
 		pfcp.NewSessionMessage(
 			pfcp.PFCP_Session_Deletion_Request,
@@ -62,8 +75,13 @@ func (Session *Session) Delete() error {
 }
 
 func (association *Association) CreateSession(ies ...pfcp.IeNode) (*Session, error) {
+	return association.CreateSessionContext(context.Background(), ies...)
+}
+
+// CreateSessionContext is CreateSession which is cancelled with the context
+func (association *Association) CreateSessionContext(ctx context.Context, ies ...pfcp.IeNode) (*Session, error) {
 	localSeid, ser := association.baseSER(ies...)
-	if peerSeid, err := doSessionEstablishmentRequest(association.PfcpPeer, ser); err != nil {
+	if peerSeid, err := doSessionEstablishmentRequest(ctx, association.PfcpPeer, ser); err != nil {
 		return nil, err
 	} else {
 		return &Session{
@@ -78,9 +96,10 @@ func (association *Association) CreateSession(ies ...pfcp.IeNode) (*Session, err
 func (association *Association) Clone(peerAddr netip.AddrPort) (*Association, error) {
 	nodeIp := association.nodeId
 	upfPeer := association.PfcpEndpoint.Peer(peerAddr)
+	upfPeer.SetLocalNodeId(pfcp.IE_NodeIdIp(nodeIp))
 	recoveryTime := pfcp.GetRecoveryTime()
 
-	if _, err := doRequest(upfPeer, associationRequest(nodeIp, recoveryTime)); err != nil {
+	if _, err := doRequest(context.Background(), upfPeer, associationRequest(nodeIp, recoveryTime)); err != nil {
 		return nil, err
 	} else {
 		go heartbeatResponder(upfPeer, pfcp.GetRecoveryTime())
@@ -93,16 +112,22 @@ func (association *Association) Clone(peerAddr netip.AddrPort) (*Association, er
 }
 
 func CreateAssociation(localAddr, peerAddr netip.AddrPort) (*Association, error) {
+	return CreateAssociationContext(context.Background(), localAddr, peerAddr)
+}
+
+// CreateAssociationContext is CreateAssociation with the Association Setup Request cancelled with the context
+func CreateAssociationContext(ctx context.Context, localAddr, peerAddr netip.AddrPort) (*Association, error) {
 	if local, err := endpoint.NewPfcpEndpoint(localAddr); err != nil {
 		return nil, fmt.Errorf("failed to create endpoint for  %s (%s)", localAddr, err)
 	} else {
 		nodeIp := localAddr.Addr()
 		upfPeer := local.Peer(peerAddr)
+		upfPeer.SetLocalNodeId(pfcp.IE_NodeIdIp(nodeIp))
 		recoveryTime := pfcp.GetRecoveryTime()
 
 		log.Printf("using local:%s peer:%s for peer upf\n", localAddr, peerAddr)
 
-		if _, err := doRequest(upfPeer, associationRequest(nodeIp, recoveryTime)); err != nil {
+		if _, err := doRequest(ctx, upfPeer, associationRequest(nodeIp, recoveryTime)); err != nil {
 			return nil, err
 		} else {
 
@@ -147,7 +172,7 @@ func heartbeatResponder(peer *endpoint.PfcpPeer, recoveryTime uint32) {
 
 // 	return pfcp.NewSessionMessage(pfcp.PFCP_Session_Establishment_Request,
 // 		0,
-// 		pfcp.IE_NodeIdIpV4(nodeId),
+// 		pfcp.IE_NodeIdIp(nodeId),
 // 		pfcp.IE_FSeid(uint64(sessionRequest.SEID), nodeId),
 // 		b.DecapPdr(b.ModeCreate, sessionRequest.Request.accessPdr),
 // 		b.DecapFar(b.ModeCreate),
@@ -157,11 +182,11 @@ func heartbeatResponder(peer *endpoint.PfcpPeer, recoveryTime uint32) {
 // }
 
 func associationRequest(nodeId netip.Addr, recoveryTime uint32) *pfcp.PfcpMessage {
-	return pfcp.NewNodeMessage(pfcp.PFCP_Association_Setup_Request, pfcp.IE_NodeIdIpV4(nodeId), pfcp.IE_RecoveryTimeStamp(recoveryTime))
+	return pfcp.NewNodeMessage(pfcp.PFCP_Association_Setup_Request, pfcp.IE_NodeIdIp(nodeId), pfcp.IE_RecoveryTimeStamp(recoveryTime))
 }
 
-func doRequest(peer *endpoint.PfcpPeer, request *pfcp.PfcpMessage) (*pfcp.PfcpMessage, error) {
-	if response, err := peer.BlockingRequest(request); err != nil {
+func doRequest(ctx context.Context, peer *endpoint.PfcpPeer, request *pfcp.PfcpMessage) (*pfcp.PfcpMessage, error) {
+	if response, err := peer.RequestContext(ctx, request); err != nil {
 		return nil, err
 	} else if cause, err := response.Node().ReadCauseCode(); err != nil {
 		return nil, fmt.Errorf(("%s request failed with missing cause"), request.MessageTypeCode)
@@ -173,8 +198,8 @@ func doRequest(peer *endpoint.PfcpPeer, request *pfcp.PfcpMessage) (*pfcp.PfcpMe
 	}
 }
 
-func doSessionEstablishmentRequest(peer *endpoint.PfcpPeer, request *pfcp.PfcpMessage) (pfcp.SEID, error) {
-	if response, err := doRequest(peer, request); err != nil {
+func doSessionEstablishmentRequest(ctx context.Context, peer *endpoint.PfcpPeer, request *pfcp.PfcpMessage) (pfcp.SEID, error) {
+	if response, err := doRequest(ctx, peer, request); err != nil {
 		return 0, err
 	} else if fseid, err := response.Node().Getter().GetByTc(pfcp.F_SEID).DeserialiseFSeid(); err != nil {
 		return 0, fmt.Errorf("sessionRequest request failed - missing SEID in reply")
@@ -5,8 +5,11 @@ package testcases
 
 import (
 	"testing"
+	"time"
 
 	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
+	"pfcpcore/transport"
 )
 
 func TestEndpoint(t *testing.T) {
@@ -43,3 +46,31 @@ func TestEndpoint(t *testing.T) {
 		CloseWait()
 	}
 }
+
+// the exchange of TestEndpoint survives a lossy network, with retransmissions, on a fake clock
+func TestEndpointSimulatedNetwork(t *testing.T) {
+	clock := simnet.NewFakeClock()
+	peer1, peer2, end1, end2 := MakeSimulatedTestPeers(clock, transport.WithRetryPolicy(transport.RetryPolicy{N1: 10, T1: time.Second}))
+	end1.SetConditions(simnet.Conditions{Loss: 0.3, Duplication: 0.2, Reordering: 0.1, Seed: 1})
+	end2.SetConditions(simnet.Conditions{Loss: 0.3, Duplication: 0.2, Seed: 2})
+
+	go func() {
+		for _, response := range pfcp.TestSet1Responses {
+			m := <-peer2.RequestChan
+			peer2.EnterResponse(response, m)
+		}
+	}()
+	done := make(chan struct{})
+	go RunFakeClock(clock, done)
+	for _, request := range pfcp.TestSet1Requests {
+		if _, err := peer1.BlockingRequest(request); err != nil {
+			t.Fatalf("request failed %s", err.Error())
+		}
+	}
+	close(done)
+	if stats := end1.Stats(); stats.Lost+stats.Duplicated+stats.Reordered == 0 {
+		t.Errorf("no faults %+v", stats)
+	}
+	peer1.Drop()
+	peer2.Drop()
+}
@@ -5,8 +5,12 @@ package testcases
 
 import (
 	"net/netip"
+	"time"
 
 	"pfcpcore/endpoint"
+	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
+	"pfcpcore/transport"
 )
 
 var sersmr []string = []string{"../samples/SEreq.bin", "../samples/SMreq.bin"}
@@ -38,3 +42,27 @@ func MakeTestPeers() (*endpoint.PfcpPeer, *endpoint.PfcpPeer, error) {
 		return peer1, peer2, nil
 	}
 }
+
+// MakeSimulatedTestPeers are test peers on a simulated network, without sockets, whose time is the fake clock.
+// The faults of the datagrams which each peer sends are set on its End.
+func MakeSimulatedTestPeers(clock *simnet.FakeClock, options ...transport.Option) (*endpoint.PfcpPeer, *endpoint.PfcpPeer, *simnet.End, *simnet.End) {
+	end1, end2 := simnet.NewLink(clock)
+	options = append(options, transport.WithClock(clock))
+	peer1 := endpoint.NewPfcpPeer(end1, pfcp.DefaultProfile, options...)
+	peer2 := endpoint.NewPfcpPeer(end2, pfcp.DefaultProfile, options...)
+	return peer1, peer2, end1, end2
+}
+
+// RunFakeClock advances the clock to its next timer whenever the peers have been idle for a while, until done is closed
+func RunFakeClock(clock *simnet.FakeClock, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(10 * time.Millisecond):
+			if clock.Pending() > 0 {
+				clock.AdvanceToNext()
+			}
+		}
+	}
+}
@@ -20,7 +20,10 @@ func GetterTest(t *testing.T) {
 		t.Fatalf("message #1 is not SER: %s (%d)\n", ser.TypeCode(), ser.TypeCode())
 	} else {
 		session.AccessSER(ser)
-		sessionState := session.ParseUpfSER(ser.Node())
-		t.Logf("success reading session state from SER:\n%s\n", sessionState)
+		if sessionState, err := session.ParseUpfSER(ser.Node()); err != nil {
+			t.Errorf("failed to read session state from SER - %s", err.Error())
+		} else {
+			t.Logf("success reading session state from SER:\n%s\n", sessionState)
+		}
 	}
 }
@@ -0,0 +1,105 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
+	"pfcpcore/transport"
+	"pfcpcore/udpserver"
+)
+
+// the request is cancelled while waiting for the response, and is not sent again
+func TestRequestContextCancel(t *testing.T) {
+	clock := simnet.NewFakeClock()
+	endpoint, udp := newRetryTestTransport(transport.WithClock(clock))
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error)
+	go func() {
+		request := pfcp.HeartBeatRequest
+		_, err := endpoint.RequestContext(ctx, &request)
+		result <- err
+	}()
+
+	sent, err := pfcp.ParseValidate((<-udp.Send).Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := clock.WaitForTimers(1); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	select {
+	case err := <-result:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("cancelled request returned %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("cancelled request did not return")
+	}
+	waitForNoRequests(t, endpoint)
+
+	// a late response is dropped
+	response := pfcp.HeartBeatResponse
+	response.SetPfcpSequenceNumber(sent.PfcpSequenceNumber())
+	udp.Receive <- udpserver.ToUdpMessage(response.Serialise())
+	clock.Advance(time.Minute)
+	select {
+	case <-udp.Send:
+		t.Errorf("cancelled request sent again")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+// the deadline of the context ends the request also while it cannot be sent
+func TestRequestContextDeadline(t *testing.T) {
+	endpoint, _ := newRetryTestTransport()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	request := pfcp.HeartBeatRequest
+	if _, err := endpoint.RequestContext(ctx, &request); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("request returned %v", err)
+	}
+	waitForNoRequests(t, endpoint)
+}
+
+// a response which arrives before the cancellation is the result
+func TestRequestContextAnswered(t *testing.T) {
+	clock := simnet.NewFakeClock()
+	endpoint, udp := newRetryTestTransport(transport.WithClock(clock))
+	ctx, cancel := context.WithCancel(context.Background())
+	replyChannel := make(chan transport.RequestReturn, 1)
+	request := pfcp.HeartBeatRequest
+	endpoint.EnterRequestContext(ctx, &request, replyChannel)
+
+	sent, _ := pfcp.ParseValidate((<-udp.Send).Payload)
+	response := pfcp.HeartBeatResponse
+	response.SetPfcpSequenceNumber(sent.PfcpSequenceNumber())
+	udp.Receive <- udpserver.ToUdpMessage(response.Serialise())
+	result := <-replyChannel
+	cancel()
+	if m, err := result.Value(); err != nil || m.MessageTypeCode != pfcp.PFCP_Heartbeat_Response {
+		t.Errorf("result %v, %v", m, err)
+	}
+	waitForNoRequests(t, endpoint)
+	select {
+	case result := <-replyChannel:
+		t.Errorf("second result %v", result)
+	default:
+	}
+}
+
+func waitForNoRequests(t *testing.T, endpoint *transport.Transport) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); endpoint.RequestsInFlight() != 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d requests still in flight", endpoint.RequestsInFlight())
+		}
+	}
+}
@@ -4,9 +4,9 @@
 package transport
 
 import (
+	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 	"pfcpcore/pfcp"
@@ -15,12 +15,15 @@ import (
 
 type requestState struct {
 	replyChannel chan RequestReturn
+	answered     chan struct{} // closed when the response is received
 }
 
 type Requestor struct {
 	nextSequenceNumber pfcp.PfcpSequenceNumber
 	inFlight           map[pfcp.PfcpSequenceNumber]*requestState
 	mutex              sync.Mutex
+	policy             RetryPolicy
+	clock              Clock
 }
 
 func (requestor *Requestor) Drop() {
@@ -34,48 +37,39 @@ func (requestor *Requestor) Drop() {
 
 // How does retry work?
 // The message state held by sequence number holds the reply channel.
-// When a reply has been received the receive side sets the channel pointer to nil,
-// this allows the send side to exit gracefully only when the work is done.
+// When a reply has been received the receive side closes the answered channel,
+// the send side then stops retransmitting, and removes the state once the current interval has passed,
+// so that a late repeat of the response is still recognised as such.
+// When the context of the request ends, the send side stops at once, and removes the state.
+// Whichever of response, failure and cancellation claims the reply channel first is the one result of the request.
 
-func (r *Requestor) enterRequest(message *pfcp.PfcpMessage, replyChannel chan RequestReturn, udpSendChannel chan *udpserver.UdpMessage) {
+func (r *Requestor) enterRequest(ctx context.Context, message *pfcp.PfcpMessage, replyChannel chan RequestReturn, udpSendChannel chan<- *udpserver.UdpMessage, policy RetryPolicy) {
 	if replyChannel == nil {
 		panic("pfcpcore: nil reply channel is fatal")
 	}
 
-	r.mutex.Lock()
-	r.inFlight[r.nextSequenceNumber] = &requestState{
+	state := &requestState{
 		replyChannel: replyChannel,
+		answered:     make(chan struct{}),
+	}
+	r.mutex.Lock()
+	sequenceNumber, err := r.allocateSequenceNumber()
+	if err == nil {
+		r.inFlight[sequenceNumber] = state
 	}
-	sequenceNumber := r.nextSequenceNumber
-	r.nextSequenceNumber++
 	r.mutex.Unlock()
+	if err != nil {
+		log.Errorf("%s\n", err.Error())
+		go func() { replyChannel <- RequestReturn{err: err} }()
+		return
+	}
 	message.SetPfcpSequenceNumber(sequenceNumber)
 
 	go func() {
-		timedOut := true
-		for n := N1; n > 0; n -= 1 {
-			if n < N1 {
-				log.Debug("pfcpcore: resending request")
-			}
-			udpSendChannel <- &udpserver.UdpMessage{Payload: message.Serialise()}
-			time.Sleep(T1)
-			r.mutex.Lock()
-			state, found := r.inFlight[sequenceNumber]
-			r.mutex.Unlock()
-			if !found {
-				log.Errorf("pfcpcore: impossible condition in enterRequest() poll loop")
-			} else {
-				if state.replyChannel == nil {
-					timedOut = false
-					n = 0
-				} else {
-					log.Trace("pfcpcore: no response yet seen")
-				}
-			}
-		}
-		if timedOut {
-			log.Tracef("pfcpcore: request failed with timeout %s\n", sequenceNumber)
-			replyChannel <- RequestReturn{err: fmt.Errorf("pfcpcore: request failed with timeout %s", sequenceNumber)}
+		if err := r.retransmit(ctx, message, sequenceNumber, state, udpSendChannel, policy); err == nil {
+		} else if replyChannel := r.claim(state); replyChannel != nil {
+			log.Tracef("%s\n", err.Error())
+			replyChannel <- RequestReturn{err: err}
 		}
 		r.mutex.Lock()
 		delete(r.inFlight, sequenceNumber)
@@ -83,22 +77,101 @@ func (r *Requestor) enterRequest(message *pfcp.PfcpMessage, replyChannel chan Re
 	}()
 }
 
+// allocateSequenceNumber takes the next sequence number which is not in flight, they wrap as only 24 bits are sent.
+// The mutex must be held.
+func (r *Requestor) allocateSequenceNumber() (pfcp.PfcpSequenceNumber, error) {
+	for i := 0; i <= int(maxSequenceNumber); i++ {
+		sequenceNumber := r.nextSequenceNumber & maxSequenceNumber
+		r.nextSequenceNumber = (sequenceNumber + 1) & maxSequenceNumber
+		if _, inFlight := r.inFlight[sequenceNumber]; !inFlight {
+			return sequenceNumber, nil
+		}
+	}
+	return 0, fmt.Errorf("pfcpcore: request failed, every sequence number is in flight")
+}
+
+// retransmit sends the request until it is answered, or fails by the policy
+func (r *Requestor) retransmit(ctx context.Context, message *pfcp.PfcpMessage, sequenceNumber pfcp.PfcpSequenceNumber, state *requestState, udpSendChannel chan<- *udpserver.UdpMessage, policy RetryPolicy) error {
+	start := r.clock.Now()
+	for n := 1; ; n++ {
+		if n > 1 {
+			log.Debug("pfcpcore: resending request")
+		}
+		select {
+		case udpSendChannel <- &udpserver.UdpMessage{Payload: message.Serialise()}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		interval := policy.Interval(n)
+		if policy.Deadline > 0 {
+			interval = min(interval, policy.Deadline-r.clock.Now().Sub(start))
+		}
+		timer := r.clock.After(interval)
+		select {
+		case <-state.answered:
+			select {
+			case <-timer:
+			case <-ctx.Done():
+			}
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer:
+		}
+
+		select {
+		case <-state.answered:
+			// answered as the interval ended
+			return nil
+		default:
+		}
+		if policy.Deadline > 0 && r.clock.Now().Sub(start) >= policy.Deadline {
+			return fmt.Errorf("pfcpcore: request failed with deadline exceeded %s", sequenceNumber)
+		} else if n >= policy.N1 {
+			return fmt.Errorf("pfcpcore: request failed with timeout %s", sequenceNumber)
+		} else {
+			log.Trace("pfcpcore: no response yet seen")
+		}
+	}
+}
+
 func (r *Requestor) handleResponse(pfcpMessage *pfcp.PfcpMessage) {
 	sequenceNumber := pfcpMessage.PfcpSequenceNumber()
 	r.mutex.Lock()
 	request, found := r.inFlight[sequenceNumber]
 	r.mutex.Unlock()
 
+	var replyChannel chan RequestReturn
+	if found {
+		// the first response is the answer, the state remains only to recognise repeats
+		if replyChannel = r.claim(request); replyChannel != nil {
+			close(request.answered)
+		}
+	}
+
 	if !found {
 		log.Warnf("pfcpcore: unknown SEID in response from peer - seid: %s\n", sequenceNumber)
-	} else if request.replyChannel == nil {
+	} else if replyChannel == nil {
 		log.Warnf("pfcpcore: unexpected repeat response from peer - seid: %s\n", sequenceNumber)
 	} else {
 		pfcpMessage.SetPfcpSequenceNumber(0) // the client must not know anything of sequence numbers!
-		request.replyChannel <- RequestReturn{message: pfcpMessage}
-		request.replyChannel = nil
-		r.mutex.Lock()
-		r.inFlight[sequenceNumber] = request
-		r.mutex.Unlock()
+		replyChannel <- RequestReturn{message: pfcpMessage}
 	}
 }
+
+// claim takes the reply channel of the request, which is nil once the request has its result
+func (r *Requestor) claim(state *requestState) chan RequestReturn {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	replyChannel := state.replyChannel
+	state.replyChannel = nil
+	return replyChannel
+}
+
+// RequestsInFlight is the number of requests which are sent and not yet removed
+func (r *Requestor) RequestsInFlight() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.inFlight)
+}
@@ -18,6 +18,7 @@ NB sync.Lock() strategy is very sensitive for handleReesponse / enterRequest;
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"pfcpcore/pfcp"
@@ -35,77 +36,185 @@ func (response PeerRequest) String() string {
 }
 
 type peerRequestState struct {
-	reply *udpserver.UdpMessage
+	reply   *udpserver.UdpMessage // nil while the request is pending with the client
+	expires time.Time
+}
+
+// ResponseCachePolicy bounds the state which the responder keeps to answer retransmitted requests.
+// An answered request expires Lifetime after its reply, which should cover the N1*T1 of the peer.
+// When MaxEntries replies are cached, the oldest is evicted before its lifetime.
+// Requests pending with the client are neither expired nor evicted, so that their reply is always sent and cached.
+type ResponseCachePolicy struct {
+	Lifetime   time.Duration
+	MaxEntries int // of answered requests, 0 for no limit
+}
+
+// DefaultResponseCache covers peers with the default retry policy
+var DefaultResponseCache = ResponseCachePolicy{Lifetime: N1 * T1, MaxEntries: 65536}
+
+// WithResponseCache sets the policy of the cache of replies to requests from the peer
+func WithResponseCache(policy ResponseCachePolicy) Option {
+	return func(transport *Transport) { transport.Responder.cache = policy }
+}
+
+// ResponderMetrics counts the requests from the peer, and the use of the cache of replies
+type ResponderMetrics struct {
+	Requests        uint64 // new requests passed to the client
+	Rejected        uint64 // invalid requests answered by the transport
+	Retransmissions uint64 // repeated requests answered from the cache
+	Expired         uint64 // replies removed after their lifetime
+	Evicted         uint64 // replies removed before their lifetime, as the cache was full
+	Entries         int    // the current size of the cache, pending requests included
+}
+
+// cachedReply is an answered request in the expiry queue
+type cachedReply struct {
+	sequenceNumber pfcp.PfcpSequenceNumber
+	state          *peerRequestState
 }
 
 type Responder struct {
 	requestChannel chan PeerRequest
 	inFlight       map[pfcp.PfcpSequenceNumber]*peerRequestState
 	mutex          sync.Mutex
+	cache          ResponseCachePolicy
+	clock          Clock
+	replies        []cachedReply // the answered requests, in order of expiry, as each expires Lifetime after it is queued
+	metrics        ResponderMetrics
 }
 
 func (responder *Responder) Drop() {
 	responder.mutex.Lock()
 	responder.inFlight = nil
+	responder.replies = nil
 	responder.mutex.Unlock()
 }
 
-// *** TODO ! *** set an expiry timer to eventually discard the state
+// Metrics returns the current counters of the responder
+func (r *Responder) Metrics() ResponderMetrics {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	metrics := r.metrics
+	metrics.Entries = len(r.inFlight)
+	return metrics
+}
+
+// lookup returns the entry of a request, after removing the expired replies, so that a peer which has wrapped its
+// sequence numbers does not get a stale reply. The mutex must be held.
+func (r *Responder) lookup(sequenceNumber pfcp.PfcpSequenceNumber) (*peerRequestState, bool) {
+	r.expire()
+	state, found := r.inFlight[sequenceNumber]
+	return state, found
+}
+
+// cacheReply queues an answered request for expiry, evicting the oldest reply if the cache is full. The mutex must be held.
+func (r *Responder) cacheReply(sequenceNumber pfcp.PfcpSequenceNumber, state *peerRequestState) {
+	r.expire()
+	if r.cache.MaxEntries > 0 && len(r.replies) >= r.cache.MaxEntries {
+		log.Warnf("Responder - cache full, evicting the reply to request %s\n", r.replies[0].sequenceNumber)
+		r.remove(r.replies[0])
+		r.metrics.Evicted++
+	}
+	state.expires = r.clock.Now().Add(r.cache.Lifetime)
+	r.inFlight[sequenceNumber] = state
+	r.replies = append(r.replies, cachedReply{sequenceNumber: sequenceNumber, state: state})
+}
+
+// expire removes the replies at the head of the queue which have expired. The mutex must be held.
+func (r *Responder) expire() {
+	now := r.clock.Now()
+	for len(r.replies) > 0 && !now.Before(r.replies[0].state.expires) {
+		r.remove(r.replies[0])
+		r.metrics.Expired++
+	}
+}
 
-func (r *Responder) enterResponse(reply *pfcp.PfcpMessage, response PeerRequest, udpSendChannel chan *udpserver.UdpMessage) {
+// remove takes the reply at the head of the queue out of the cache
+func (r *Responder) remove(reply cachedReply) {
+	if r.inFlight[reply.sequenceNumber] == reply.state {
+		delete(r.inFlight, reply.sequenceNumber)
+	}
+	r.replies[0] = cachedReply{}
+	r.replies = r.replies[1:]
+}
+
+func (r *Responder) enterResponse(reply *pfcp.PfcpMessage, response PeerRequest, udpSendChannel chan<- *udpserver.UdpMessage) {
 	r.mutex.Lock()
-	state, found := r.inFlight[response.sequenceNumber]
+	state, found := r.lookup(response.sequenceNumber)
 
-	if found {
-		if state.reply != nil {
-			r.mutex.Unlock()
-			log.Errorf("Responder.enterResponse - error, reply already sent! %s\n", response.sequenceNumber)
-		} else if udpSendChannel == nil {
-			r.mutex.Unlock()
-			log.Debug("Responder.enterResponse - error, udpserver channel closed\n")
-		} else {
-			reply.SetPfcpSequenceNumber(response.sequenceNumber)
-			udpReply := udpserver.ToUdpMessage(reply.Serialise())
-			state.reply = udpReply
-			r.inFlight[response.sequenceNumber] = state
-			r.mutex.Unlock()
-			udpSendChannel <- udpReply
-		}
+	if r.inFlight == nil || udpSendChannel == nil {
+		r.mutex.Unlock()
+		log.Debug("Responder.enterResponse - error, udpserver channel closed\n")
+	} else if found && state.reply != nil {
+		r.mutex.Unlock()
+		log.Errorf("Responder.enterResponse - error, reply already sent! %s\n", response.sequenceNumber)
 	} else {
+		if !found {
+			// not expected, as pending requests are kept, but the peer still gets its reply
+			log.Warnf("Responder.enterResponse - warning, reply for unknown request %s\n", response.sequenceNumber)
+			state = &peerRequestState{}
+		}
+		reply.SetPfcpSequenceNumber(response.sequenceNumber)
+		udpReply := udpserver.ToUdpMessage(reply.Serialise())
+		state.reply = udpReply
+		// a retransmission of the request may follow the reply by up to the lifetime
+		r.cacheReply(response.sequenceNumber, state)
 		r.mutex.Unlock()
-		log.Errorf("Responder.enterResponse - error, reply for unknown request! %s\n", response.sequenceNumber)
+		udpSendChannel <- udpReply
 	}
 }
 
-// func (r Requestor) handleRequest(message *pfcp.Message, replyChannel chan RequestReturn, udpSendChannel chan *UdpMessage)
-// Note,this is an incoming request from the peer, not the local client.
-func (r *Responder) handleRequest(requestMessage *pfcp.PfcpMessage, udpSendChannel chan *udpserver.UdpMessage) {
+// rejectRequest sends the response to an invalid request, without passing the request to the client.
+// A retransmission of the request is answered from the stored response, as for valid requests.
+func (r *Responder) rejectRequest(reject *pfcp.PfcpMessage, udpSendChannel chan<- *udpserver.UdpMessage) {
+	sequenceNumber := reject.PfcpSequenceNumber()
+	r.mutex.Lock()
 	if r.inFlight == nil {
+		r.mutex.Unlock()
 		log.Debug("responder - drop inbound request for closed endpoint")
+	} else if state, found := r.lookup(sequenceNumber); found && state.reply != nil {
+		r.metrics.Retransmissions++
+		r.mutex.Unlock()
+		udpSendChannel <- state.reply
+	} else if found {
+		r.mutex.Unlock()
+		log.Errorf("Responder.rejectRequest - error, invalid retransmission of a pending request %s\n", sequenceNumber)
 	} else {
+		udpReply := udpserver.ToUdpMessage(reject.Serialise())
+		r.cacheReply(sequenceNumber, &peerRequestState{reply: udpReply})
+		r.metrics.Rejected++
+		r.mutex.Unlock()
+		udpSendChannel <- udpReply
+	}
+}
 
-		sequenceNumber := requestMessage.PfcpSequenceNumber()
-
-		r.mutex.Lock()
-		state, found := r.inFlight[sequenceNumber]
+// func (r Requestor) handleRequest(message *pfcp.Message, replyChannel chan RequestReturn, udpSendChannel chan *UdpMessage)
+// Note,this is an incoming request from the peer, not the local client.
+func (r *Responder) handleRequest(requestMessage *pfcp.PfcpMessage, udpSendChannel chan<- *udpserver.UdpMessage) {
+	sequenceNumber := requestMessage.PfcpSequenceNumber()
 
-		if found {
-			r.mutex.Unlock()
-			if state.reply == nil {
-				log.Errorf("Responder.handleRequest - error, retranmission request with no pending reply %s\n", sequenceNumber)
-			} else {
-				log.Infof("Responder.handleRequest - warning, retranmission requested %s\n", sequenceNumber)
-				udpSendChannel <- state.reply
-			}
-
-		} else if r.requestChannel == nil {
+	r.mutex.Lock()
+	if r.inFlight == nil {
+		r.mutex.Unlock()
+		log.Debug("responder - drop inbound request for closed endpoint")
+	} else if state, found := r.lookup(sequenceNumber); found {
+		if state.reply == nil {
 			r.mutex.Unlock()
-			log.Debug("responder - drop inbound request for closed endpoint")
+			log.Errorf("Responder.handleRequest - error, retranmission request with no pending reply %s\n", sequenceNumber)
 		} else {
-			r.inFlight[sequenceNumber] = &peerRequestState{}
+			r.metrics.Retransmissions++
 			r.mutex.Unlock()
-			requestMessage.SetPfcpSequenceNumber(0) // the client must not know anything of sequence numbers!
-			r.requestChannel <- PeerRequest{Message: requestMessage, sequenceNumber: sequenceNumber, port: 0}
+			log.Infof("Responder.handleRequest - warning, retranmission requested %s\n", sequenceNumber)
+			udpSendChannel <- state.reply
 		}
+	} else if r.requestChannel == nil {
+		r.mutex.Unlock()
+		log.Debug("responder - drop inbound request for closed endpoint")
+	} else {
+		r.inFlight[sequenceNumber] = &peerRequestState{}
+		r.metrics.Requests++
+		r.mutex.Unlock()
+		requestMessage.SetPfcpSequenceNumber(0) // the client must not know anything of sequence numbers!
+		r.requestChannel <- PeerRequest{Message: requestMessage, sequenceNumber: sequenceNumber, port: 0}
 	}
 }
@@ -0,0 +1,136 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package transport_test
+
+import (
+	"testing"
+	"time"
+
+	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
+	"pfcpcore/transport"
+	"pfcpcore/udpserver"
+)
+
+type responderTest struct {
+	t         *testing.T
+	clock     *simnet.FakeClock
+	endpoint  *transport.Transport
+	udp       *udpserver.UdpServerPeer
+	requests  chan transport.PeerRequest
+	heartbeat pfcp.PfcpMessage
+}
+
+func newResponderTest(t *testing.T, policy transport.ResponseCachePolicy) *responderTest {
+	clock := simnet.NewFakeClock()
+	udp := &udpserver.UdpServerPeer{Send: make(chan *udpserver.UdpMessage, 1), Receive: make(chan *udpserver.UdpMessage)}
+	requests := make(chan transport.PeerRequest, 1)
+	endpoint := transport.NewTransport(udp, requests, transport.WithClock(clock), transport.WithResponseCache(policy))
+	return &responderTest{t: t, clock: clock, endpoint: endpoint, udp: udp, requests: requests, heartbeat: pfcp.HeartBeatRequest}
+}
+
+// receive delivers a request from the peer, and returns the request passed to the client, if any
+func (test *responderTest) receive(sequenceNumber pfcp.PfcpSequenceNumber) (request transport.PeerRequest, toClient bool) {
+	test.t.Helper()
+	test.heartbeat.SetPfcpSequenceNumber(sequenceNumber)
+	test.udp.Receive <- udpserver.ToUdpMessage(test.heartbeat.Serialise())
+	select {
+	case request = <-test.requests:
+		return request, true
+	case <-test.udp.Send:
+		return request, false
+	case <-time.After(time.Second):
+		test.t.Fatalf("request %d neither passed to the client nor answered", sequenceNumber)
+		return
+	}
+}
+
+func (test *responderTest) respond(request transport.PeerRequest) {
+	test.t.Helper()
+	response := pfcp.HeartBeatResponse
+	test.endpoint.EnterResponse(&response, request)
+	select {
+	case <-test.udp.Send:
+	case <-time.After(time.Second):
+		test.t.Fatalf("response to %s not sent", request)
+	}
+}
+
+func TestResponderRetransmission(t *testing.T) {
+	test := newResponderTest(t, transport.ResponseCachePolicy{Lifetime: 10 * time.Second})
+	if request, toClient := test.receive(1); !toClient {
+		t.Fatal("new request not passed to the client")
+	} else {
+		test.respond(request)
+	}
+
+	test.clock.Advance(9 * time.Second)
+	if _, toClient := test.receive(1); toClient {
+		t.Error("retransmitted request passed to the client")
+	}
+
+	// once expired, the sequence number is a new request
+	test.clock.Advance(time.Second)
+	if _, toClient := test.receive(1); !toClient {
+		t.Error("request after expiry not passed to the client")
+	}
+
+	metrics := test.endpoint.Responder.Metrics()
+	if metrics.Requests != 2 || metrics.Retransmissions != 1 || metrics.Expired != 1 || metrics.Entries != 1 {
+		t.Errorf("metrics %+v", metrics)
+	}
+}
+
+func TestResponderSweep(t *testing.T) {
+	test := newResponderTest(t, transport.ResponseCachePolicy{Lifetime: 4 * time.Second})
+	for sequenceNumber := pfcp.PfcpSequenceNumber(1); sequenceNumber <= 3; sequenceNumber++ {
+		request, _ := test.receive(sequenceNumber)
+		test.respond(request)
+	}
+	test.clock.Advance(5 * time.Second)
+	test.receive(4)
+
+	if metrics := test.endpoint.Responder.Metrics(); metrics.Expired != 3 || metrics.Entries != 1 {
+		t.Errorf("metrics %+v", metrics)
+	}
+}
+
+func TestResponderEviction(t *testing.T) {
+	test := newResponderTest(t, transport.ResponseCachePolicy{Lifetime: time.Minute, MaxEntries: 2})
+	for sequenceNumber := pfcp.PfcpSequenceNumber(1); sequenceNumber <= 3; sequenceNumber++ {
+		request, _ := test.receive(sequenceNumber)
+		test.respond(request)
+		test.clock.Advance(time.Second)
+	}
+
+	if metrics := test.endpoint.Responder.Metrics(); metrics.Evicted != 1 || metrics.Entries != 2 {
+		t.Errorf("metrics %+v", metrics)
+	}
+	// the oldest was evicted, the others are still answered from the cache
+	if _, toClient := test.receive(1); !toClient {
+		t.Error("evicted request not passed to the client")
+	}
+	if _, toClient := test.receive(3); toClient {
+		t.Error("cached request passed to the client")
+	}
+}
+
+// a request pending with the client is kept past the lifetime, and when the cache is full, so its reply is sent and cached
+func TestResponderPending(t *testing.T) {
+	test := newResponderTest(t, transport.ResponseCachePolicy{Lifetime: 10 * time.Second, MaxEntries: 1})
+	pending, _ := test.receive(1)
+	for sequenceNumber := pfcp.PfcpSequenceNumber(2); sequenceNumber <= 3; sequenceNumber++ {
+		request, _ := test.receive(sequenceNumber)
+		test.respond(request)
+		test.clock.Advance(20 * time.Second)
+	}
+
+	test.respond(pending)
+	if _, toClient := test.receive(1); toClient {
+		t.Error("retransmission of the pending request passed to the client")
+	}
+	if metrics := test.endpoint.Responder.Metrics(); metrics.Requests != 3 || metrics.Retransmissions != 1 || metrics.Entries != 1 {
+		t.Errorf("metrics %+v", metrics)
+	}
+}
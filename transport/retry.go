@@ -0,0 +1,69 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package transport
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy controls the retransmission of requests which are not answered, see TS 29.244 7.6.
+// The request is sent at most N1 times, T1 after the first transmission it is sent again,
+// and each following interval is Backoff times the previous one, up to MaxInterval.
+// Jitter randomises each interval by up to the fraction given, e.g. 0.1 for +/- 10%.
+// A request which has no response N1 intervals, or Deadline, after its first transmission fails.
+type RetryPolicy struct {
+	N1          int
+	T1          time.Duration
+	Backoff     float64       // 0 or 1 for a fixed interval
+	MaxInterval time.Duration // 0 for no limit
+	Jitter      float64       // 0 for none
+	Deadline    time.Duration // 0 for no limit other than N1
+}
+
+// DefaultRetryPolicy sends requests N1 times at the fixed interval T1
+var DefaultRetryPolicy = RetryPolicy{N1: N1, T1: T1}
+
+// Interval is the time to wait after the nth transmission, counting from 1
+func (policy RetryPolicy) Interval(n int) time.Duration {
+	interval := float64(policy.T1)
+	if policy.Backoff > 0 {
+		interval *= math.Pow(policy.Backoff, float64(n-1))
+	}
+	if policy.MaxInterval > 0 && interval > float64(policy.MaxInterval) {
+		interval = float64(policy.MaxInterval)
+	}
+	if policy.Jitter > 0 {
+		interval *= 1 + policy.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(interval)
+}
+
+// Clock is the time source of the transport, which tests replace to control retransmissions
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Option configures a Transport when it is created
+type Option func(*Transport)
+
+// WithRetryPolicy sets the retry policy for requests which are entered without their own policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(transport *Transport) { transport.Requestor.policy = policy }
+}
+
+// WithClock replaces the system clock, e.g. with a fake clock in tests
+func WithClock(clock Clock) Option {
+	return func(transport *Transport) {
+		transport.Requestor.clock = clock
+		transport.Responder.clock = clock
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package transport_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
+	"pfcpcore/transport"
+	"pfcpcore/udpserver"
+)
+
+// newRetryTestTransport is a transport on plain channels, without a socket
+func newRetryTestTransport(options ...transport.Option) (*transport.Transport, *udpserver.UdpServerPeer) {
+	udp := &udpserver.UdpServerPeer{Send: make(chan *udpserver.UdpMessage), Receive: make(chan *udpserver.UdpMessage)}
+	return transport.NewTransport(udp, make(chan transport.PeerRequest), options...), udp
+}
+
+// sendTimes returns the times, on the fake clock, at which the request is sent, and the result of the request
+func sendTimes(t *testing.T, clock *simnet.FakeClock, udp *udpserver.UdpServerPeer, replyChannel chan transport.RequestReturn) (times []time.Duration, result transport.RequestReturn) {
+	t.Helper()
+	for {
+		select {
+		case <-udp.Send:
+			times = append(times, clock.Elapsed())
+			if err := clock.WaitForTimers(1); err != nil {
+				t.Fatal(err)
+			}
+			clock.AdvanceToNext()
+		case result = <-replyChannel:
+			return
+		case <-time.After(time.Second):
+			t.Fatalf("no result after %v", times)
+		}
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	for name, test := range map[string]struct {
+		policy transport.RetryPolicy
+		times  []time.Duration
+		err    string
+	}{
+		"fixed":    {transport.RetryPolicy{N1: 3, T1: time.Second}, []time.Duration{0, time.Second, 2 * time.Second}, "timeout"},
+		"backoff":  {transport.RetryPolicy{N1: 5, T1: time.Second, Backoff: 2, MaxInterval: 3 * time.Second}, []time.Duration{0, 1 * time.Second, 3 * time.Second, 6 * time.Second, 9 * time.Second}, "timeout"},
+		"deadline": {transport.RetryPolicy{N1: 10, T1: time.Second, Backoff: 2, Deadline: 5 * time.Second}, []time.Duration{0, 1 * time.Second, 3 * time.Second}, "deadline"},
+	} {
+		clock := simnet.NewFakeClock()
+		endpoint, udp := newRetryTestTransport(transport.WithRetryPolicy(test.policy), transport.WithClock(clock))
+		replyChannel := make(chan transport.RequestReturn)
+		request := pfcp.HeartBeatRequest
+		endpoint.EnterRequest(&request, replyChannel)
+
+		times, result := sendTimes(t, clock, udp, replyChannel)
+		if _, err := result.Value(); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: result %v", name, err)
+		} else if !slices.Equal(times, test.times) {
+			t.Errorf("%s: sent at %v, expected %v", name, times, test.times)
+		} else if name == "deadline" && clock.Elapsed() != 5*time.Second {
+			t.Errorf("%s: failed at %s", name, clock.Elapsed())
+		}
+	}
+}
+
+// a request entered with its own policy does not use the policy of the transport
+func TestRetryPolicyPerRequest(t *testing.T) {
+	clock := simnet.NewFakeClock()
+	endpoint, udp := newRetryTestTransport(transport.WithClock(clock))
+	replyChannel := make(chan transport.RequestReturn)
+	request := pfcp.HeartBeatRequest
+	endpoint.EnterRequestWithPolicy(&request, replyChannel, transport.RetryPolicy{N1: 2, T1: 5 * time.Second})
+
+	if times, result := sendTimes(t, clock, udp, replyChannel); !slices.Equal(times, []time.Duration{0, 5 * time.Second}) {
+		t.Errorf("sent at %v", times)
+	} else if _, err := result.Value(); err == nil {
+		t.Errorf("request without response succeeded")
+	}
+}
+
+// the response ends the retransmissions
+func TestRetryAnswered(t *testing.T) {
+	clock := simnet.NewFakeClock()
+	endpoint, udp := newRetryTestTransport(transport.WithRetryPolicy(transport.RetryPolicy{N1: 3, T1: time.Second}), transport.WithClock(clock))
+	replyChannel := make(chan transport.RequestReturn)
+	request := pfcp.HeartBeatRequest
+	endpoint.EnterRequest(&request, replyChannel)
+
+	<-udp.Send
+	if err := clock.WaitForTimers(1); err != nil {
+		t.Fatal(err)
+	}
+	clock.AdvanceToNext()
+	sent, err := pfcp.ParseValidate((<-udp.Send).Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := pfcp.HeartBeatResponse
+	response.SetPfcpSequenceNumber(sent.PfcpSequenceNumber())
+	udp.Receive <- udpserver.ToUdpMessage(response.Serialise())
+	result := <-replyChannel
+	if m, err := result.Value(); err != nil || m.MessageTypeCode != pfcp.PFCP_Heartbeat_Response {
+		t.Fatalf("response %v, %v", m, err)
+	}
+
+	if err := clock.WaitForTimers(1); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(time.Minute)
+	select {
+	case <-udp.Send:
+		t.Errorf("answered request sent again")
+	case result := <-replyChannel:
+		t.Errorf("second result %v", result)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestRetryInterval(t *testing.T) {
+	policy := transport.RetryPolicy{N1: 5, T1: time.Second, Backoff: 1.5, MaxInterval: 2 * time.Second, Jitter: 0.2}
+	for n, expected := range []time.Duration{time.Second, 1500 * time.Millisecond, 2 * time.Second, 2 * time.Second} {
+		for i := 0; i < 100; i++ {
+			if interval := policy.Interval(n + 1); interval < expected*8/10 || interval > expected*12/10 {
+				t.Fatalf("interval %d is %s, expected %s +/- 20%%", n+1, interval, expected)
+			}
+		}
+	}
+	if interval := transport.DefaultRetryPolicy.Interval(7); interval != transport.T1 {
+		t.Errorf("default interval %s", interval)
+	}
+}
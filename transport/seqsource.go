@@ -10,6 +10,9 @@ import (
 	"pfcpcore/pfcp"
 )
 
+// maxSequenceNumber is the largest of the 24 bit sequence numbers of the header, TS 29.244 7.2.2.1
+const maxSequenceNumber pfcp.PfcpSequenceNumber = 1<<24 - 1
+
 func getSeqStart() pfcp.PfcpSequenceNumber {
 	b := make([]byte, 4)
 	rand.Read(b[1:])
@@ -0,0 +1,82 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package transport
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"pfcpcore/pfcp"
+	"pfcpcore/udpserver"
+)
+
+func TestSequenceNumberWrap(t *testing.T) {
+	r := Requestor{
+		nextSequenceNumber: maxSequenceNumber - 1,
+		inFlight:           map[pfcp.PfcpSequenceNumber]*requestState{maxSequenceNumber: {}, 0: {}, 2: {}},
+	}
+	var allocated []pfcp.PfcpSequenceNumber
+	for i := 0; i < 3; i++ {
+		if sequenceNumber, err := r.allocateSequenceNumber(); err != nil {
+			t.Fatal(err)
+		} else {
+			allocated = append(allocated, sequenceNumber)
+		}
+	}
+	if expected := []pfcp.PfcpSequenceNumber{maxSequenceNumber - 1, 1, 3}; !slices.Equal(allocated, expected) {
+		t.Errorf("allocated %v, expected %v", allocated, expected)
+	}
+}
+
+func TestSequenceNumberStart(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		if start := getSeqStart(); start > maxSequenceNumber {
+			t.Fatalf("start %d is not 24 bits", start)
+		}
+	}
+}
+
+// a long stream of requests, some outstanding at any time, is answered across the wrap of the sequence numbers
+func TestRequestStreamWrap(t *testing.T) {
+	const requests, outstanding = 2000, 20
+	udp := &udpserver.UdpServerPeer{Send: make(chan *udpserver.UdpMessage), Receive: make(chan *udpserver.UdpMessage)}
+	transport := NewTransport(udp, make(chan PeerRequest), WithRetryPolicy(RetryPolicy{N1: 3, T1: 10 * time.Millisecond}))
+	transport.Requestor.nextSequenceNumber = maxSequenceNumber - requests/2
+
+	// the peer answers every request, with the sequence number read from the wire
+	wrapped := false
+	go func() {
+		for message := range udp.Send {
+			request, err := pfcp.ParseValidate(message.Payload)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			wrapped = wrapped || request.PfcpSequenceNumber() < requests
+			response := pfcp.HeartBeatResponse
+			response.SetPfcpSequenceNumber(request.PfcpSequenceNumber())
+			udp.Receive <- udpserver.ToUdpMessage(response.Serialise())
+		}
+	}()
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, outstanding)
+	for i := 0; i < requests; i++ {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() { <-slots; wg.Done() }()
+			request := pfcp.HeartBeatRequest
+			if _, err := transport.BlockingRequest(&request); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if !wrapped {
+		t.Error("sequence numbers did not wrap")
+	}
+}
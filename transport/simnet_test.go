@@ -0,0 +1,144 @@
+// Copyright 2024 BISDN GmbH
+// This program is free software: you can redistribute it and/or modify it under the terms of the GNU Affero General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+// You should have received a copy of the GNU Affero General Public License along with this program.
+package transport_test
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
+	"pfcpcore/transport"
+)
+
+// simnetTest is a client and a server transport on a simulated link, with a fake clock
+type simnetTest struct {
+	clock                *simnet.FakeClock
+	client, server       *transport.Transport
+	clientEnd, serverEnd *simnet.End
+	serverRequests       atomic.Int32 // requests passed to the server application
+}
+
+func newSimnetTest(t *testing.T, clientFaults, serverFaults []simnet.Fault) *simnetTest {
+	test := &simnetTest{clock: simnet.NewFakeClock()}
+	test.clientEnd, test.serverEnd = simnet.NewLink(test.clock)
+	test.clientEnd.SetConditions(simnet.Conditions{Script: script(clientFaults)})
+	test.serverEnd.SetConditions(simnet.Conditions{Script: script(serverFaults)})
+
+	policy := transport.WithRetryPolicy(transport.RetryPolicy{N1: 3, T1: time.Second})
+	test.client = transport.NewTransport(test.clientEnd, make(chan transport.PeerRequest), policy, transport.WithClock(test.clock))
+	requests := make(chan transport.PeerRequest)
+	test.server = transport.NewTransport(test.serverEnd, requests, policy, transport.WithClock(test.clock))
+	go func() {
+		for request := range requests {
+			test.serverRequests.Add(1)
+			response := pfcp.HeartBeatResponse
+			test.server.EnterResponse(&response, request)
+		}
+	}()
+	t.Cleanup(func() {
+		test.client.Drop()
+		test.server.Drop()
+	})
+	return test
+}
+
+// script gives the faults of the first datagrams, the rest are delivered
+func script(faults []simnet.Fault) func(n int) simnet.Fault {
+	return func(n int) simnet.Fault {
+		if n <= len(faults) {
+			return faults[n-1]
+		}
+		return simnet.Deliver
+	}
+}
+
+// request sends a heartbeat, advancing the fake clock whenever the transports wait for it
+func (test *simnetTest) request(t *testing.T) (*pfcp.PfcpMessage, error) {
+	t.Helper()
+	replyChannel := make(chan transport.RequestReturn, 1)
+	request := pfcp.HeartBeatRequest
+	test.client.EnterRequest(&request, replyChannel)
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+		select {
+		case result := <-replyChannel:
+			return result.Value()
+		case <-time.After(20 * time.Millisecond):
+			if test.clock.Pending() > 0 {
+				test.clock.AdvanceToNext()
+			}
+		}
+	}
+	t.Fatal("no result")
+	return nil, nil
+}
+
+func TestSimnetLostRequest(t *testing.T) {
+	test := newSimnetTest(t, []simnet.Fault{simnet.Lose}, nil)
+	if _, err := test.request(t); err != nil {
+		t.Fatal(err)
+	} else if stats := test.clientEnd.Stats(); stats.Sent != 2 || stats.Lost != 1 {
+		t.Errorf("client sent %+v", stats)
+	} else if test.clock.Elapsed() != time.Second {
+		t.Errorf("answered after %s", test.clock.Elapsed())
+	}
+}
+
+// the retransmitted request is answered from the cache of the server, not by the application
+func TestSimnetLostResponse(t *testing.T) {
+	test := newSimnetTest(t, nil, []simnet.Fault{simnet.Lose})
+	if _, err := test.request(t); err != nil {
+		t.Fatal(err)
+	} else if stats := test.serverEnd.Stats(); stats.Sent != 2 || stats.Lost != 1 {
+		t.Errorf("server sent %+v", stats)
+	} else if n := test.serverRequests.Load(); n != 1 {
+		t.Errorf("%d requests passed to the server application", n)
+	} else if metrics := test.server.Responder.Metrics(); metrics.Retransmissions != 1 {
+		t.Errorf("server metrics %+v", metrics)
+	}
+}
+
+// duplicates of the request and the response make no difference to either application
+func TestSimnetDuplicates(t *testing.T) {
+	test := newSimnetTest(t, []simnet.Fault{simnet.Duplicate}, []simnet.Fault{simnet.Duplicate})
+	if _, err := test.request(t); err != nil {
+		t.Fatal(err)
+	} else if n := test.serverRequests.Load(); n != 1 {
+		t.Errorf("%d requests passed to the server application", n)
+	} else if _, err := test.request(t); err != nil {
+		t.Errorf("request after duplicates failed %s", err.Error())
+	}
+}
+
+// delivered waits until the End has delivered n datagrams, which may be after the reply to the last one arrived
+func delivered(end *simnet.End, n int) simnet.Stats {
+	stats := end.Stats()
+	for deadline := time.Now().Add(time.Second); stats.Delivered < n && time.Now().Before(deadline); stats = end.Stats() {
+		time.Sleep(time.Millisecond)
+	}
+	return stats
+}
+
+// a request held back until its retransmission arrives is then a duplicate
+func TestSimnetReorder(t *testing.T) {
+	test := newSimnetTest(t, []simnet.Fault{simnet.Reorder}, nil)
+	if _, err := test.request(t); err != nil {
+		t.Fatal(err)
+	} else if stats := delivered(test.clientEnd, 2); stats.Sent != 2 || stats.Delivered != 2 || stats.Reordered != 1 {
+		t.Errorf("client sent %+v", stats)
+	} else if n := test.serverRequests.Load(); n != 1 {
+		t.Errorf("%d requests passed to the server application", n)
+	}
+}
+
+func TestSimnetTimeout(t *testing.T) {
+	test := newSimnetTest(t, []simnet.Fault{simnet.Lose, simnet.Lose, simnet.Lose}, nil)
+	if _, err := test.request(t); err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("request returned %v", err)
+	} else if test.clock.Elapsed() != 3*time.Second {
+		t.Errorf("failed after %s", test.clock.Elapsed())
+	}
+}
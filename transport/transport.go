@@ -4,7 +4,8 @@
 package transport
 
 /*
-the reliable transport layer consumes an instance of the UDP service, UdpServerPeer
+the reliable transport layer consumes an instance of the datagram service, udpserver.DatagramPeer,
+which is a UdpServerPeer, or a simulated network in tests
 the reliable transport layer has two half systems - a requester and a responder.
 They function separately, and a message routing function use a table of types to route incoming messages,
 depending on whether they are requests or responses, to the correct half system.
@@ -12,7 +13,7 @@ depending on whether they are requests or responses, to the correct half system.
 the reliable transport layer requester provides a service interface which accepts valid request messages and an accompanying reply channel.
 the reply channel carries one of error or message, where message is symmetric with the request
 
-the multiplexer consumes the UdpServerPeer, and calls the appropriate state machine depending on the request/response nature
+the multiplexer consumes the DatagramPeer, and calls the appropriate state machine depending on the request/response nature
 
 so, the reliable transport layer requester (RTL requester) provides an upstack method call as well as downstack.
 In a null implementation, the upstack call simply delivers the message blindly to a client, as long as it can locate it.
@@ -23,6 +24,8 @@ the reliable transport layer responder.
 */
 
 import (
+	"context"
+	"sync/atomic"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -30,8 +33,8 @@ import (
 	"pfcpcore/udpserver"
 )
 
-// N1 = 10 is a useful value for testing, where the UPF may take rather long to start initially
-// TODO - Perhaps it is too high for real use, and should be configurable
+// N1 = 100 is a useful value for testing, where the UPF may take rather long to start initially.
+// It is too high for real use, where a RetryPolicy should be set with WithRetryPolicy
 const (
 	N1 = 100
 	T1 = time.Second
@@ -40,14 +43,40 @@ const (
 type Transport struct {
 	Requestor
 	Responder
-	*udpserver.UdpServerPeer
+	udpserver.DatagramPeer
+	ValidationProfile *pfcp.ValidationProfile
+	localNodeId       atomic.Pointer[pfcp.IeNode] // the Node ID in responses which reject invalid requests
 }
 
-func NewTransport(udpServerPeer *udpserver.UdpServerPeer, requestChannel chan PeerRequest) *Transport {
+// SetLocalNodeId sets the Node ID for responses to invalid requests, which the transport answers itself
+func (r *Transport) SetLocalNodeId(nodeId pfcp.IeNode) {
+	r.localNodeId.Store(&nodeId)
+}
+
+func NewTransport(datagramPeer udpserver.DatagramPeer, requestChannel chan PeerRequest, options ...Option) *Transport {
+	return NewTransportWithProfile(datagramPeer, requestChannel, pfcp.DefaultProfile, options...)
+}
+
+// NewTransportWithProfile validates received messages with the profile, e.g. pfcp.ProfileR15 for an R15 peer
+func NewTransportWithProfile(datagramPeer udpserver.DatagramPeer, requestChannel chan PeerRequest, profile *pfcp.ValidationProfile, options ...Option) *Transport {
 	transport := &Transport{
-		Requestor:     Requestor{nextSequenceNumber: getSeqStart(), inFlight: make(map[pfcp.PfcpSequenceNumber]*requestState)},
-		Responder:     Responder{requestChannel: requestChannel, inFlight: make(map[pfcp.PfcpSequenceNumber]*peerRequestState)},
-		UdpServerPeer: udpServerPeer,
+		ValidationProfile: profile,
+		Requestor: Requestor{
+			nextSequenceNumber: getSeqStart(),
+			inFlight:           make(map[pfcp.PfcpSequenceNumber]*requestState),
+			policy:             DefaultRetryPolicy,
+			clock:              systemClock{},
+		},
+		Responder: Responder{
+			requestChannel: requestChannel,
+			inFlight:       make(map[pfcp.PfcpSequenceNumber]*peerRequestState),
+			cache:          DefaultResponseCache,
+			clock:          systemClock{},
+		},
+		DatagramPeer: datagramPeer,
+	}
+	for _, option := range options {
+		option(transport)
 	}
 	go transport.runLower()
 	return transport
@@ -56,17 +85,22 @@ func NewTransport(udpServerPeer *udpserver.UdpServerPeer, requestChannel chan Pe
 func (r *Transport) Drop() {
 	// on inspection it appears that any transient go routines in this instance will safely terminate
 	//  But, it needs to closely checked once working
-	r.UdpServerPeer.Drop()
+	r.DatagramPeer.Drop()
 	r.Requestor.Drop()
 	r.Responder.Drop()
 }
 
 func (r *Transport) runLower() {
-	for m := range r.UdpServerPeer.Receive {
-		if pfcpMessage, err := pfcp.ParseValidate(m.Payload); err != nil {
-			log.Warnf("error in PFCP message format %s\n", err.Error())
+	for m := range r.DatagramPeer.ReceiveChannel() {
+		if pfcpMessage, err := r.ValidationProfile.ParseValidate(m.Payload); err != nil {
+			if reject := pfcp.RejectRequest(m.Payload, err, r.localNodeId.Load(), r.ValidationProfile); reject == nil {
+				log.Warnf("error in PFCP message format %s\n", err.Error())
+			} else {
+				log.Warnf("rejecting invalid request, %s\n", err.Error())
+				r.Responder.rejectRequest(reject, r.DatagramPeer.SendChannel())
+			}
 		} else if pfcpMessage.IsRequest() {
-			r.Responder.handleRequest(pfcpMessage, r.UdpServerPeer.Send)
+			r.Responder.handleRequest(pfcpMessage, r.DatagramPeer.SendChannel())
 		} else if pfcpMessage.IsResponse() {
 			r.Requestor.handleResponse(pfcpMessage)
 		} else {
@@ -77,18 +111,35 @@ func (r *Transport) runLower() {
 }
 
 func (r *Transport) BlockingRequest(message *pfcp.PfcpMessage) (*pfcp.PfcpMessage, error) {
-	replyChannel := make(chan RequestReturn)
-	r.EnterRequest(message, replyChannel)
+	return r.RequestContext(context.Background(), message)
+}
+
+// RequestContext sends the request and waits for the response.
+// When the context is cancelled, or its deadline passes, retransmission stops at once, and ctx.Err() is returned.
+func (r *Transport) RequestContext(ctx context.Context, message *pfcp.PfcpMessage) (*pfcp.PfcpMessage, error) {
+	// buffered, so that the result of the request never waits for the caller, which may have gone
+	replyChannel := make(chan RequestReturn, 1)
+	r.Requestor.enterRequest(ctx, message, replyChannel, r.DatagramPeer.SendChannel(), r.Requestor.policy)
 	rval := <-replyChannel
 	return rval.Value()
 }
 
 func (r *Transport) EnterRequest(message *pfcp.PfcpMessage, replyChannel chan RequestReturn) {
-	r.Requestor.enterRequest(message, replyChannel, r.UdpServerPeer.Send)
+	r.Requestor.enterRequest(context.Background(), message, replyChannel, r.DatagramPeer.SendChannel(), r.Requestor.policy)
+}
+
+// EnterRequestWithPolicy is EnterRequest with a retry policy for this request only
+func (r *Transport) EnterRequestWithPolicy(message *pfcp.PfcpMessage, replyChannel chan RequestReturn, policy RetryPolicy) {
+	r.Requestor.enterRequest(context.Background(), message, replyChannel, r.DatagramPeer.SendChannel(), policy)
+}
+
+// EnterRequestContext is EnterRequest which is cancelled with the context, the reply channel then carries ctx.Err()
+func (r *Transport) EnterRequestContext(ctx context.Context, message *pfcp.PfcpMessage, replyChannel chan RequestReturn) {
+	r.Requestor.enterRequest(ctx, message, replyChannel, r.DatagramPeer.SendChannel(), r.Requestor.policy)
 }
 
 func (r *Transport) EnterResponse(message *pfcp.PfcpMessage, response PeerRequest) {
-	r.Responder.enterResponse(message, response, r.UdpServerPeer.Send)
+	r.Responder.enterResponse(message, response, r.DatagramPeer.SendChannel())
 }
 
 type RequestReturn struct {
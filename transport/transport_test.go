@@ -6,8 +6,10 @@ package transport_test
 import (
 	"net/netip"
 	"testing"
+	"time"
 
 	"pfcpcore/pfcp"
+	"pfcpcore/simnet"
 	"pfcpcore/transport"
 	"pfcpcore/udpserver"
 )
@@ -64,3 +66,48 @@ func chattee(t *testing.T, udp *udpserver.UdpServerPeer) {
 	conn.EnterResponse(&pfcp.HeartBeatResponse, m)
 	close()
 }
+
+// an invalid request is answered by the transport, and not passed to the client
+func TestRejectInvalidRequest(t *testing.T) {
+	senderEnd, rejectingEnd := simnet.NewLink(nil)
+	requestChan := make(chan transport.PeerRequest)
+	rejectingTransport := transport.NewTransport(rejectingEnd, requestChan)
+	rejectingTransport.SetLocalNodeId(pfcp.IE_NodeIdFqdn("upf"))
+	t.Cleanup(func() {
+		rejectingTransport.Drop()
+		senderEnd.Drop()
+	})
+
+	// a Session Establishment Request without Create FAR
+	request := pfcp.NewSessionMessage(pfcp.PFCP_Session_Establishment_Request, 0,
+		pfcp.IE_NodeIdFqdn("smf"),
+		pfcp.IE_FSeid(99, netip.MustParseAddr("192.0.2.1")),
+		pfcp.IE_CreatePdr(pfcp.IE_PdrId(1), pfcp.IE_Pdi(pfcp.IE_SourceInterface(pfcp.EnumCore))),
+	)
+	request.SetPfcpSequenceNumber(77)
+
+	for i := 0; i < 2; i++ { // the retransmission gets the same answer
+		senderEnd.SendChannel() <- udpserver.ToUdpMessage(request.Serialise())
+		var message *udpserver.UdpMessage
+		select {
+		case message = <-senderEnd.ReceiveChannel():
+		case <-time.After(time.Second):
+			t.Fatal("invalid request not answered")
+		}
+		if response, err := pfcp.ParseValidate(message.Payload); err != nil {
+			t.Errorf("invalid response %s", err.Error())
+		} else if response.TypeCode() != pfcp.PFCP_Session_Establishment_Response || response.PfcpSequenceNumber() != 77 || *response.SEID != 99 {
+			t.Errorf("wrong response %s", response)
+		} else if cause, err := response.Node().ReadCauseCode(); err != nil || cause != pfcp.MandatoryIeMissing {
+			t.Errorf("wrong cause %d", cause)
+		} else if offendingIe, err := response.Node().Getter().GetByTc(pfcp.Offending_IE).DeserialiseU16(); err != nil || pfcp.IeTypeCode(offendingIe) != pfcp.Create_FAR {
+			t.Errorf("wrong offending IE %d", offendingIe)
+		}
+	}
+
+	select {
+	case m := <-requestChan:
+		t.Errorf("invalid request passed to the client %s", m)
+	default:
+	}
+}
@@ -0,0 +1,1238 @@
+# TS 29.244 IE and message catalogue, the input to cmd/codegen, which writes pfcp/catalogue_generated.go
+# Fields are TAB separated, '#' starts a comment, which for IEs is carried into the generated ieTypes map.
+#
+# ie <type code> <identifier> <ieType> <layout> <name> [<releases>]
+#     <ieType> is the suffix of an ieT... constant in pfcp/ieshow.go
+#     <layout> selects the typed T_<identifier> struct: u8, u16, u32, u64, octets or string give a 'Value' field,
+#     any other name is a pfcp value type with Decode/Encode methods (e.g. FTeid) which is embedded,
+#     and '-' marks a grouped IE, which has no typed struct
+# message <type code> <identifier> <name> [<releases>]
+#     <releases> is the release which introduced it, e.g. R16, or the first and last release in which it is used, e.g. R14-R15,
+#     when absent it is in R15 and every later release. The release profiles in pfcp/profile.go are built from it.
+# group <IE identifier>
+# messageies <message identifier>
+#     followed by one TAB indented line per member IE: <IE identifier> [required] [multiple] [id] [update=<base IE>] [delete=<base IE>] [transient]
+#     a transient member, e.g. Query URR, is a request of its message only, and not merged into the session state
+#
+# Every grouped IE has a 'group' member list.
+
+ie	1	Create_PDR	group	-	Create PDR
+ie	2	PDI	group	-	PDI
+ie	3	Create_FAR	group	-	Create FAR
+ie	4	Forwarding_Parameters	group	-	Forwarding Parameters
+ie	5	Duplicating_Parameters	group	-	Duplicating Parameters
+ie	6	Create_URR	group	-	Create URR
+ie	7	Create_QER	group	-	Create QER
+ie	8	Created_PDR	group	-	Created PDR
+ie	9	Update_PDR	group	-	Update PDR
+ie	10	Update_FAR	group	-	Update FAR
+ie	11	Update_Forwarding_Parameters	group	-	Update Forwarding Parameters
+ie	12	Update_BAR_SRRsp	group	-	Update BAR (PFCP Session Report Response)
+ie	13	Update_URR	group	-	Update URR
+ie	14	Update_QER	group	-	Update QER
+ie	15	Remove_PDR	group	-	Remove PDR
+ie	16	Remove_FAR	group	-	Remove FAR
+ie	17	Remove_URR	group	-	Remove URR
+ie	18	Remove_QER	group	-	Remove QER
+ie	19	Cause	enum	u8	Cause
+ie	20	Source_Interface	enumInterface	EnumInterface	Source Interface	# only 4 bits used
+ie	21	F_TEID	fteid	FTeid	F-TEID	# IPV4/6+TEID, TEID 32 bits mandatory
+ie	22	Network_Instance	apn	octets	Network Instance
+ie	23	SDF_Filter	bytes	SdfFilter	SDF Filter
+ie	24	Application_ID	bytes	octets	Application ID
+ie	25	Gate_Status	bits	GateStatus	Gate Status	# only LSB 1,3of 8 used
+ie	26	MBR	bitRates	BitRate	MBR	# two 32 bit numbers
+ie	27	GBR	bitRates	BitRate	GBR	# two 32 bit numbers
+ie	28	QER_Correlation_ID	bytes	u32	QER Correlation ID
+ie	29	Precedence	integral	u32	Precedence	# 32 bits
+ie	30	Transport_Level_Marking	bytes	u16	Transport Level Marking
+ie	31	Volume_Threshold	special	Volume	Volume Threshold	# up to 3 64 bit numbers
+ie	32	Time_Threshold	integral	u32	Time Threshold
+ie	33	Monitoring_Time	bytes	u32	Monitoring Time
+ie	34	Subsequent_Volume_Threshold	bytes	Volume	Subsequent Volume Threshold
+ie	35	Subsequent_Time_Threshold	integral	u32	Subsequent Time Threshold
+ie	36	Inactivity_Detection_Time	integral	u32	Inactivity Detection Time
+ie	37	Reporting_Triggers	bytes	Flags	Reporting Triggers
+ie	38	Redirect_Information	bytes	RedirectInformation	Redirect Information
+ie	39	Report_Type	bytes	Flags	Report Type
+ie	40	Offending_IE	bytes	u16	Offending IE
+ie	41	Forwarding_Policy	bytes	ForwardingPolicy	Forwarding Policy
+ie	42	Destination_Interface	enumInterface	EnumInterface	Destination Interface	# only 4 bits used - see Source_Interface
+ie	43	UP_Function_Features	bytes	Flags	UP Function Features
+ie	44	Apply_Action	ApplyAction	Flags	Apply Action	# 11bits used
+ie	45	Downlink_Data_Service_Information	bytes	DownlinkDataServiceInformation	Downlink Data Service Information
+ie	46	Downlink_Data_Notification_Delay	bytes	u8	Downlink Data Notification Delay
+ie	47	DL_Buffering_Duration	bytes	u8	DL Buffering Duration
+ie	48	DL_Buffering_Suggested_Packet_Count	bytes	octets	DL Buffering Suggested Packet Count
+ie	49	PfcpsmreqFlags	bytes	Flags	PFCPSMReq-Flags
+ie	50	PfcpsrrspFlags	bytes	Flags	PFCPSRRsp-Flags
+ie	51	Load_Control_Information	group	-	Load Control Information
+ie	52	Sequence_Number	bytes	u32	Sequence Number
+ie	53	Metric	bytes	u8	Metric
+ie	54	Overload_Control_Information	group	-	Overload Control Information
+ie	55	Timer	bytes	u8	Timer
+ie	56	PDR_ID	id	u16	PDR ID	# 16 bits
+ie	57	F_SEID	fseid	FSeid	F-SEID	# IPV4/6+SEID, SEID 64 bits mandatory
+ie	58	Application_IDs_PFDs	group	-	Application ID's PFDs
+ie	59	PFD_context	group	-	PFD context
+ie	60	Node_ID	nodeid	NodeId	Node ID	# one of string or IPv4/6, IPs not strings...
+ie	61	PFD_contents	bytes	octets	PFD contents
+ie	62	Measurement_Method	bytes	Flags	Measurement Method
+ie	63	Usage_Report_Trigger	bytes	Flags	Usage Report Trigger
+ie	64	Measurement_Period	integral	u32	Measurement Period
+ie	65	FQ_CSID	bytes	FqCsid	FQ-CSID
+ie	66	Volume_Measurement	bytes	Volume	Volume Measurement
+ie	67	Duration_Measurement	bytes	u32	Duration Measurement
+ie	68	Application_Detection_Information	group	-	Application Detection Information
+ie	69	Time_of_First_Packet	bytes	u32	Time of First Packet
+ie	70	Time_of_Last_Packet	bytes	u32	Time of Last Packet
+ie	71	Quota_Holding_Time	integral	u32	Quota Holding Time
+ie	72	Dropped_DL_Traffic_Threshold	bytes	octets	Dropped DL Traffic Threshold
+ie	73	Volume_Quota	bytes	Volume	Volume Quota
+ie	74	Time_Quota	integral	u32	Time Quota
+ie	75	Start_Time	bytes	u32	Start Time
+ie	76	End_Time	bytes	u32	End Time
+ie	77	Query_URR	group	-	Query URR
+ie	78	Usage_Report_SMR	group	-	Usage Report (Session Modification Response)
+ie	79	Usage_Report_SDR	group	-	Usage Report (Session Deletion Response)
+ie	80	Usage_Report_SRR	group	-	Usage Report (Session Report Request)
+ie	81	URR_ID	id	u32	URR ID	# 32 bits
+ie	82	Linked_URR_ID	integral	u32	Linked URR ID
+ie	83	Downlink_Data_Report	group	-	Downlink Data Report
+ie	84	Outer_Header_Creation	OuterHeaderCreate	OuterHeaderCreate	Outer Header Creation	# many forms, GTPu TEID is our main interest, 32 bits
+ie	85	Create_BAR	group	-	Create BAR
+ie	86	Update_BAR	group	-	Update BAR (Session Modification Request)
+ie	87	Remove_BAR	group	-	Remove BAR
+ie	88	BAR_ID	id	u8	BAR ID	# 8 bits
+ie	89	CP_Function_Features	bytes	Flags	CP Function Features
+ie	90	Usage_Information	bytes	Flags	Usage Information
+ie	91	Application_Instance_ID	bytes	octets	Application Instance ID
+ie	92	Flow_Information	bytes	octets	Flow Information
+ie	93	UE_IP_Address	ueIpAddress	UeIpAddress	UE IP Address	# can be ipv4 or 6, or empty, requesting them...
+ie	94	Packet_Rate	bytes	octets	Packet Rate
+ie	95	Outer_Header_Removal	enum	OuterHeaderRemoval	Outer Header Removal	# strictly not an enum, because another bit can be set...
+ie	96	Recovery_Time_Stamp	integral	u32	Recovery Time Stamp	# 32 bits, seconds since 01/01/1900 00:00:00
+ie	97	DL_Flow_Level_Marking	bytes	octets	DL Flow Level Marking
+ie	98	Header_Enrichment	bytes	HeaderEnrichment	Header Enrichment
+ie	99	Error_Indication_Report	group	-	Error Indication Report
+ie	100	Measurement_Information	bytes	octets	Measurement Information
+ie	101	Node_Report_Type	bytes	Flags	Node Report Type
+ie	102	User_Plane_Path_Failure_Report	group	-	User Plane Path Failure Report
+ie	103	Remote_GTP_U_Peer	bytes	RemoteGtpUPeer	Remote GTP-U Peer
+ie	104	UR_SEQN	bytes	u32	UR-SEQN
+ie	105	Update_Duplicating_Parameters	group	-	Update Duplicating Parameters
+ie	106	Activate_Predefined_Rules	bytes	string	Activate Predefined Rules
+ie	107	Deactivate_Predefined_Rules	bytes	string	Deactivate Predefined Rules
+ie	108	FAR_ID	id	u32	FAR ID	# 32 bits
+ie	109	QER_ID	id	u32	QER ID	# 32 bits
+ie	110	OCI_Flags	bytes	Flags	OCI Flags
+ie	111	PfcparreqFlags	bytes	Flags	PFCP Association Release Request
+ie	112	Graceful_Release_Period	bytes	u8	Graceful Release Period
+ie	113	PDN_Type	enum	u8	PDN Type	# 3 bits, ip4 ip6 ip4/6 eth other
+ie	114	Failed_Rule_ID	bytes	FailedRuleId	Failed Rule ID
+ie	115	Time_Quota_Mechanism	bytes	octets	Time Quota Mechanism
+ie	116	User_Plane_IP_Resource_Information	UPIpResInfo	UpIpResInfo	User Plane IP Resource Information (rel 15 only)	R14-R15
+ie	117	User_Plane_Inactivity_Timer	integral	u32	User Plane Inactivity Timer
+ie	118	Aggregated_URRs	group	-	Aggregated URRs
+ie	119	Multiplier	bytes	MultiplierValue	Multiplier
+ie	120	Aggregated_URR_ID	integral	u32	Aggregated URR ID
+ie	121	Subsequent_Volume_Quota	bytes	Volume	Subsequent Volume Quota
+ie	122	Subsequent_Time_Quota	integral	u32	Subsequent Time Quota
+ie	123	RQI	bytes	Flags	RQI
+ie	124	QFI	integral	u8	QFI
+ie	125	Query_URR_Reference	bytes	u32	Query URR Reference
+ie	126	Additional_Usage_Reports_Information	bytes	octets	Additional Usage Reports Information
+ie	127	Create_Traffic_Endpoint	group	-	Create Traffic Endpoint
+ie	128	Created_Traffic_Endpoint	group	-	Created Traffic Endpoint
+ie	129	Update_Traffic_Endpoint	group	-	Update Traffic Endpoint
+ie	130	Remove_Traffic_Endpoint	group	-	Remove Traffic Endpoint
+ie	131	Traffic_Endpoint_ID	id	u8	Traffic Endpoint ID
+ie	132	Ethernet_Packet_Filter	group	-	Ethernet Packet Filter
+ie	133	MAC_address	bytes	octets	MAC address
+ie	134	C_TAG	bytes	octets	C-TAG
+ie	135	S_TAG	bytes	octets	S-TAG
+ie	136	Ethertype	bytes	octets	Ethertype
+ie	137	Proxying	bytes	Flags	Proxying
+ie	138	Ethernet_Filter_ID	bytes	u32	Ethernet Filter ID
+ie	139	Ethernet_Filter_Properties	bytes	Flags	Ethernet Filter Properties
+ie	140	Suggested_Buffering_Packets_Count	bytes	u8	Suggested Buffering Packets Count
+ie	141	User_ID	bytes	octets	User ID
+ie	142	Ethernet_PDU_Session_Information	bytes	octets	Ethernet PDU Session Information
+ie	143	Ethernet_Traffic_Information	group	-	Ethernet Traffic Information
+ie	144	MAC_Addresses_Detected	bytes	octets	MAC Addresses Detected
+ie	145	MAC_Addresses_Removed	bytes	octets	MAC Addresses Removed
+ie	146	Ethernet_Inactivity_Timer	integral	u32	Ethernet Inactivity Timer
+ie	147	Additional_Monitoring_Time	group	-	Additional Monitoring Time
+ie	148	Event_Quota	integral	u32	Event Quota
+ie	149	Event_Threshold	integral	u32	Event Threshold
+ie	150	Subsequent_Event_Quota	integral	u32	Subsequent Event Quota
+ie	151	Subsequent_Event_Threshold	integral	u32	Subsequent Event Threshold
+ie	152	Trace_Information	bytes	octets	Trace Information
+ie	153	Framed_Route	bytes	octets	Framed-Route
+ie	154	Framed_Routing	bytes	octets	Framed-Routing
+ie	155	Framed_IPv6_Route	bytes	octets	Framed-IPv6-Route
+ie	156	Time_Stamp	bytes	u32	Time Stamp
+ie	157	Averaging_Window	integral	u32	Averaging Window
+ie	158	Paging_Policy_Indicator	bytes	u8	Paging Policy Indicator
+ie	159	APN_DNN	apn	octets	APN/DNN
+ie	160	TGPP_Interface_Type	enum	u8	3GPP Interface Type
+ie	161	PfcpsrreqFlags	bytes	Flags	PFCPSRReq-Flags
+ie	162	PfcpaureqFlags	bytes	Flags	PFCPAUReq-Flags
+ie	163	Activation_Time	bytes	u32	Activation Time
+ie	164	Deactivation_Time	bytes	u32	Deactivation Time
+ie	165	Create_MAR	group	-	Create MAR	R16
+ie	166	TGPP_Access_Forwarding_Action_Information	group	-	3GPP Access Forwarding Action Information	R16
+ie	167	Non_3GPP_Access_Forwarding_Action_Information	group	-	Non-3GPP Access Forwarding Action Information	R16
+ie	168	Remove_MAR	group	-	Remove MAR	R16
+ie	169	Update_MAR	group	-	Update MAR	R16
+ie	170	MAR_ID	id	u16	MAR ID	R16
+ie	171	Steering_Functionality	enum	u8	Steering Functionality	R16
+ie	172	Steering_Mode	enum	u8	Steering Mode	R16
+ie	173	Weight	bytes	u8	Weight	R16
+ie	174	Priority	bytes	u8	Priority	R16
+ie	175	Update_TGPP_Access_Forwarding_Action_Information	group	-	Update 3GPP Access Forwarding Action Information	R16
+ie	176	Update_Non_3GPP_Access_Forwarding_Action_Information	group	-	Update Non 3GPP Access Forwarding Action Information	R16
+ie	177	UE_IP_address_Pool_Identity	bytes	octets	UE IP address Pool Identity	R16
+ie	178	Alternative_SMF_IP_Address	bytes	octets	Alternative SMF IP Address	R16
+ie	179	Packet_Replication_and_Detection_Carry_On_Information	bytes	octets	Packet Replication and Detection Carry-On Information	R16
+ie	180	SMF_Set_ID	bytes	octets	SMF Set ID	R16
+ie	181	Quota_Validity_Time	integral	u32	Quota Validity Time	R16
+ie	182	Number_of_Reports	integral	u16	Number of Reports	R16
+ie	183	PFCP_Session_Retention_Information	group	-	PFCP Session Retention Information (within PFCP Association Setup Request)	R16
+ie	184	PfcpasrspFlags	bytes	Flags	PFCPASRsp-Flags	R16
+ie	185	CP_PFCP_Entity_IP_Address	bytes	octets	CP PFCP Entity IP Address	R16
+ie	186	PfcpsereqFlags	bytes	Flags	PFCPSEReq-Flags
+ie	187	User_Plane_Path_Recovery_Report	group	-	User Plane Path Recovery Report	R16
+ie	188	IP_Multicast_Addressing_Info	group	-	IP Multicast Addressing Info within PFCP Session Establishment Request	R16
+ie	189	Join_IP_Multicast_Information	group	-	Join IP Multicast Information IE within Usage Report	R16
+ie	190	Leave_IP_Multicast_Information	group	-	Leave IP Multicast Information IE within Usage Report	R16
+ie	191	IP_Multicast_Address	bytes	octets	IP Multicast Address	R16
+ie	192	Source_IP_Address	sourceIpAddress	SourceIpAddress	Source IP Address	R16	# can be ipv4 or 6,optionally with a mask
+ie	193	Packet_Rate_Status	bytes	octets	Packet Rate Status	R16
+ie	194	Create_Bridge_Info_for_TSC	bytes	Flags	Create Bridge Info for TSC	R16
+ie	195	Created_Bridge_Info_for_TSC	group	-	Created Bridge Info for TSC	R16
+ie	196	DS_TT_Port_Number	bytes	u32	DS-TT Port Number	R16
+ie	197	NW_TT_Port_Number	bytes	u32	NW-TT Port Number	R16
+ie	198	TSN_Bridge_ID	bytes	octets	TSN Bridge ID	R16
+ie	199	TSC_Management_Information_SMReq	group	-	TSC Management Information IE within PFCP Session Modification Request	R16
+ie	200	TSC_Management_Information_SMRsp	group	-	TSC Management Information IE within PFCP Session Modification Response	R16
+ie	201	TSC_Management_Information_SRReq	group	-	TSC Management Information IE within PFCP Session Report Request	R16
+ie	202	Port_Management_Information_Container	bytes	octets	Port Management Information Container	R16
+ie	203	Clock_Drift_Control_Information	group	-	Clock Drift Control Information	R16
+ie	204	Requested_Clock_Drift_Information	bytes	octets	Requested Clock Drift Information	R16
+ie	205	Clock_Drift_Report	group	-	Clock Drift Report	R16
+ie	206	TSN_Time_Domain_Number	bytes	octets	TSN Time Domain Number	R16
+ie	207	Time_Offset_Threshold	bytes	octets	Time Offset Threshold	R16
+ie	208	Cumulative_rateRatio_Threshold	bytes	octets	Cumulative rateRatio Threshold	R16
+ie	209	Time_Offset_Measurement	bytes	octets	Time Offset Measurement	R16
+ie	210	Cumulative_rateRatio_Measurement	bytes	octets	Cumulative rateRatio Measurement	R16
+ie	211	Remove_SRR	group	-	Remove SRR	R16
+ie	212	Create_SRR	group	-	Create SRR	R16
+ie	213	Update_SRR	group	-	Update SRR	R16
+ie	214	Session_Report	group	-	Session Report	R16
+ie	215	SRR_ID	id	u8	SRR ID	R16
+ie	216	Access_Availability_Control_Information	group	-	Access Availability Control Information	R16
+ie	217	Requested_Access_Availability_Information	bytes	octets	Requested Access Availability Information	R16
+ie	218	Access_Availability_Report	group	-	Access Availability Report	R16
+ie	219	Access_Availability_Information	bytes	octets	Access Availability Information	R16
+ie	220	Provide_ATSSS_Control_Information	group	-	Provide ATSSS Control Information	R16
+ie	221	ATSSS_Control_Parameters	group	-	ATSSS Control Parameters	R16
+ie	222	MPTCP_Control_Information	bytes	octets	MPTCP Control Information	R16
+ie	223	ATSSS_LL_Control_Information	bytes	octets	ATSSS-LL Control Information	R16
+ie	224	PMF_Control_Information	bytes	octets	PMF Control Information	R16
+ie	225	MPTCP_Parameters	group	-	MPTCP Parameters	R16
+ie	226	ATSSS_LL_Parameters	group	-	ATSSS-LL Parameters	R16
+ie	227	PMF_Parameters	group	-	PMF Parameters	R16
+ie	228	MPTCP_Address_Information	bytes	octets	MPTCP Address Information	R16
+ie	229	UE_Link_Specific_IP_Address	bytes	octets	UE Link-Specific IP Address	R16
+ie	230	PMF_Address_Information	bytes	octets	PMF Address Information	R16
+ie	231	ATSSS_LL_Information	bytes	octets	ATSSS-LL Information	R16
+ie	232	Data_Network_Access_Identifier	bytes	octets	Data Network Access Identifier	R16
+ie	233	UE_IP_address_Pool_Information	group	-	UE IP address Pool Information	R16
+ie	234	Average_Packet_Delay	bytes	u32	Average Packet Delay	R16
+ie	235	Minimum_Packet_Delay	bytes	u32	Minimum Packet Delay	R16
+ie	236	Maximum_Packet_Delay	bytes	u32	Maximum Packet Delay	R16
+ie	237	QoS_Report_Trigger	bytes	Flags	QoS Report Trigger	R16
+ie	238	GTP_U_Path_QoS_Control_Information	group	-	GTP-U Path QoS Control Information	R16
+ie	239	GTP_U_Path_QoS_Report	group	-	GTP-U Path QoS Report (PFCP Node Report Request)	R16
+ie	240	QoS_Information_In_GTP_U_Path_QoS_Report	group	-	QoS Information in GTP-U Path QoS Report	R16
+ie	241	GTP_U_Path_Interface_Type	bytes	Flags	GTP-U Path Interface Type	R16
+ie	242	QoS_Monitoring_per_QoS_flow_Control_Information	group	-	QoS Monitoring per QoS flow Control Information	R16
+ie	243	Requested_QoS_Monitoring	bytes	octets	Requested QoS Monitoring	R16
+ie	244	Reporting_Frequency	bytes	Flags	Reporting Frequency	R16
+ie	245	Packet_Delay_Thresholds	bytes	octets	Packet Delay Thresholds	R16
+ie	246	Minimum_Wait_Time	bytes	u32	Minimum Wait Time	R16
+ie	247	QoS_Monitoring_Report	group	-	QoS Monitoring Report	R16
+ie	248	QoS_Monitoring_Measurement	bytes	octets	QoS Monitoring Measurement	R16
+ie	249	MT_EDT_Control_Information	bytes	octets	MT-EDT Control Information	R16
+ie	250	DL_Data_Packets_Size	bytes	octets	DL Data Packets Size	R16
+ie	251	QER_Control_Indications	bytes	Flags	QER Control Indications	R16
+ie	252	Packet_Rate_Status_Report	group	-	Packet Rate Status Report	R16
+ie	253	NF_Instance_ID	bytes	octets	NF Instance ID	R16
+ie	254	Ethernet_Context_Information	group	-	Ethernet Context Information	R16
+ie	255	Redundant_Transmission_Parameters	group	-	Redundant Transmission Parameters	R16
+ie	256	Updated_PDR	group	-	Updated PDR	R16
+ie	257	S_NSSAI	bytes	SNssai	S-NSSAI	R16
+ie	258	IP_version	bytes	Flags	IP version	R16
+ie	259	PfcpasreqFlags	bytes	Flags	PFCPASReq-Flags	R16
+ie	260	Data_Status	bytes	Flags	Data Status	R16
+ie	261	Provide_RDS_configuration_information	group	-	Provide RDS configuration information	R16
+ie	262	RDS_configuration_information	bytes	octets	RDS configuration information	R16
+ie	263	Query_Packet_Rate_Status	group	-	Query Packet Rate Status IE within PFCP Session Modification Request	R16
+ie	264	Packet_Rate_Status_Report_SMRsp	group	-	Packet Rate Status Report IE within PFCP Session Modification Response	R16
+ie	265	MPTCP_Applicable_Indication	bytes	Flags	MPTCP Applicable Indication	R17
+ie	266	Bridge_Management_Information_Container	bytes	octets	Bridge Management Information Container	R17
+ie	267	UE_IP_Address_Usage_Information	group	-	UE IP Address Usage Information	R17
+ie	268	Number_of_UE_IP_Addresses	bytes	octets	Number of UE IP Addresses	R17
+ie	269	Validity_Timer	bytes	u16	Validity Timer	R17
+ie	270	Redundant_Transmission_Forwarding_Parameters	group	-	Redundant Transmission Forwarding Parameters	R17
+ie	271	Transport_Delay_Reporting	group	-	Transport Delay Reporting	R17
+ie	272	Partial_Failure_Information_SERsp	group	-	Partial Failure Information within PFCP Session Establishment Response	R17
+ie	273	Partial_Failure_Information_SMRsp	group	-	Partial Failure Information within PFCP Session Modification Response	R17
+ie	274	Offending_IE_Information	bytes	octets	Offending IE Information	R17
+ie	275	RAT_Type	enum	u8	RAT Type	R17
+ie	276	L2TP_Tunnel_Information	group	-	L2TP Tunnel Information	R17
+ie	277	L2TP_Session_Information	group	-	L2TP Session Information	R17
+ie	278	L2TP_User_Authentication	bytes	octets	L2TP User Authentication	R17
+ie	279	Created_L2TP_Session	group	-	Created L2TP Session	R17
+ie	280	LNS_Address	bytes	octets	LNS Address	R17
+ie	281	Tunnel_Preference	integral	u32	Tunnel Preference	R17
+ie	282	Calling_Number	bytes	string	Calling Number	R17
+ie	283	Called_Number	bytes	string	Called Number	R17
+ie	284	L2TP_Session_Indications	bytes	Flags	L2TP Session Indications	R17
+ie	285	DNS_Server_Address	bytes	octets	DNS Server Address	R17
+ie	286	NBNS_Server_Address	bytes	octets	NBNS Server Address	R17
+ie	287	Maximum_Receive_Unit	integral	u16	Maximum Receive Unit	R17
+ie	288	Thresholds	bytes	octets	Thresholds	R17
+ie	289	Steering_Mode_Indicator	bytes	Flags	Steering Mode Indicator	R17
+ie	290	PFCP_Session_Change_Info	group	-	PFCP Session Change Info	R17
+ie	291	Group_Id	bytes	octets	Group Id	R17
+ie	292	CP_IP_Address	bytes	octets	CP IP Address	R17
+ie	293	IP_Address_and_Port_Number_Replacement	group	-	IP Address and Port Number Replacement	R17
+ie	294	DNS_Query_Filter	bytes	octets	DNS Query Filter	R17
+ie	295	Direct_Reporting_Information	group	-	Direct Reporting Information	R17
+ie	296	Event_Notification_URI	bytes	string	Event Notification URI	R17
+ie	297	Notification_Correlation_ID	bytes	octets	Notification Correlation ID	R17
+ie	298	Reporting_Flags	bytes	Flags	Reporting Flags	R17
+ie	299	Predefined_Rules_Name	bytes	string	Predefined Rules Name	R17
+ie	300	MBS_Session_N4mb_Control_Information	group	-	MBS Session N4mb Control Information	R17
+ie	301	MBS_Multicast_Parameters	group	-	MBS Multicast Parameters	R17
+ie	302	Add_MBS_Unicast_Parameters	group	-	Add MBS Unicast Parameters	R17
+ie	303	MBS_Session_N4mb_Information	group	-	MBS Session N4mb Information	R17
+ie	304	Remove_MBS_Unicast_Parameters	group	-	Remove MBS Unicast Parameters	R17
+ie	305	MBS_Session_Identifier	bytes	octets	MBS Session Identifier	R17
+ie	306	Multicast_Transport_Information	bytes	octets	Multicast Transport Information	R17
+ie	307	MBSN4mbReq_Flags	bytes	Flags	MBSN4mbReq Flags	R17
+ie	308	Local_Ingress_Tunnel	bytes	octets	Local Ingress Tunnel	R17
+ie	309	MBS_Unicast_Parameters_ID	id	u16	MBS Unicast Parameters ID	R17
+ie	310	MBS_Session_N4_Control_Information	group	-	MBS Session N4 Control Information	R17
+ie	311	MBS_Session_N4_Information	group	-	MBS Session N4 Information	R17
+ie	312	MBSN4Resp_Flags	bytes	Flags	MBSN4Resp Flags	R17
+ie	313	Tunnel_Password	bytes	octets	Tunnel Password	R17
+ie	314	Area_Session_ID	integral	u16	Area Session ID	R17
+ie	315	Peer_UP_Restart_Report	group	-	Peer UP Restart Report	R17
+ie	316	DSCP_to_PPI_Control_Information	group	-	DSCP to PPI Control Information	R17
+ie	317	DSCP_to_PPI_Mapping_Information	bytes	octets	DSCP to PPI Mapping Information	R17
+ie	318	PfcpsdrspFlags	bytes	Flags	PFCPSDRsp-Flags	R17
+ie	319	QER_Indications	bytes	Flags	QER Indications	R17
+ie	320	Vendor_Specific_Node_Report_Type	bytes	octets	Vendor-Specific Node Report Type	R17
+ie	321	Configured_Time_Domain	bytes	octets	Configured Time Domain	R17
+
+message	1	PFCP_Heartbeat_Request	Heartbeat Request
+message	2	PFCP_Heartbeat_Response	Heartbeat Response
+message	3	PFCP_PFD_Management_Request	PFD Management Request
+message	4	PFCP_PFD_Management_Response	PFD Management Response
+message	5	PFCP_Association_Setup_Request	Association Setup Request
+message	6	PFCP_Association_Setup_Response	Association Setup Response
+message	7	PFCP_Association_Update_Request	Association Update Request
+message	8	PFCP_Association_Update_Response	Association Update Response
+message	9	PFCP_Association_Release_Request	Association Release Request
+message	10	PFCP_Association_Release_Response	Association Release Response
+message	11	PFCP_Version_Not_Supported_Response	Version Not Supported Response
+message	12	PFCP_Node_Report_Request	Node Report Request
+message	13	PFCP_Node_Report_Response	Node Report Response
+message	14	PFCP_Session_Set_Deletion_Request	Session Set Deletion Request
+message	15	PFCP_Session_Set_Deletion_Response	Session Set Deletion Response
+message	16	PFCP_Session_Set_Modification_Request	Session Set Modification Request	R17
+message	17	PFCP_Session_Set_Modification_Response	Session Set Modification Response	R17
+message	50	PFCP_Session_Establishment_Request	Session Establishment Request
+message	51	PFCP_Session_Establishment_Response	Session Establishment Response
+message	52	PFCP_Session_Modification_Request	Session Modification Request
+message	53	PFCP_Session_Modification_Response	Session Modification Response
+message	54	PFCP_Session_Deletion_Request	Session Deletion Request
+message	55	PFCP_Session_Deletion_Response	Session Deletion Response
+message	56	PFCP_Session_Report_Request	Session Report Request
+message	57	PFCP_Session_Report_Response	Session Report Response
+
+group	Create_PDR
+	PDR_ID	required id
+	PDI	required
+	Precedence
+	Outer_Header_Removal
+	FAR_ID
+	URR_ID	multiple
+	QER_ID	multiple
+	Activate_Predefined_Rules	multiple
+	Activation_Time
+	Deactivation_Time
+	MAR_ID
+	Packet_Replication_and_Detection_Carry_On_Information
+	IP_Multicast_Addressing_Info	multiple
+	UE_IP_address_Pool_Identity	multiple
+	MPTCP_Applicable_Indication
+	Transport_Delay_Reporting
+	RAT_Type
+group	PDI
+	Source_Interface	required
+	F_TEID
+	Local_Ingress_Tunnel
+	Network_Instance
+	Redundant_Transmission_Parameters
+	UE_IP_Address	multiple
+	Traffic_Endpoint_ID	multiple
+	SDF_Filter	multiple
+	Application_ID
+	Ethernet_PDU_Session_Information
+	Ethernet_Packet_Filter	multiple
+	QFI	multiple
+	Framed_Route	multiple
+	Framed_Routing
+	Framed_IPv6_Route	multiple
+	TGPP_Interface_Type
+	IP_Multicast_Addressing_Info	multiple
+	DNS_Query_Filter	multiple
+	MBS_Session_Identifier
+	Area_Session_ID
+group	Create_FAR
+	FAR_ID	required id
+	Apply_Action
+	Forwarding_Parameters
+	Duplicating_Parameters	multiple
+	BAR_ID
+	Redundant_Transmission_Forwarding_Parameters
+	MBS_Multicast_Parameters	multiple
+	Add_MBS_Unicast_Parameters	multiple
+group	Forwarding_Parameters
+	Destination_Interface
+	Network_Instance
+	Redirect_Information
+	Outer_Header_Creation
+	Transport_Level_Marking
+	Forwarding_Policy
+	Header_Enrichment
+	Traffic_Endpoint_ID
+	Proxying
+	TGPP_Interface_Type
+	Data_Network_Access_Identifier
+	IP_Address_and_Port_Number_Replacement
+group	Duplicating_Parameters
+	Destination_Interface	required
+	Outer_Header_Creation
+	Transport_Level_Marking
+	Forwarding_Policy
+group	Create_URR
+	URR_ID	required id
+	Measurement_Method
+	Reporting_Triggers
+	Measurement_Period
+	Volume_Threshold
+	Volume_Quota
+	Event_Threshold
+	Event_Quota
+	Time_Threshold
+	Time_Quota
+	Quota_Holding_Time
+	Dropped_DL_Traffic_Threshold
+	Quota_Validity_Time
+	Monitoring_Time
+	Subsequent_Volume_Threshold
+	Subsequent_Time_Threshold
+	Subsequent_Volume_Quota
+	Subsequent_Time_Quota
+	Subsequent_Event_Threshold
+	Subsequent_Event_Quota
+	Inactivity_Detection_Time
+	Linked_URR_ID	multiple
+	Measurement_Information
+	Time_Quota_Mechanism
+	Aggregated_URRs	multiple
+	FAR_ID	# FAR ID for Quota Action
+	Ethernet_Inactivity_Timer
+	Additional_Monitoring_Time	multiple
+	Number_of_Reports
+	Application_ID	multiple	# Exempted Application ID for Quota Action
+	SDF_Filter	multiple	# Exempted SDF Filter for Quota Action
+	User_Plane_Inactivity_Timer
+group	Create_QER
+	QER_ID	required id
+	QER_Correlation_ID
+	Gate_Status
+	MBR
+	GBR
+	Packet_Rate_Status
+	DL_Flow_Level_Marking
+	QFI
+	RQI
+	Paging_Policy_Indicator
+	Averaging_Window
+	QER_Control_Indications
+	QER_Indications
+group	Created_PDR
+	PDR_ID	required id
+	Precedence
+	PDI
+	Outer_Header_Removal
+	FAR_ID
+	QER_ID	multiple
+	F_TEID	multiple
+	UE_IP_Address	multiple
+group	Update_PDR
+	PDR_ID	required id
+	Outer_Header_Removal
+	Precedence
+	PDI
+	FAR_ID
+	URR_ID	multiple
+	QER_ID	multiple
+	Activate_Predefined_Rules	multiple
+	Deactivate_Predefined_Rules	multiple
+	Activation_Time
+	Deactivation_Time
+	IP_Multicast_Addressing_Info	multiple
+	Transport_Delay_Reporting
+	RAT_Type
+group	Update_FAR
+	FAR_ID	required id
+	Apply_Action
+	Forwarding_Parameters
+	Update_Forwarding_Parameters	update=Forwarding_Parameters
+	Update_Duplicating_Parameters	update=Duplicating_Parameters	# without an ID, it updates the first Duplicating Parameters
+	Redundant_Transmission_Forwarding_Parameters
+	BAR_ID
+	Add_MBS_Unicast_Parameters	multiple
+	Remove_MBS_Unicast_Parameters	multiple delete=Add_MBS_Unicast_Parameters
+group	Update_Forwarding_Parameters
+	Destination_Interface
+	Network_Instance
+	Redirect_Information
+	Outer_Header_Creation
+	Transport_Level_Marking
+	Forwarding_Policy
+	Header_Enrichment
+	PfcpsmreqFlags
+	Traffic_Endpoint_ID
+	TGPP_Interface_Type
+	Data_Network_Access_Identifier
+	IP_Address_and_Port_Number_Replacement
+group	Update_BAR_SRRsp
+	BAR_ID	required id
+	Downlink_Data_Notification_Delay
+	DL_Buffering_Duration
+	DL_Buffering_Suggested_Packet_Count
+	Suggested_Buffering_Packets_Count
+group	Update_URR
+	URR_ID	required id
+	Measurement_Method
+	Reporting_Triggers
+	Measurement_Period
+	Volume_Threshold
+	Volume_Quota
+	Event_Threshold
+	Event_Quota
+	Time_Threshold
+	Time_Quota
+	Quota_Holding_Time
+	Dropped_DL_Traffic_Threshold
+	Quota_Validity_Time
+	Monitoring_Time
+	Subsequent_Volume_Threshold
+	Subsequent_Time_Threshold
+	Subsequent_Volume_Quota
+	Subsequent_Time_Quota
+	Subsequent_Event_Threshold
+	Subsequent_Event_Quota
+	Inactivity_Detection_Time
+	Linked_URR_ID	multiple
+	Measurement_Information
+	Time_Quota_Mechanism
+	Aggregated_URRs	multiple
+	FAR_ID	# FAR ID for Quota Action
+	Ethernet_Inactivity_Timer
+	Additional_Monitoring_Time	multiple
+	Number_of_Reports
+	Application_ID	multiple	# Exempted Application ID for Quota Action
+	SDF_Filter	multiple	# Exempted SDF Filter for Quota Action
+	User_Plane_Inactivity_Timer
+group	Update_QER
+	QER_ID	required id
+	QER_Correlation_ID
+	Gate_Status
+	MBR
+	GBR
+	Packet_Rate_Status
+	DL_Flow_Level_Marking
+	QFI
+	RQI
+	Paging_Policy_Indicator
+	Averaging_Window
+	QER_Control_Indications
+	QER_Indications
+group	Remove_PDR
+	PDR_ID	required id
+group	Remove_FAR
+	FAR_ID	required id
+group	Remove_URR
+	URR_ID	required id
+group	Remove_QER
+	QER_ID	required id
+group	Load_Control_Information
+	Sequence_Number	required
+	Metric	required
+group	Overload_Control_Information
+	Sequence_Number	required
+	Metric	required
+	Timer	required
+	OCI_Flags
+group	Application_IDs_PFDs
+	Application_ID	required
+	PFD_context	multiple
+group	PFD_context
+	PFD_contents	required multiple
+group	Application_Detection_Information
+	Application_ID	required
+	Application_Instance_ID
+	Flow_Information
+	PDR_ID
+group	Query_URR
+	URR_ID	required id
+group	Usage_Report_SMR
+	URR_ID	required
+	UR_SEQN	required
+	Usage_Report_Trigger	required
+	Start_Time
+	End_Time
+	Volume_Measurement
+	Duration_Measurement
+	Time_of_First_Packet
+	Time_of_Last_Packet
+	Usage_Information
+	Query_URR_Reference
+	Time_Stamp	multiple
+	Ethernet_Traffic_Information
+	Join_IP_Multicast_Information	multiple
+	Leave_IP_Multicast_Information	multiple
+	Predefined_Rules_Name	multiple
+group	Usage_Report_SDR
+	URR_ID	required
+	UR_SEQN	required
+	Usage_Report_Trigger	required
+	Start_Time
+	End_Time
+	Volume_Measurement
+	Duration_Measurement
+	Time_of_First_Packet
+	Time_of_Last_Packet
+	Usage_Information
+	Time_Stamp	multiple
+	Ethernet_Traffic_Information
+	Predefined_Rules_Name	multiple
+group	Usage_Report_SRR
+	URR_ID	required
+	UR_SEQN	required
+	Usage_Report_Trigger	required
+	Start_Time
+	End_Time
+	Volume_Measurement
+	Duration_Measurement
+	Application_Detection_Information
+	UE_IP_Address
+	Network_Instance
+	Time_of_First_Packet
+	Time_of_Last_Packet
+	Usage_Information
+	Query_URR_Reference
+	Time_Stamp	multiple
+	Ethernet_Traffic_Information
+	Join_IP_Multicast_Information	multiple
+	Leave_IP_Multicast_Information	multiple
+	Predefined_Rules_Name	multiple
+group	Downlink_Data_Report
+	PDR_ID	required multiple
+	Downlink_Data_Service_Information	multiple
+	DL_Data_Packets_Size
+	Data_Status
+group	Create_BAR
+	BAR_ID	required id
+	Downlink_Data_Notification_Delay
+	Suggested_Buffering_Packets_Count
+	MT_EDT_Control_Information
+group	Update_BAR
+	BAR_ID	required id
+	Downlink_Data_Notification_Delay
+	Suggested_Buffering_Packets_Count
+	MT_EDT_Control_Information
+group	Remove_BAR
+	BAR_ID	required id
+group	Error_Indication_Report
+	F_TEID	required multiple
+group	User_Plane_Path_Failure_Report
+	Remote_GTP_U_Peer	required multiple
+group	Update_Duplicating_Parameters
+	Destination_Interface
+	Outer_Header_Creation
+	Transport_Level_Marking
+	Forwarding_Policy
+group	Aggregated_URRs
+	Aggregated_URR_ID	required
+	Multiplier	required
+group	Create_Traffic_Endpoint
+	Traffic_Endpoint_ID	required id
+	F_TEID
+	Network_Instance
+	Redundant_Transmission_Parameters
+	UE_IP_Address	multiple
+	Ethernet_PDU_Session_Information
+	Framed_Route	multiple
+	Framed_Routing
+	Framed_IPv6_Route	multiple
+	QFI	multiple
+	TGPP_Interface_Type
+	Local_Ingress_Tunnel
+	MBS_Session_Identifier
+	Area_Session_ID
+	RAT_Type
+group	Created_Traffic_Endpoint
+	Traffic_Endpoint_ID	required id
+	F_TEID	multiple
+	UE_IP_Address	multiple
+	Local_Ingress_Tunnel
+group	Update_Traffic_Endpoint
+	Traffic_Endpoint_ID	required id
+	F_TEID
+	Network_Instance
+	Redundant_Transmission_Parameters
+	UE_IP_Address	multiple
+	Framed_Route	multiple
+	Framed_Routing
+	Framed_IPv6_Route	multiple
+	QFI	multiple
+	TGPP_Interface_Type
+	Local_Ingress_Tunnel
+	RAT_Type
+group	Remove_Traffic_Endpoint
+	Traffic_Endpoint_ID	required id
+group	Ethernet_Packet_Filter
+	Ethernet_Filter_ID
+	Ethernet_Filter_Properties
+	MAC_address	multiple
+	Ethertype
+	C_TAG
+	S_TAG
+	SDF_Filter	multiple
+group	Ethernet_Traffic_Information
+	MAC_Addresses_Detected	multiple
+	MAC_Addresses_Removed	multiple
+group	Additional_Monitoring_Time
+	Monitoring_Time	required
+	Subsequent_Volume_Threshold
+	Subsequent_Time_Threshold
+	Subsequent_Volume_Quota
+	Subsequent_Time_Quota
+	Subsequent_Event_Threshold
+	Subsequent_Event_Quota
+group	Create_MAR
+	MAR_ID	required id
+	Steering_Functionality	required
+	Steering_Mode	required
+	TGPP_Access_Forwarding_Action_Information
+	Non_3GPP_Access_Forwarding_Action_Information
+	Thresholds
+	Steering_Mode_Indicator
+group	TGPP_Access_Forwarding_Action_Information
+	FAR_ID	required
+	Weight
+	Priority
+	URR_ID	multiple
+	RAT_Type
+group	Non_3GPP_Access_Forwarding_Action_Information
+	FAR_ID	required
+	Weight
+	Priority
+	URR_ID	multiple
+	RAT_Type
+group	Remove_MAR
+	MAR_ID	required id
+group	Update_MAR
+	MAR_ID	required id
+	Steering_Functionality
+	Steering_Mode
+	Update_TGPP_Access_Forwarding_Action_Information	update=TGPP_Access_Forwarding_Action_Information
+	Update_Non_3GPP_Access_Forwarding_Action_Information	update=Non_3GPP_Access_Forwarding_Action_Information
+	TGPP_Access_Forwarding_Action_Information
+	Non_3GPP_Access_Forwarding_Action_Information
+	Thresholds
+	Steering_Mode_Indicator
+group	Update_TGPP_Access_Forwarding_Action_Information
+	FAR_ID
+	Weight
+	Priority
+	URR_ID	multiple
+	RAT_Type
+group	Update_Non_3GPP_Access_Forwarding_Action_Information
+	FAR_ID
+	Weight
+	Priority
+	URR_ID	multiple
+	RAT_Type
+group	PFCP_Session_Retention_Information
+	CP_PFCP_Entity_IP_Address	multiple
+group	User_Plane_Path_Recovery_Report
+	Remote_GTP_U_Peer	required multiple
+group	IP_Multicast_Addressing_Info
+	IP_Multicast_Address	required
+	Source_IP_Address	multiple
+group	Join_IP_Multicast_Information
+	IP_Multicast_Address	required
+	Source_IP_Address	multiple
+group	Leave_IP_Multicast_Information
+	IP_Multicast_Address	required
+	Source_IP_Address	multiple
+group	Created_Bridge_Info_for_TSC
+	DS_TT_Port_Number
+	TSN_Bridge_ID
+	NW_TT_Port_Number
+group	TSC_Management_Information_SMReq
+	Port_Management_Information_Container
+	Bridge_Management_Information_Container
+	NW_TT_Port_Number
+group	TSC_Management_Information_SMRsp
+	Port_Management_Information_Container
+	Bridge_Management_Information_Container
+	NW_TT_Port_Number
+group	TSC_Management_Information_SRReq
+	Port_Management_Information_Container
+	Bridge_Management_Information_Container
+	NW_TT_Port_Number
+group	Clock_Drift_Control_Information
+	Requested_Clock_Drift_Information	required
+	TSN_Time_Domain_Number	multiple
+	Time_Offset_Threshold
+	Cumulative_rateRatio_Threshold
+group	Clock_Drift_Report
+	TSN_Time_Domain_Number	required
+	Time_Offset_Measurement
+	Cumulative_rateRatio_Measurement
+	Time_Stamp
+	Network_Instance
+	APN_DNN
+	S_NSSAI
+group	Remove_SRR
+	SRR_ID	required id
+group	Create_SRR
+	SRR_ID	required id
+	Access_Availability_Control_Information
+	QoS_Monitoring_per_QoS_flow_Control_Information	multiple
+	Direct_Reporting_Information
+group	Update_SRR
+	SRR_ID	required id
+	Access_Availability_Control_Information
+	QoS_Monitoring_per_QoS_flow_Control_Information	multiple
+	Direct_Reporting_Information
+group	Session_Report
+	SRR_ID	required
+	Access_Availability_Report
+	QoS_Monitoring_Report	multiple
+group	Access_Availability_Control_Information
+	Requested_Access_Availability_Information	required
+group	Access_Availability_Report
+	Access_Availability_Information	required
+group	Provide_ATSSS_Control_Information
+	MPTCP_Control_Information
+	ATSSS_LL_Control_Information
+	PMF_Control_Information
+group	ATSSS_Control_Parameters
+	MPTCP_Parameters
+	ATSSS_LL_Parameters
+	PMF_Parameters
+group	MPTCP_Parameters
+	MPTCP_Address_Information	required
+	UE_Link_Specific_IP_Address	required
+group	ATSSS_LL_Parameters
+	ATSSS_LL_Information	required
+group	PMF_Parameters
+	PMF_Address_Information	required
+group	UE_IP_address_Pool_Information
+	UE_IP_address_Pool_Identity	required multiple
+	Network_Instance
+	S_NSSAI	multiple
+	IP_version
+group	GTP_U_Path_QoS_Control_Information
+	Remote_GTP_U_Peer	multiple
+	GTP_U_Path_Interface_Type
+	QoS_Report_Trigger	required
+	Transport_Level_Marking
+	Measurement_Period
+	Average_Packet_Delay
+	Minimum_Packet_Delay
+	Maximum_Packet_Delay
+	Minimum_Wait_Time
+group	GTP_U_Path_QoS_Report
+	Remote_GTP_U_Peer	required
+	GTP_U_Path_Interface_Type
+	QoS_Report_Trigger	required
+	Time_Stamp	required
+	Start_Time
+	QoS_Information_In_GTP_U_Path_QoS_Report	required multiple
+group	QoS_Information_In_GTP_U_Path_QoS_Report
+	Average_Packet_Delay	required
+	Minimum_Packet_Delay
+	Maximum_Packet_Delay
+	Transport_Level_Marking
+group	QoS_Monitoring_per_QoS_flow_Control_Information
+	QFI	required multiple
+	Requested_QoS_Monitoring	required
+	Reporting_Frequency	required
+	Packet_Delay_Thresholds
+	Minimum_Wait_Time
+	Measurement_Period
+group	QoS_Monitoring_Report
+	QFI	required
+	QoS_Monitoring_Measurement	required
+	Time_Stamp	required
+	Start_Time
+group	Packet_Rate_Status_Report
+	QER_ID	required
+	Packet_Rate_Status	required
+group	Ethernet_Context_Information
+	MAC_Addresses_Detected	required multiple
+group	Redundant_Transmission_Parameters
+	F_TEID	required
+	Network_Instance
+group	Updated_PDR
+	PDR_ID	required
+	F_TEID	multiple
+	UE_IP_Address	multiple
+group	Provide_RDS_configuration_information
+	RDS_configuration_information
+group	Query_Packet_Rate_Status
+	QER_ID	required
+group	Packet_Rate_Status_Report_SMRsp
+	QER_ID	required
+	Packet_Rate_Status
+group	UE_IP_Address_Usage_Information
+	Sequence_Number	required
+	Metric	required
+	Validity_Timer	required
+	Number_of_UE_IP_Addresses	required
+	Network_Instance	required
+	UE_IP_address_Pool_Identity	multiple
+	S_NSSAI
+group	Redundant_Transmission_Forwarding_Parameters
+	Outer_Header_Creation	required
+	Network_Instance
+group	Transport_Delay_Reporting
+	Remote_GTP_U_Peer	required
+	Transport_Level_Marking
+group	Partial_Failure_Information_SERsp
+	Failed_Rule_ID	required
+	Cause	required
+	Offending_IE_Information	required
+group	Partial_Failure_Information_SMRsp
+	Failed_Rule_ID	required
+	Cause	required
+	Offending_IE_Information	required
+group	L2TP_Tunnel_Information
+	LNS_Address	required
+	Tunnel_Password
+	Tunnel_Preference
+group	L2TP_Session_Information
+	Calling_Number
+	Called_Number
+	Maximum_Receive_Unit
+	L2TP_Session_Indications
+	L2TP_User_Authentication
+group	Created_L2TP_Session
+	DNS_Server_Address	multiple
+	NBNS_Server_Address	multiple
+	LNS_Address
+group	PFCP_Session_Change_Info
+	FQ_CSID	multiple
+	Group_Id	multiple
+	CP_IP_Address	multiple
+	Alternative_SMF_IP_Address	required
+group	IP_Address_and_Port_Number_Replacement
+	Source_IP_Address	multiple
+group	Direct_Reporting_Information
+	Event_Notification_URI	required
+	Notification_Correlation_ID
+	Reporting_Flags
+group	MBS_Session_N4mb_Control_Information
+	MBS_Session_Identifier	required
+	Area_Session_ID
+	Multicast_Transport_Information
+group	MBS_Multicast_Parameters
+	Destination_Interface	required
+	Network_Instance
+	Outer_Header_Creation	required
+	Transport_Level_Marking
+group	Add_MBS_Unicast_Parameters
+	Destination_Interface	required
+	MBS_Unicast_Parameters_ID	required id
+	Network_Instance
+	Outer_Header_Creation	required
+	Transport_Level_Marking
+group	MBS_Session_N4mb_Information
+	Multicast_Transport_Information	required
+group	Remove_MBS_Unicast_Parameters
+	MBS_Unicast_Parameters_ID	required id
+group	MBS_Session_N4_Control_Information
+	MBS_Session_Identifier	required
+	Area_Session_ID
+	Multicast_Transport_Information
+group	MBS_Session_N4_Information
+	MBS_Session_Identifier	required
+	Area_Session_ID
+	F_TEID
+	MBSN4Resp_Flags
+group	Peer_UP_Restart_Report
+	Remote_GTP_U_Peer	required
+group	DSCP_to_PPI_Control_Information
+	DSCP_to_PPI_Mapping_Information	required multiple
+	QFI	multiple
+
+messageies	PFCP_Heartbeat_Request
+	Recovery_Time_Stamp	required
+	Source_IP_Address
+messageies	PFCP_Heartbeat_Response
+	Recovery_Time_Stamp	required
+messageies	PFCP_Session_Establishment_Request
+	Node_ID	required
+	F_SEID	required
+	Create_PDR	required multiple
+	Create_FAR	required multiple
+	Create_URR	multiple
+	Create_QER	multiple
+	Create_BAR	multiple
+	Create_Traffic_Endpoint	multiple
+	PDN_Type
+	FQ_CSID	multiple	# SGW-C, MME, PGW-C/SMF, ePDG and TWAN FQ-CSIDs
+	User_Plane_Inactivity_Timer
+	User_ID
+	Trace_Information
+	APN_DNN
+	Create_MAR	multiple
+	PfcpsereqFlags
+	Create_Bridge_Info_for_TSC
+	Create_SRR	multiple
+	Provide_ATSSS_Control_Information
+	Recovery_Time_Stamp
+	S_NSSAI
+	Provide_RDS_configuration_information
+	RAT_Type
+	L2TP_Tunnel_Information
+	L2TP_Session_Information
+	Group_Id
+	MBS_Session_N4mb_Control_Information
+	MBS_Session_N4_Control_Information	multiple
+	DSCP_to_PPI_Control_Information	multiple
+	SDF_Filter
+messageies	PFCP_Session_Establishment_Response
+	Node_ID	required
+	Cause	required
+	Offending_IE
+	F_SEID	# required only if cause is success, see messageConditionalRules
+	Created_PDR	multiple
+	Load_Control_Information
+	Overload_Control_Information
+	FQ_CSID	multiple	# SGW-U and PGW-U/UPF FQ-CSIDs
+	Failed_Rule_ID
+	Created_Traffic_Endpoint	multiple
+	Created_Bridge_Info_for_TSC
+	ATSSS_Control_Parameters
+	RDS_configuration_information
+	Partial_Failure_Information_SERsp	multiple
+	Created_L2TP_Session
+	MBS_Session_N4mb_Information
+	MBS_Session_N4_Information	multiple
+messageies	PFCP_Session_Modification_Request
+	F_SEID
+	Remove_PDR	multiple delete=Create_PDR
+	Remove_FAR	multiple delete=Create_FAR
+	Remove_URR	multiple delete=Create_URR
+	Remove_QER	multiple delete=Create_QER
+	Remove_BAR	delete=Create_BAR
+	Remove_Traffic_Endpoint	multiple delete=Create_Traffic_Endpoint
+	Create_PDR	multiple
+	Create_FAR	multiple
+	Create_URR	multiple
+	Create_QER	multiple
+	Create_BAR
+	Create_Traffic_Endpoint	multiple
+	Update_PDR	multiple update=Create_PDR
+	Update_FAR	multiple update=Create_FAR
+	Update_URR	multiple update=Create_URR
+	Update_QER	multiple update=Create_QER
+	Update_BAR	update=Create_BAR
+	Update_Traffic_Endpoint	multiple update=Create_Traffic_Endpoint
+	PfcpsmreqFlags	transient
+	Query_URR	multiple transient
+	FQ_CSID	multiple	# PGW-C/SMF, SGW-C, MME, ePDG and TWAN FQ-CSIDs
+	User_Plane_Inactivity_Timer
+	Query_URR_Reference	transient
+	Trace_Information
+	Remove_MAR	multiple delete=Create_MAR
+	Update_MAR	multiple update=Create_MAR
+	Create_MAR	multiple
+	Node_ID
+	TSC_Management_Information_SMReq	multiple transient
+	Remove_SRR	multiple delete=Create_SRR
+	Create_SRR	multiple
+	Update_SRR	multiple update=Create_SRR
+	Provide_ATSSS_Control_Information
+	Ethernet_Context_Information
+	Access_Availability_Information
+	Query_Packet_Rate_Status	multiple transient
+	S_NSSAI
+	RAT_Type
+	Group_Id
+	MBS_Session_N4_Control_Information	multiple
+	DSCP_to_PPI_Control_Information	multiple
+messageies	PFCP_Session_Modification_Response
+	Cause	required
+	Offending_IE
+	Created_PDR	multiple
+	Load_Control_Information
+	Overload_Control_Information
+	Usage_Report_SMR	multiple
+	Failed_Rule_ID
+	Additional_Usage_Reports_Information
+	Created_Traffic_Endpoint	multiple
+	TSC_Management_Information_SMRsp	multiple
+	ATSSS_Control_Parameters
+	Updated_PDR	multiple
+	Packet_Rate_Status_Report_SMRsp	multiple
+	Partial_Failure_Information_SMRsp	multiple
+	MBS_Session_N4_Information	multiple
+messageies	PFCP_Session_Deletion_Request
+messageies	PFCP_Session_Deletion_Response
+	Cause	required
+	Offending_IE
+	Load_Control_Information
+	Overload_Control_Information
+	Usage_Report_SDR	multiple
+	Additional_Usage_Reports_Information
+	Packet_Rate_Status_Report	multiple
+	Session_Report	multiple
+	PfcpsdrspFlags
+messageies	PFCP_Association_Setup_Request
+	Node_ID	required
+	Recovery_Time_Stamp	required
+	UP_Function_Features
+	CP_Function_Features
+	User_Plane_IP_Resource_Information	multiple	# an R15 only IE, removed by the R16 and later validation profiles
+	Alternative_SMF_IP_Address	multiple
+	SMF_Set_ID
+	PFCP_Session_Retention_Information
+	UE_IP_address_Pool_Information	multiple
+	GTP_U_Path_QoS_Control_Information	multiple
+	Clock_Drift_Control_Information	multiple
+	NF_Instance_ID
+	PfcpasreqFlags
+messageies	PFCP_Association_Setup_Response
+	Cause	required
+	Node_ID	required
+	Recovery_Time_Stamp	required
+	CP_Function_Features
+	UP_Function_Features
+	User_Plane_IP_Resource_Information	# an R15 only IE, removed by the R16 and later validation profiles
+	Alternative_SMF_IP_Address	multiple
+	SMF_Set_ID
+	PfcpasrspFlags
+	Clock_Drift_Control_Information	multiple
+	UE_IP_address_Pool_Information	multiple
+	GTP_U_Path_QoS_Control_Information	multiple
+	NF_Instance_ID
+messageies	PFCP_Association_Update_Request
+	Node_ID	required
+	UP_Function_Features
+	CP_Function_Features
+	PfcparreqFlags
+	Graceful_Release_Period
+	PfcpaureqFlags
+	User_Plane_IP_Resource_Information	multiple
+	Alternative_SMF_IP_Address	multiple
+	SMF_Set_ID
+	Clock_Drift_Control_Information	multiple
+	UE_IP_address_Pool_Information	multiple
+	GTP_U_Path_QoS_Control_Information	multiple
+	UE_IP_Address_Usage_Information	multiple
+messageies	PFCP_Association_Update_Response
+	Node_ID	required
+	Cause	required
+	UP_Function_Features
+	CP_Function_Features
+	UE_IP_Address_Usage_Information	multiple
+messageies	PFCP_Association_Release_Request
+	Node_ID	required
+messageies	PFCP_Association_Release_Response
+	Node_ID	required
+	Cause	required
+messageies	PFCP_Version_Not_Supported_Response
+messageies	PFCP_PFD_Management_Request
+	Application_IDs_PFDs	multiple
+	Node_ID
+messageies	PFCP_PFD_Management_Response
+	Cause	required
+	Offending_IE
+	Node_ID
+messageies	PFCP_Node_Report_Request
+	Node_ID	required
+	Node_Report_Type	required
+	User_Plane_Path_Failure_Report
+	User_Plane_Path_Recovery_Report
+	Clock_Drift_Report	multiple
+	GTP_U_Path_QoS_Report	multiple
+	Peer_UP_Restart_Report
+	Vendor_Specific_Node_Report_Type
+messageies	PFCP_Node_Report_Response
+	Node_ID	required
+	Cause	required
+	Offending_IE
+messageies	PFCP_Session_Set_Deletion_Request
+	Node_ID	required
+	FQ_CSID	multiple	# SGW-C, PGW-C, SGW-U, PGW-U, TWAN, ePDG and MME FQ-CSIDs all share the IE type
+messageies	PFCP_Session_Set_Deletion_Response
+	Node_ID	required
+	Cause	required
+	Offending_IE
+messageies	PFCP_Session_Set_Modification_Request
+	Alternative_SMF_IP_Address	required
+	FQ_CSID	multiple
+	Group_Id	multiple
+	CP_IP_Address	multiple
+messageies	PFCP_Session_Set_Modification_Response
+	Node_ID	required
+	Cause	required
+	Offending_IE
+messageies	PFCP_Session_Report_Request
+	Report_Type	required
+	Downlink_Data_Report
+	Usage_Report_SRR	multiple
+	Error_Indication_Report
+	Load_Control_Information
+	Overload_Control_Information
+	Additional_Usage_Reports_Information
+	PfcpsrreqFlags
+	F_SEID	# the old CP F-SEID
+	Packet_Rate_Status_Report
+	TSC_Management_Information_SRReq	multiple
+	Session_Report	multiple
+	Cause
+messageies	PFCP_Session_Report_Response
+	Cause	required
+	Offending_IE
+	Update_BAR_SRRsp
+	PfcpsrrspFlags
+	F_SEID	# the CP F-SEID
+	F_TEID	# the N4-u F-TEID
+	Alternative_SMF_IP_Address
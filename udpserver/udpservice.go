@@ -24,14 +24,27 @@ type UdpServer struct {
 	registeredPeers map[netip.AddrPort]*UdpServerPeer
 }
 
+// DatagramPeer is the socket layer under the transport, carrying the datagrams to and from one peer.
+// UdpServerPeer is the implementation on a UDP socket, package simnet has one on a simulated network.
+type DatagramPeer interface {
+	SendChannel() chan<- *UdpMessage
+	ReceiveChannel() <-chan *UdpMessage
+	Drop()
+}
+
 type UdpServerPeer struct {
 	Send, Receive chan *UdpMessage
 	peer          netip.AddrPort
 	parent        *UdpServer
 }
 
+func (udpServerPeer *UdpServerPeer) SendChannel() chan<- *UdpMessage    { return udpServerPeer.Send }
+func (udpServerPeer *UdpServerPeer) ReceiveChannel() <-chan *UdpMessage { return udpServerPeer.Receive }
+
 func (udpServerPeer *UdpServerPeer) Drop() {
-	udpServerPeer.parent.Unregister(udpServerPeer.peer)
+	if udpServerPeer.parent != nil { // a peer on plain channels has no server
+		udpServerPeer.parent.Unregister(udpServerPeer.peer)
+	}
 }
 
 func (udpServerPeer *UdpServerPeer) Enqueue(udpMessage *UdpMessage) {